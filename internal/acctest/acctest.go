@@ -99,7 +99,7 @@ var Provider *schema.Provider
 var testAccProviderConfigure sync.Once
 
 func init() {
-	Provider = provider.Provider()
+	Provider = newProvider()
 
 	Providers = map[string]*schema.Provider{
 		ProviderName: Provider,
@@ -108,16 +108,25 @@ func init() {
 	// Always allocate a new provider instance each invocation, otherwise gRPC
 	// ProviderConfigure() can overwrite configuration during concurrent testing.
 	ProviderFactories = map[string]func() (*schema.Provider, error){
-		ProviderName: func() (*schema.Provider, error) { return provider.Provider(), nil }, //nolint:unparam
+		ProviderName: func() (*schema.Provider, error) { return newProvider(), nil }, //nolint:unparam
 	}
 }
 
+// newProvider returns a new provider instance whose AWS API interactions
+// are recorded or replayed when the TF_ACC_VCR_MODE environment variable is set.
+func newProvider() *schema.Provider {
+	p := provider.Provider()
+	p.ConfigureContextFunc = vcrProviderConfigureContextFunc(p.ConfigureContextFunc)
+
+	return p
+}
+
 // factoriesInit creates ProviderFactories for the provider under testing.
 func factoriesInit(providers *[]*schema.Provider, providerNames []string) map[string]func() (*schema.Provider, error) {
 	var factories = make(map[string]func() (*schema.Provider, error), len(providerNames))
 
 	for _, name := range providerNames {
-		p := provider.Provider()
+		p := newProvider()
 
 		factories[name] = func() (*schema.Provider, error) { //nolint:unparam
 			return p, nil
@@ -192,11 +201,13 @@ func FactoriesMultipleRegion(providers *[]*schema.Provider, regions int) map[str
 func PreCheck(t *testing.T) {
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
-	testAccProviderConfigure.Do(func() {
-		conns.FailIfAllEnvVarEmpty(t, []string{conns.EnvVarProfile, conns.EnvVarAccessKeyId, conns.EnvVarContainerCredentialsFullURI}, "credentials for running acceptance testing")
+	configure := func() {
+		if vcrMode() != vcrModeReplayOnly {
+			conns.FailIfAllEnvVarEmpty(t, []string{conns.EnvVarProfile, conns.EnvVarAccessKeyId, conns.EnvVarContainerCredentialsFullURI}, "credentials for running acceptance testing")
 
-		if os.Getenv(conns.EnvVarAccessKeyId) != "" {
-			conns.FailIfEnvVarEmpty(t, conns.EnvVarSecretAccessKey, "static credentials value when using "+conns.EnvVarAccessKeyId)
+			if os.Getenv(conns.EnvVarAccessKeyId) != "" {
+				conns.FailIfEnvVarEmpty(t, conns.EnvVarSecretAccessKey, "static credentials value when using "+conns.EnvVarAccessKeyId)
+			}
 		}
 
 		// Setting the AWS_DEFAULT_REGION environment variable here allows all tests to omit
@@ -213,7 +224,15 @@ func PreCheck(t *testing.T) {
 		if err != nil {
 			t.Fatal(err)
 		}
-	})
+	}
+
+	// When recording or replaying, Provider is configured for each test
+	// so that its AWS API interactions are in every test's cassette.
+	if vcrEnabled() {
+		configure()
+	} else {
+		testAccProviderConfigure.Do(configure)
+	}
 }
 
 // providerAccountID returns the account ID of an AWS provider
@@ -547,6 +566,20 @@ func PrimaryInstanceState(s *terraform.State, name string) (*terraform.InstanceS
 	return is, nil
 }
 
+// AttrImportStateIdFunc returns the value of the specified resource attribute as the import ID,
+// e.g. for importing by ARN
+func AttrImportStateIdFunc(resourceName, attributeName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		is, err := PrimaryInstanceState(s, resourceName)
+
+		if err != nil {
+			return "", err
+		}
+
+		return is.Attributes[attributeName], nil
+	}
+}
+
 // AccountID returns the account ID of Provider
 // Must be used within a resource.TestCheckFunc
 func AccountID() string {
@@ -1356,6 +1389,15 @@ func ACMCertificateRandomSubDomain(rootDomain string) string {
 		rootDomain)
 }
 
+// acmPCATemplateARN returns the ARN of the ACM PCA certificate template with the given name.
+func acmPCATemplateARN(name string) string {
+	return arn.ARN{
+		Partition: Partition(),
+		Service:   "acm-pca",
+		Resource:  "template/" + name,
+	}.String()
+}
+
 func CheckACMPCACertificateAuthorityActivateRootCA(certificateAuthority *acmpca.CertificateAuthority) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := Provider.Meta().(*conns.AWSClient).ACMPCAConn
@@ -1379,7 +1421,7 @@ func CheckACMPCACertificateAuthorityActivateRootCA(certificateAuthority *acmpca.
 			Csr:                     []byte(aws.StringValue(getCsrOutput.Csr)),
 			IdempotencyToken:        aws.String(resource.UniqueId()),
 			SigningAlgorithm:        certificateAuthority.CertificateAuthorityConfiguration.SigningAlgorithm,
-			TemplateArn:             aws.String(fmt.Sprintf("arn:%s:acm-pca:::template/RootCACertificate/V1", Partition())),
+			TemplateArn:             aws.String(acmPCATemplateARN("RootCACertificate/V1")),
 			Validity: &acmpca.Validity{
 				Type:  aws.String(acmpca.ValidityPeriodTypeYears),
 				Value: aws.Int64(10),
@@ -1447,7 +1489,7 @@ func CheckACMPCACertificateAuthorityActivateSubordinateCA(rootCertificateAuthori
 			Csr:                     []byte(aws.StringValue(getCsrOutput.Csr)),
 			IdempotencyToken:        aws.String(resource.UniqueId()),
 			SigningAlgorithm:        certificateAuthority.CertificateAuthorityConfiguration.SigningAlgorithm,
-			TemplateArn:             aws.String(fmt.Sprintf("arn:%s:acm-pca:::template/SubordinateCACertificate_PathLen0/V1", Partition())),
+			TemplateArn:             aws.String(acmPCATemplateARN("SubordinateCACertificate_PathLen0/V1")),
 			Validity: &acmpca.Validity{
 				Type:  aws.String(acmpca.ValidityPeriodTypeYears),
 				Value: aws.Int64(3),
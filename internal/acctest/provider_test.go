@@ -3,6 +3,8 @@ package acctest
 import (
 	"fmt"
 	"reflect"
+	"regexp"
+	"sort"
 	"strings"
 	"testing"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -372,6 +375,44 @@ func TestAccProvider_IgnoreTagsKeys_multiple(t *testing.T) {
 	})
 }
 
+func TestAccProvider_IgnoreTagsKeyRegexes_one(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { PreCheck(t) },
+		ErrorCheck:        ErrorCheck(t),
+		ProviderFactories: FactoriesInternal(&providers),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig_ignoreTagsKeyRegexes1(`(?i)^kubernetes\.io/cluster/`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIgnoreTagsKeyRegexes(&providers, []string{`(?i)^kubernetes\.io/cluster/`}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProvider_IgnoreTagsValueRegexes_one(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { PreCheck(t) },
+		ErrorCheck:        ErrorCheck(t),
+		ProviderFactories: FactoriesInternal(&providers),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig_ignoreTagsValueRegexes1(`^owned$`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIgnoreTagsValueRegexes(&providers, []string{`^owned$`}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccProvider_Region_c2s(t *testing.T) {
 	var providers []*schema.Provider
 
@@ -693,6 +734,53 @@ func testAccCheckIgnoreTagsKeyPrefixes(providers *[]*schema.Provider, expectedKe
 	}
 }
 
+func testAccCheckIgnoreTagsKeyRegexes(providers *[]*schema.Provider, expectedRegexes []string) resource.TestCheckFunc {
+	return testAccCheckIgnoreTagsRegexes(providers, "key_regexes", func(c *tftags.IgnoreConfig) []*regexp.Regexp { return c.KeyRegexes }, expectedRegexes)
+}
+
+func testAccCheckIgnoreTagsValueRegexes(providers *[]*schema.Provider, expectedRegexes []string) resource.TestCheckFunc {
+	return testAccCheckIgnoreTagsRegexes(providers, "value_regexes", func(c *tftags.IgnoreConfig) []*regexp.Regexp { return c.ValueRegexes }, expectedRegexes)
+}
+
+func testAccCheckIgnoreTagsRegexes(providers *[]*schema.Provider, name string, f func(*tftags.IgnoreConfig) []*regexp.Regexp, expectedRegexes []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if providers == nil {
+			return fmt.Errorf("no providers initialized")
+		}
+
+		for _, provo := range *providers {
+			if provo == nil || provo.Meta() == nil || provo.Meta().(*conns.AWSClient) == nil {
+				continue
+			}
+
+			providerClient := provo.Meta().(*conns.AWSClient)
+			ignoreTagsConfig := providerClient.IgnoreTagsConfig
+
+			if ignoreTagsConfig == nil {
+				if len(expectedRegexes) != 0 {
+					return fmt.Errorf("expected %s (%d) length, got: 0", name, len(expectedRegexes))
+				}
+
+				continue
+			}
+
+			var actualRegexes []string
+			for _, r := range f(ignoreTagsConfig) {
+				actualRegexes = append(actualRegexes, r.String())
+			}
+
+			sort.Strings(actualRegexes)
+			sort.Strings(expectedRegexes)
+
+			if !reflect.DeepEqual(actualRegexes, expectedRegexes) {
+				return fmt.Errorf("expected %s %v, got: %v", name, expectedRegexes, actualRegexes)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckIgnoreTagsKeys(providers *[]*schema.Provider, expectedKeys []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if providers == nil {
@@ -1087,6 +1175,42 @@ provider "aws" {
 `, tagPrefix1))
 }
 
+func testAccProviderConfig_ignoreTagsKeyRegexes1(regex1 string) string {
+	//lintignore:AT004
+	return ConfigCompose(
+		testAccProviderConfigBase,
+		fmt.Sprintf(`
+provider "aws" {
+  ignore_tags {
+    key_regexes = [%[1]q]
+  }
+
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+`, regex1))
+}
+
+func testAccProviderConfig_ignoreTagsValueRegexes1(regex1 string) string {
+	//lintignore:AT004
+	return ConfigCompose(
+		testAccProviderConfigBase,
+		fmt.Sprintf(`
+provider "aws" {
+  ignore_tags {
+    value_regexes = [%[1]q]
+  }
+
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+`, regex1))
+}
+
 func testAccProviderConfig_ignoreTagsKeyPrefixes2(tagPrefix1, tagPrefix2 string) string {
 	//lintignore:AT004
 	return ConfigCompose(
@@ -0,0 +1,427 @@
+package acctest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	vcrModeRecordOnly = "RECORD_ONLY"
+	vcrModeReplayOnly = "REPLAY_ONLY"
+)
+
+const (
+	vcrScrubbedAccountID       = "123456789012"
+	vcrScrubbedCredentialValue = "scrubbed"
+)
+
+// vcrScrubbedCredentialsRegexps match credentials in XML and JSON API responses,
+// e.g. those returned by STS AssumeRole or IAM CreateAccessKey.
+var vcrScrubbedCredentialsRegexps = []*regexp.Regexp{
+	regexp.MustCompile(`(<(?:AccessKeyId|SecretAccessKey|SessionToken)>)[^<]*(</)`),
+	regexp.MustCompile(`("(?:AccessKeyId|SecretAccessKey|SessionToken)"\s*:\s*")[^"]*(")`),
+}
+
+// vcrCurrentCassette is the cassette of the running acceptance test.
+// Acceptance tests are run serially when recording or replaying as all
+// provider instances, including Provider, share the current cassette.
+var vcrCurrentCassette struct {
+	sync.Mutex
+	cassette *vcrCassette
+}
+
+func vcrMode() string {
+	return os.Getenv(conns.EnvVarAccVCRMode)
+}
+
+func vcrEnabled() bool {
+	return vcrMode() != ""
+}
+
+// vcrCassette contains the AWS API interactions of a single acceptance test.
+type vcrCassette struct {
+	Seed         int64             `json:"seed"`
+	Interactions []*vcrInteraction `json:"interactions"`
+
+	lock       sync.Mutex
+	accountIDs map[string]struct{}
+	mode       string
+	path       string
+	rand       *rand.Rand
+	replayed   []bool
+	transport  http.RoundTripper
+}
+
+type vcrInteraction struct {
+	Request  vcrRequest  `json:"request"`
+	Response vcrResponse `json:"response"`
+}
+
+type vcrRequest struct {
+	Body      string `json:"body,omitempty"`
+	Method    string `json:"method"`
+	Operation string `json:"operation,omitempty"`
+	URL       string `json:"url"`
+}
+
+type vcrResponse struct {
+	Body       string      `json:"body,omitempty"`
+	Headers    http.Header `json:"headers,omitempty"`
+	StatusCode int         `json:"status_code"`
+}
+
+// vcrCassetteForTest returns the cassette for the specified test,
+// creating it and making it the current cassette on first use.
+func vcrCassetteForTest(t *testing.T) *vcrCassette {
+	vcrCurrentCassette.Lock()
+	defer vcrCurrentCassette.Unlock()
+
+	path := filepath.Join(os.Getenv(conns.EnvVarAccVCRPath), strings.ReplaceAll(t.Name(), "/", "_")+".json")
+
+	if c := vcrCurrentCassette.cassette; c != nil && c.path == path {
+		return c
+	}
+
+	c, err := newVCRCassette(vcrMode(), path)
+
+	if err != nil {
+		t.Fatalf("error initializing VCR cassette (%s): %s", path, err)
+	}
+
+	vcrCurrentCassette.cassette = c
+
+	t.Cleanup(func() {
+		vcrCurrentCassette.Lock()
+		defer vcrCurrentCassette.Unlock()
+
+		vcrCurrentCassette.cassette = nil
+
+		if c.mode != vcrModeRecordOnly || t.Failed() {
+			return
+		}
+
+		if err := c.save(); err != nil {
+			t.Errorf("error saving VCR cassette (%s): %s", path, err)
+		}
+	})
+
+	return c
+}
+
+func newVCRCassette(mode, path string) (*vcrCassette, error) {
+	if os.Getenv(conns.EnvVarAccVCRPath) == "" {
+		return nil, fmt.Errorf("%s must be set when %s is set", conns.EnvVarAccVCRPath, conns.EnvVarAccVCRMode)
+	}
+
+	c := &vcrCassette{
+		accountIDs: make(map[string]struct{}),
+		mode:       mode,
+		path:       path,
+	}
+
+	switch mode {
+	case vcrModeRecordOnly:
+		c.Seed = time.Now().UnixNano()
+		c.transport = cleanhttp.DefaultPooledTransport()
+	case vcrModeReplayOnly:
+		b, err := os.ReadFile(path)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, c); err != nil {
+			return nil, err
+		}
+
+		c.replayed = make([]bool, len(c.Interactions))
+	default:
+		return nil, fmt.Errorf("unsupported %s value: %s", conns.EnvVarAccVCRMode, mode)
+	}
+
+	c.rand = rand.New(rand.NewSource(c.Seed)) //nolint:gosec
+
+	return c, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (c *vcrCassette) RoundTrip(r *http.Request) (*http.Response, error) {
+	var body []byte
+
+	if r.Body != nil {
+		b, err := io.ReadAll(r.Body)
+		r.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+
+		body = b
+		r.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	request := vcrRequest{
+		Method:    r.Method,
+		Operation: vcrOperation(r, body),
+		URL:       r.URL.String(),
+	}
+
+	// Request bodies are not recorded as is as they may contain secrets, such as passwords.
+	// Instead, the API operation's parameters are recorded with sensitive values redacted.
+	// The bodies of requests not made by AWS SDK for Go v1 API operations are not recorded.
+	if v, ok := conns.RedactedParametersFromContext(r.Context()); ok {
+		b, err := json.Marshal(v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		request.Body = string(b)
+	}
+
+	if c.mode == vcrModeReplayOnly {
+		return c.replay(r, request)
+	}
+
+	return c.record(r, request)
+}
+
+func (c *vcrCassette) record(r *http.Request, request vcrRequest) (*http.Response, error) {
+	response, err := c.transport.RoundTrip(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(response.Body)
+	response.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	response.Body = io.NopCloser(bytes.NewReader(body))
+
+	interaction := &vcrInteraction{
+		Request: request,
+		Response: vcrResponse{
+			Body:       string(body),
+			Headers:    response.Header.Clone(),
+			StatusCode: response.StatusCode,
+		},
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.Interactions = append(c.Interactions, interaction)
+
+	return response, nil
+}
+
+// replay returns the first interaction not yet replayed that matches the request.
+// Request bodies are not compared as they may contain values that differ between runs,
+// such as idempotency tokens and timestamps.
+func (c *vcrCassette) replay(r *http.Request, request vcrRequest) (*http.Response, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for i, interaction := range c.Interactions {
+		if c.replayed[i] {
+			continue
+		}
+
+		if v := interaction.Request; v.Method != request.Method || v.URL != request.URL || v.Operation != request.Operation {
+			continue
+		}
+
+		c.replayed[i] = true
+
+		response := &http.Response{
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Header:        interaction.Response.Headers.Clone(),
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Request:       r,
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+		}
+
+		return response, nil
+	}
+
+	return nil, fmt.Errorf("no recorded interaction in VCR cassette (%s) for %s %s (%s)", c.path, request.Method, request.URL, request.Operation)
+}
+
+func (c *vcrCassette) addAccountID(accountID string) {
+	if accountID == "" || accountID == vcrScrubbedAccountID {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.accountIDs[accountID] = struct{}{}
+}
+
+// scrub replaces account IDs and credentials in the string.
+func (c *vcrCassette) scrub(s string) string {
+	for accountID := range c.accountIDs {
+		s = strings.ReplaceAll(s, accountID, vcrScrubbedAccountID)
+	}
+
+	for _, re := range vcrScrubbedCredentialsRegexps {
+		s = re.ReplaceAllString(s, "${1}"+vcrScrubbedCredentialValue+"${2}")
+	}
+
+	return s
+}
+
+func (c *vcrCassette) save() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, interaction := range c.Interactions {
+		interaction.Request.Body = c.scrub(interaction.Request.Body)
+		interaction.Request.URL = c.scrub(interaction.Request.URL)
+		interaction.Response.Body = c.scrub(interaction.Response.Body)
+
+		for _, v := range interaction.Response.Headers {
+			for i := range v {
+				v[i] = c.scrub(v[i])
+			}
+		}
+	}
+
+	b, err := json.MarshalIndent(c, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(c.path, b, 0644)
+}
+
+// vcrOperation returns the API operation name of a request using the
+// JSON (X-Amz-Target header) or Query (Action parameter) protocols.
+// REST protocol operations are identified by method and URL alone.
+func vcrOperation(r *http.Request, body []byte) string {
+	if v := r.Header.Get("X-Amz-Target"); v != "" {
+		return v
+	}
+
+	if v := r.URL.Query().Get("Action"); v != "" {
+		return v
+	}
+
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			return values.Get("Action")
+		}
+	}
+
+	return ""
+}
+
+// vcrProviderConfigureContextFunc returns a ConfigureContextFunc that routes
+// all AWS API requests through the current cassette, if any.
+func vcrProviderConfigureContextFunc(configure schema.ConfigureContextFunc) schema.ConfigureContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		vcrCurrentCassette.Lock()
+		c := vcrCurrentCassette.cassette
+		vcrCurrentCassette.Unlock()
+
+		if c == nil {
+			return configure(ctx, d)
+		}
+
+		// Credentials are validated using a separate HTTP client, so skip validation
+		// and look up the account ID using requests that can be recorded.
+		if err := d.Set("skip_credentials_validation", true); err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		if c.mode == vcrModeReplayOnly {
+			for k, v := range map[string]interface{}{
+				"access_key":              "mock_access_key",
+				"secret_key":              "mock_secret_key",
+				"skip_metadata_api_check": "true",
+			} {
+				if err := d.Set(k, v); err != nil {
+					return nil, diag.FromErr(err)
+				}
+			}
+		}
+
+		meta, diags := configure(conns.NewHTTPClientContext(ctx, &http.Client{Transport: c}), d)
+
+		if client, ok := meta.(*conns.AWSClient); ok {
+			c.addAccountID(client.AccountID)
+		}
+
+		return meta, diags
+	}
+}
+
+// RandomWithPrefix returns a random name with the specified prefix, e.g. acctest.ResourcePrefix.
+// When recording or replaying AWS API interactions the name is the same on every run of the test.
+func RandomWithPrefix(t *testing.T, prefix string) string {
+	if !vcrEnabled() {
+		return sdkacctest.RandomWithPrefix(prefix)
+	}
+
+	c := vcrCassetteForTest(t)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return fmt.Sprintf("%s-%d", prefix, c.rand.Int())
+}
+
+// Test is a wrapper for resource.Test that records or replays the test's AWS API interactions
+// when the TF_ACC_VCR_MODE environment variable is set.
+func Test(t *testing.T, testCase resource.TestCase) {
+	if vcrEnabled() {
+		vcrCassetteForTest(t)
+	}
+
+	resource.Test(t, testCase)
+}
+
+// ParallelTest is a wrapper for resource.ParallelTest that records or replays the test's AWS API interactions
+// when the TF_ACC_VCR_MODE environment variable is set.
+// Tests are not run in parallel when recording or replaying.
+func ParallelTest(t *testing.T, testCase resource.TestCase) {
+	if vcrEnabled() {
+		Test(t, testCase)
+		return
+	}
+
+	resource.ParallelTest(t, testCase)
+}
@@ -0,0 +1,156 @@
+package acctest
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccVCR_callerIdentity(t *testing.T) {
+	dataSourceName := "data.aws_caller_identity.current"
+
+	ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { PreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "aws_caller_identity" "current" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					CheckResourceAttrAccountID(dataSourceName, "account_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestVCRCassetteRecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		io.WriteString(w, `<GetCallerIdentityResponse><Account>111122223333</Account><AccessKeyId>AKIAEXAMPLE</AccessKeyId></GetCallerIdentityResponse>`)
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	t.Setenv(conns.EnvVarAccVCRPath, dir)
+	path := filepath.Join(dir, "test.json")
+
+	recorder, err := newVCRCassette(vcrModeRecordOnly, path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := &http.Client{Transport: recorder}
+
+	response, err := client.Post(server.URL+"/", "application/x-www-form-urlencoded", strings.NewReader("Action=GetCallerIdentity&Version=2011-06-15"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	response.Body.Close()
+
+	// Bodies of requests not made by AWS SDK for Go v1 API operations are not recorded.
+	if got := recorder.Interactions[0].Request.Body; got != "" {
+		t.Errorf("request body got %q, want none", got)
+	}
+
+	recorder.addAccountID("111122223333")
+
+	if err := recorder.save(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if s := string(b); strings.Contains(s, "111122223333") || strings.Contains(s, "AKIAEXAMPLE") {
+		t.Errorf("cassette not scrubbed: %s", s)
+	}
+
+	replayer, err := newVCRCassette(vcrModeReplayOnly, path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := replayer.Seed, recorder.Seed; got != want {
+		t.Errorf("seed got %d, want %d", got, want)
+	}
+
+	server.Close()
+	client = &http.Client{Transport: replayer}
+
+	response, err = client.Post(server.URL+"/", "application/x-www-form-urlencoded", strings.NewReader("Action=GetCallerIdentity&Version=2011-06-15"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := string(body), `<GetCallerIdentityResponse><Account>123456789012</Account><AccessKeyId>scrubbed</AccessKeyId></GetCallerIdentityResponse>`; got != want {
+		t.Errorf("body got %s, want %s", got, want)
+	}
+
+	if got, want := response.Header.Get("Content-Type"), "text/xml"; got != want {
+		t.Errorf("Content-Type got %s, want %s", got, want)
+	}
+
+	// Each interaction is replayed only once.
+	if _, err := client.Post(server.URL+"/", "application/x-www-form-urlencoded", strings.NewReader("Action=GetCallerIdentity&Version=2011-06-15")); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestVCROperation(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Request  func() *http.Request
+		Body     string
+		Expected string
+	}{
+		{
+			Name: "JSON protocol",
+			Request: func() *http.Request {
+				r := httptest.NewRequest(http.MethodPost, "https://logs.us-west-2.amazonaws.com/", nil)
+				r.Header.Set("X-Amz-Target", "Logs_20140328.DescribeLogGroups")
+				return r
+			},
+			Expected: "Logs_20140328.DescribeLogGroups",
+		},
+		{
+			Name: "Query protocol",
+			Request: func() *http.Request {
+				r := httptest.NewRequest(http.MethodPost, "https://ec2.us-west-2.amazonaws.com/", nil)
+				r.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+				return r
+			},
+			Body:     "Action=DescribeVpcs&Version=2016-11-15",
+			Expected: "DescribeVpcs",
+		},
+		{
+			Name: "REST protocol",
+			Request: func() *http.Request {
+				return httptest.NewRequest(http.MethodGet, "https://lambda.us-west-2.amazonaws.com/2015-03-31/functions/test", nil)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got, want := vcrOperation(testCase.Request(), []byte(testCase.Body)), testCase.Expected; got != want {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// AssumeRoleChainError occurs when one of a chain of IAM Roles cannot be assumed.
+type AssumeRoleChainError struct {
+	Hop     int
+	Hops    int
+	RoleARN string
+	Err     error
+}
+
+func (e *AssumeRoleChainError) Error() string {
+	return fmt.Sprintf("assume_role (hop %d of %d): IAM Role (%s) cannot be assumed: %s", e.Hop, e.Hops, e.RoleARN, e.Err)
+}
+
+func (e *AssumeRoleChainError) Unwrap() error {
+	return e.Err
+}
+
+// assumeRoleChain assumes each of the specified IAM Roles in order, starting from the configuration's credentials.
+// The first hop of the chain, c.AssumeRole, has already been assumed by the time this is called.
+// Each hop's credentials are retrieved eagerly so that a failure identifies the hop.
+func (c *Config) assumeRoleChain(ctx context.Context, cfg aws.Config) (aws.CredentialsProvider, error) {
+	hops := len(c.AssumeRoleChain) + 1
+	credentials := cfg.Credentials
+
+	for i, ar := range c.AssumeRoleChain {
+		hop := i + 2
+
+		log.Printf("[INFO] Assuming IAM Role %q (hop %d of %d, SessionName: %q, ExternalId: %q)", ar.RoleARN, hop, hops, ar.SessionName, ar.ExternalID)
+
+		cfg.Credentials = credentials
+		client := sts.NewFromConfig(cfg, func(o *sts.Options) {
+			if c.STSRegion != "" {
+				o.Region = c.STSRegion
+			}
+
+			if v := c.Endpoints[names.STS]; v != "" {
+				o.EndpointResolver = sts.EndpointResolverFromURL(v)
+			}
+		})
+
+		provider := aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(client, ar.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+			expandAssumeRoleOptions(o, ar)
+		}), func(o *aws.CredentialsCacheOptions) {
+			// Refresh each hop before the credentials of the next hop are refreshed using it.
+			o.ExpiryWindow = credentialsExpiryWindow
+		})
+
+		if _, err := provider.Retrieve(ctx); err != nil {
+			return nil, &AssumeRoleChainError{
+				Hop:     hop,
+				Hops:    hops,
+				RoleARN: ar.RoleARN,
+				Err:     err,
+			}
+		}
+
+		credentials = provider
+	}
+
+	return credentials, nil
+}
+
+func expandAssumeRoleOptions(o *stscreds.AssumeRoleOptions, ar *awsbase.AssumeRole) {
+	o.Duration = ar.Duration
+	o.RoleSessionName = ar.SessionName
+
+	if ar.ExternalID != "" {
+		o.ExternalID = aws.String(ar.ExternalID)
+	}
+
+	if ar.Policy != "" {
+		o.Policy = aws.String(ar.Policy)
+	}
+
+	for _, v := range ar.PolicyARNs {
+		o.PolicyARNs = append(o.PolicyARNs, types.PolicyDescriptorType{
+			Arn: aws.String(v),
+		})
+	}
+
+	for k, v := range ar.Tags {
+		o.Tags = append(o.Tags, types.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	if len(ar.TransitiveTagKeys) > 0 {
+		o.TransitiveTagKeys = ar.TransitiveTagKeys
+	}
+}
@@ -0,0 +1,226 @@
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const testAssumeRoleResponse = `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <AssumedRoleUser>
+      <Arn>%[1]s/%[2]s</Arn>
+      <AssumedRoleId>ARO123EXAMPLE123:%[2]s</AssumedRoleId>
+    </AssumedRoleUser>
+    <Credentials>
+      <AccessKeyId>%[3]s</AccessKeyId>
+      <SecretAccessKey>secret</SecretAccessKey>
+      <SessionToken>token</SessionToken>
+      <Expiration>%[4]s</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleResponse>`
+
+const testAssumeRoleAccessDeniedResponse = `<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>AccessDenied</Code>
+    <Message>User is not authorized to perform: sts:AssumeRole</Message>
+  </Error>
+  <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+</ErrorResponse>`
+
+type testAssumeRoleRequest struct {
+	AccessKeyID     string
+	ExternalID      string
+	RoleARN         string
+	RoleSessionName string
+	DurationSeconds string
+}
+
+// newTestSTSServer returns a test STS endpoint that issues credentials whose access key ID is the assumed role's session name.
+// Requests to assume the denied role fail.
+func newTestSTSServer(t *testing.T, deniedRoleARN string, requests *[]testAssumeRoleRequest) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("parsing request: %s", err)
+		}
+
+		if v := r.PostForm.Get("Action"); v != "AssumeRole" {
+			t.Errorf("unexpected action: %s", v)
+		}
+
+		// Authorization: AWS4-HMAC-SHA256 Credential=<access key ID>/<scope>, SignedHeaders=..., Signature=...
+		accessKeyID := strings.SplitN(strings.TrimPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential="), "/", 2)[0]
+
+		req := testAssumeRoleRequest{
+			AccessKeyID:     accessKeyID,
+			ExternalID:      r.PostForm.Get("ExternalId"),
+			RoleARN:         r.PostForm.Get("RoleArn"),
+			RoleSessionName: r.PostForm.Get("RoleSessionName"),
+			DurationSeconds: r.PostForm.Get("DurationSeconds"),
+		}
+		*requests = append(*requests, req)
+
+		w.Header().Set("Content-Type", "text/xml")
+
+		if req.RoleARN == deniedRoleARN {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, testAssumeRoleAccessDeniedResponse)
+			return
+		}
+
+		fmt.Fprintf(w, testAssumeRoleResponse, req.RoleARN, req.RoleSessionName, req.RoleSessionName, time.Now().Add(1*time.Hour).UTC().Format(time.RFC3339))
+	}))
+}
+
+func TestAssumeRoleChain(t *testing.T) {
+	chain := []*awsbase.AssumeRole{
+		{
+			RoleARN:     "arn:aws:iam::222222222222:role/deployment", //lintignore:AWSAT005
+			SessionName: "deployment",
+			ExternalID:  "deployment-external-id",
+			Duration:    30 * time.Minute,
+		},
+		{
+			RoleARN:     "arn:aws:iam::333333333333:role/workload", //lintignore:AWSAT005
+			SessionName: "workload",
+		},
+	}
+
+	testCases := []struct {
+		Name          string
+		DeniedRoleARN string
+		ExpectedHop   int
+		ExpectedCalls []testAssumeRoleRequest
+	}{
+		{
+			Name: "success",
+			ExpectedCalls: []testAssumeRoleRequest{
+				{
+					AccessKeyID:     "identity",
+					ExternalID:      "deployment-external-id",
+					RoleARN:         chain[0].RoleARN,
+					RoleSessionName: "deployment",
+					DurationSeconds: "1800",
+				},
+				{
+					AccessKeyID:     "deployment",
+					RoleARN:         chain[1].RoleARN,
+					RoleSessionName: "workload",
+					DurationSeconds: "900",
+				},
+			},
+		},
+		{
+			Name:          "second hop denied",
+			DeniedRoleARN: chain[0].RoleARN,
+			ExpectedHop:   2,
+		},
+		{
+			Name:          "third hop denied",
+			DeniedRoleARN: chain[1].RoleARN,
+			ExpectedHop:   3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var requests []testAssumeRoleRequest
+			server := newTestSTSServer(t, testCase.DeniedRoleARN, &requests)
+			defer server.Close()
+
+			c := &Config{
+				AssumeRole: &awsbase.AssumeRole{
+					RoleARN: "arn:aws:iam::111111111111:role/identity", //lintignore:AWSAT005
+				},
+				AssumeRoleChain: chain,
+				Endpoints: map[string]string{
+					names.STS: server.URL,
+				},
+			}
+			cfg := awsv2.Config{
+				Credentials: credentials.NewStaticCredentialsProvider("identity", "secret", "token"),
+				Region:      "us-west-2", //lintignore:AWSAT003
+				Retryer: func() awsv2.Retryer {
+					return awsv2.NopRetryer{}
+				},
+			}
+
+			provider, err := c.assumeRoleChain(context.Background(), cfg)
+
+			if testCase.ExpectedHop != 0 {
+				var chainErr *AssumeRoleChainError
+				if !errors.As(err, &chainErr) {
+					t.Fatalf("expected AssumeRoleChainError, got: %v", err)
+				}
+
+				if chainErr.Hop != testCase.ExpectedHop {
+					t.Errorf("got hop %d, expected %d", chainErr.Hop, testCase.ExpectedHop)
+				}
+
+				if chainErr.Hops != 3 {
+					t.Errorf("got %d hops, expected 3", chainErr.Hops)
+				}
+
+				if chainErr.RoleARN != testCase.DeniedRoleARN {
+					t.Errorf("got role ARN %q, expected %q", chainErr.RoleARN, testCase.DeniedRoleARN)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			creds, err := provider.Retrieve(context.Background())
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := creds.AccessKeyID, "workload"; got != expected {
+				t.Errorf("got access key ID %q, expected %q", got, expected)
+			}
+
+			if len(requests) != len(testCase.ExpectedCalls) {
+				t.Fatalf("got %d requests, expected %d", len(requests), len(testCase.ExpectedCalls))
+			}
+
+			for i, expected := range testCase.ExpectedCalls {
+				if got := requests[i]; got != expected {
+					t.Errorf("request %d: got %+v, expected %+v", i, got, expected)
+				}
+			}
+		})
+	}
+}
+
+func TestAssumeRoleChainErrorError(t *testing.T) {
+	err := &AssumeRoleChainError{
+		Hop:     2,
+		Hops:    3,
+		RoleARN: "arn:aws:iam::222222222222:role/deployment", //lintignore:AWSAT005
+		Err:     errors.New("AccessDenied"),
+	}
+
+	expected := "assume_role (hop 2 of 3): IAM Role (arn:aws:iam::222222222222:role/deployment) cannot be assumed: AccessDenied" //lintignore:AWSAT005
+
+	if got := err.Error(); got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+}
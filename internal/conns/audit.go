@@ -0,0 +1,265 @@
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"reflect"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+const (
+	auditLogRedactedBinary    = "<binary>"
+	auditLogRedactedSensitive = "<sensitive>"
+	auditLogRedactedStream    = "<stream>"
+)
+
+// AuditLogEntry is a JSON lines audit log record of a mutating AWS API operation.
+type AuditLogEntry struct {
+	Timestamp    time.Time   `json:"timestamp"`
+	Service      string      `json:"service"`
+	Operation    string      `json:"operation"`
+	RequestID    string      `json:"request_id,omitempty"`
+	StatusCode   int         `json:"status_code,omitempty"`
+	ErrorCode    string      `json:"error_code,omitempty"`
+	ErrorMessage string      `json:"error_message,omitempty"`
+	Parameters   interface{} `json:"parameters,omitempty"`
+}
+
+// auditLog appends audit log records to a file shared by all clients.
+// The provider is not notified when it is shut down, so the file is opened and closed for each record
+// instead of being kept open, and no records are lost if the provider process exits.
+type auditLog struct {
+	lock sync.Mutex
+	path string
+}
+
+// newAuditLog returns an audit log appending to the specified file, creating it if it does not exist.
+func newAuditLog(path string) (*auditLog, error) {
+	f, err := openAuditLogFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+
+	return &auditLog{path: path}, nil
+}
+
+func openAuditLogFile(path string) (*os.File, error) {
+	return os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+}
+
+// write appends the entry as a single JSON line.
+// Failures are logged rather than failing the API operation.
+func (l *auditLog) write(entry *AuditLogEntry) {
+	b, err := json.Marshal(entry)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to marshal audit log entry (%s %s): %s", entry.Service, entry.Operation, err)
+		return
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	f, err := openAuditLogFile(l.path)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to open audit log (%s): %s", l.path, err)
+		return
+	}
+
+	_, err = f.Write(append(b, '\n'))
+
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		log.Printf("[WARN] Unable to write audit log entry (%s %s): %s", entry.Service, entry.Operation, err)
+	}
+}
+
+// applyAuditLogToSession records mutating API operations made using the specified AWS SDK for Go v1 session.
+// Each operation is recorded once, after any retries, with its parameters redacted.
+func applyAuditLogToSession(sess *session.Session, l *auditLog) {
+	sess.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "TerraformAuditLog",
+		Fn: func(r *request.Request) {
+			if r.Operation == nil || IsReadOnlyOperation(r.Operation.Name) {
+				return
+			}
+
+			entry := &AuditLogEntry{
+				Timestamp:  time.Now().UTC(),
+				Service:    r.ClientInfo.ServiceID,
+				Operation:  r.Operation.Name,
+				RequestID:  r.RequestID,
+				Parameters: redactParameters(reflect.ValueOf(r.Params)),
+			}
+
+			if r.HTTPResponse != nil {
+				entry.StatusCode = r.HTTPResponse.StatusCode
+			}
+
+			if r.Error != nil {
+				var awsErr awserr.Error
+				if errors.As(r.Error, &awsErr) {
+					entry.ErrorCode = awsErr.Code()
+					entry.ErrorMessage = awsErr.Message()
+				} else {
+					entry.ErrorMessage = r.Error.Error()
+				}
+			}
+
+			l.write(entry)
+		},
+	})
+}
+
+// auditLogAPIOption returns an AWS SDK for Go v2 API option that records mutating API operations.
+// Parameters are not recorded, unlike for AWS SDK for Go v1 operations, as AWS SDK for Go v2 API models
+// do not identify sensitive fields and so parameters cannot be redacted.
+func auditLogAPIOption(l *auditLog) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		// Added after the service metadata is registered, wrapping any retries.
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TerraformAuditLog", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			out, metadata, err := next.HandleInitialize(ctx, in)
+
+			operation := awsmiddleware.GetOperationName(ctx)
+
+			if IsReadOnlyOperation(operation) {
+				return out, metadata, err
+			}
+
+			entry := &AuditLogEntry{
+				Timestamp: time.Now().UTC(),
+				Service:   awsmiddleware.GetServiceID(ctx),
+				Operation: operation,
+			}
+
+			if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+				entry.RequestID = v
+			}
+
+			if v, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok {
+				entry.StatusCode = v.StatusCode
+			}
+
+			if err != nil {
+				var respErr *awshttp.ResponseError
+				if errors.As(err, &respErr) {
+					entry.RequestID = respErr.ServiceRequestID()
+					entry.StatusCode = respErr.HTTPStatusCode()
+				}
+
+				var apiErr smithy.APIError
+				if errors.As(err, &apiErr) {
+					entry.ErrorCode = apiErr.ErrorCode()
+					entry.ErrorMessage = apiErr.ErrorMessage()
+				} else {
+					entry.ErrorMessage = err.Error()
+				}
+			}
+
+			l.write(entry)
+
+			return out, metadata, err
+		}), middleware.After)
+	}
+}
+
+// redactParameters returns a JSON serializable copy of AWS SDK for Go v1 API operation parameters.
+// The values of fields marked as sensitive in the API model are redacted, as are binary and streaming values.
+func redactParameters(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+
+		if _, ok := v.Interface().(io.Reader); ok {
+			return auditLogRedactedStream
+		}
+
+		return redactParameters(v.Elem())
+	case reflect.Struct:
+		if t, ok := v.Interface().(time.Time); ok {
+			return t
+		}
+
+		m := make(map[string]interface{})
+
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+
+			if field.PkgPath != "" || field.Name == "_" {
+				continue
+			}
+
+			value := redactParameters(v.Field(i))
+
+			if value == nil {
+				continue
+			}
+
+			if field.Tag.Get("sensitive") == "true" {
+				value = auditLogRedactedSensitive
+			}
+
+			m[field.Name] = value
+		}
+
+		return m
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return auditLogRedactedBinary
+		}
+
+		l := make([]interface{}, v.Len())
+
+		for i := 0; i < v.Len(); i++ {
+			l[i] = redactParameters(v.Index(i))
+		}
+
+		return l
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+
+		m := make(map[string]interface{}, v.Len())
+
+		for _, k := range v.MapKeys() {
+			m[fmt.Sprint(k.Interface())] = redactParameters(v.MapIndex(k))
+		}
+
+		return m
+	case reflect.Invalid, reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return nil
+	}
+
+	return v.Interface()
+}
@@ -0,0 +1,325 @@
+package conns
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kendra"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+func TestRedactParameters(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    interface{}
+		Expected interface{}
+	}{
+		{
+			Name:     "nil",
+			Input:    (*iam.CreateLoginProfileInput)(nil),
+			Expected: nil,
+		},
+		{
+			Name: "sensitive field",
+			Input: &iam.CreateLoginProfileInput{
+				Password: aws.String("secret"),
+				UserName: aws.String("test"),
+			},
+			Expected: map[string]interface{}{
+				"Password": auditLogRedactedSensitive,
+				"UserName": "test",
+			},
+		},
+		{
+			Name: "nested sensitive map",
+			Input: &lambda.UpdateFunctionConfigurationInput{
+				Environment: &lambda.Environment{
+					Variables: map[string]*string{
+						"PASSWORD": aws.String("secret"),
+					},
+				},
+				FunctionName: aws.String("test"),
+				Layers:       aws.StringSlice([]string{"layer1", "layer2"}),
+				MemorySize:   aws.Int64(128),
+			},
+			Expected: map[string]interface{}{
+				"Environment": map[string]interface{}{
+					"Variables": auditLogRedactedSensitive,
+				},
+				"FunctionName": "test",
+				"Layers":       []interface{}{"layer1", "layer2"},
+				"MemorySize":   int64(128),
+			},
+		},
+		{
+			Name: "binary and stream",
+			Input: &s3.PutObjectInput{
+				Body:   strings.NewReader("test"),
+				Bucket: aws.String("test"),
+				Key:    aws.String("test"),
+			},
+			Expected: map[string]interface{}{
+				"Body":   auditLogRedactedStream,
+				"Bucket": "test",
+				"Key":    "test",
+			},
+		},
+		{
+			Name: "bytes",
+			Input: &kinesis.PutRecordInput{
+				Data:         []byte("test"),
+				PartitionKey: aws.String("test"),
+				StreamName:   aws.String("test"),
+			},
+			Expected: map[string]interface{}{
+				"Data":         auditLogRedactedBinary,
+				"PartitionKey": "test",
+				"StreamName":   "test",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := redactParameters(reflect.ValueOf(testCase.Input))
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func testAuditLogEntries(t *testing.T, path string) []*AuditLogEntry {
+	t.Helper()
+
+	f, err := os.Open(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	defer f.Close()
+
+	var entries []*AuditLogEntry
+	scanner := bufio.NewScanner(f)
+
+	for scanner.Scan() {
+		entry := &AuditLogEntry{}
+
+		if err := json.Unmarshal(scanner.Bytes(), entry); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+func TestApplyAuditLogToSession(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	l, err := newAuditLog(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	sess := session.Must(session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+	}))
+	applyAuditLogToSession(sess, l)
+	conn := iam.New(sess)
+
+	req, _ := conn.GetUserRequest(&iam.GetUserInput{})
+	req.Handlers.Complete.Run(req)
+
+	req, _ = conn.CreateLoginProfileRequest(&iam.CreateLoginProfileInput{
+		Password: aws.String("secret"),
+		UserName: aws.String("test"),
+	})
+	req.HTTPResponse = &http.Response{StatusCode: http.StatusConflict}
+	req.RequestID = "request-1"
+	req.Error = awserr.New(iam.ErrCodeEntityAlreadyExistsException, "Login Profile for user test already exists.", nil)
+	req.Handlers.Complete.Run(req)
+
+	entries := testAuditLogEntries(t, path)
+
+	if got, expected := len(entries), 1; got != expected {
+		t.Fatalf("got %d entries, expected %d", got, expected)
+	}
+
+	entry := entries[0]
+	entry.Timestamp = entry.Timestamp.UTC()
+
+	if entry.Timestamp.IsZero() {
+		t.Error("expected timestamp")
+	}
+
+	expected := &AuditLogEntry{
+		Timestamp:    entry.Timestamp,
+		Service:      "IAM",
+		Operation:    "CreateLoginProfile",
+		RequestID:    "request-1",
+		StatusCode:   http.StatusConflict,
+		ErrorCode:    iam.ErrCodeEntityAlreadyExistsException,
+		ErrorMessage: "Login Profile for user test already exists.",
+		Parameters: map[string]interface{}{
+			"Password": auditLogRedactedSensitive,
+			"UserName": "test",
+		},
+	}
+
+	if !reflect.DeepEqual(entry, expected) {
+		t.Errorf("got %#v, expected %#v", entry, expected)
+	}
+}
+
+func TestApplyRedactedParametersToSession(t *testing.T) {
+	sess := session.Must(session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+	}))
+	applyRedactedParametersToSession(sess)
+	conn := iam.New(sess)
+
+	req, _ := conn.CreateLoginProfileRequest(&iam.CreateLoginProfileInput{
+		Password: aws.String("secret"),
+		UserName: aws.String("test"),
+	})
+
+	if err := req.Sign(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, ok := RedactedParametersFromContext(req.HTTPRequest.Context())
+
+	if !ok {
+		t.Fatal("expected redacted parameters")
+	}
+
+	expected := map[string]interface{}{
+		"Password": auditLogRedactedSensitive,
+		"UserName": "test",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %#v, expected %#v", got, expected)
+	}
+
+	if _, ok := RedactedParametersFromContext(context.Background()); ok {
+		t.Error("unexpected redacted parameters")
+	}
+}
+
+func TestAuditLogAPIOption(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	httpClient := &testHTTPClient{}
+	c := &Config{auditLog: &auditLog{path: path}}
+	conn := kendra.New(kendra.Options{
+		APIOptions:  c.apiOptions("kendra"),
+		Credentials: awsv2.AnonymousCredentials{},
+		HTTPClient:  httpClient,
+		Region:      "us-west-2", //lintignore:AWSAT003
+		Retryer:     awsv2.NopRetryer{},
+	})
+
+	if _, err := conn.ListIndices(context.Background(), &kendra.ListIndicesInput{}); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("expected no audit log, got: %v", err)
+	}
+
+	if _, err := conn.DeleteIndex(context.Background(), &kendra.DeleteIndexInput{Id: awsv2.String("test")}); err == nil {
+		t.Fatal("expected error")
+	}
+
+	entries := testAuditLogEntries(t, path)
+
+	if got, expected := len(entries), 1; got != expected {
+		t.Fatalf("got %d entries, expected %d", got, expected)
+	}
+
+	entry := entries[0]
+
+	if got, expected := entry.Operation, "DeleteIndex"; got != expected {
+		t.Errorf("got operation %q, expected %q", got, expected)
+	}
+
+	if entry.ErrorMessage == "" {
+		t.Error("expected error message")
+	}
+
+	if entry.Parameters != nil {
+		t.Errorf("got parameters %#v, expected none", entry.Parameters)
+	}
+}
+
+func TestAuditLogReadOnly(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	c := &Config{
+		auditLog: &auditLog{path: path},
+		ReadOnly: true,
+	}
+	conn := kendra.New(kendra.Options{
+		APIOptions:  c.apiOptions("kendra"),
+		Credentials: awsv2.AnonymousCredentials{},
+		HTTPClient:  &testHTTPClient{},
+		Region:      "us-west-2", //lintignore:AWSAT003
+		Retryer:     awsv2.NopRetryer{},
+	})
+
+	_, err := conn.DeleteIndex(context.Background(), &kendra.DeleteIndexInput{Id: awsv2.String("test")})
+
+	var readOnlyErr *ReadOnlyError
+	if !errors.As(err, &readOnlyErr) {
+		t.Fatalf("expected ReadOnlyError, got %v", err)
+	}
+
+	entries := testAuditLogEntries(t, path)
+
+	if got, expected := len(entries), 1; got != expected {
+		t.Fatalf("got %d entries, expected %d", got, expected)
+	}
+
+	if got, expected := entries[0].ErrorMessage, readOnlyErr.Error(); got != expected {
+		t.Errorf("got error message %q, expected %q", got, expected)
+	}
+}
+
+func TestNewAuditLogAppends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	for i := 0; i < 2; i++ {
+		l, err := newAuditLog(path)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		l.write(&AuditLogEntry{Service: "STS", Operation: "AssumeRole"})
+	}
+
+	if got, expected := len(testAuditLogEntries(t, path)), 2; got != expected {
+		t.Errorf("got %d entries, expected %d", got, expected)
+	}
+}
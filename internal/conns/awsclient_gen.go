@@ -4,8 +4,13 @@ package conns
 import (
 	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
 	"github.com/aws/aws-sdk-go-v2/service/kendra"
+	"github.com/aws/aws-sdk-go-v2/service/pipes"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/account"
@@ -142,7 +147,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/identitystore"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/aws/aws-sdk-go/service/inspector"
-	"github.com/aws/aws-sdk-go/service/inspector2"
 	"github.com/aws/aws-sdk-go/service/iot"
 	"github.com/aws/aws-sdk-go/service/iot1clickdevicesservice"
 	"github.com/aws/aws-sdk-go/service/iot1clickprojects"
@@ -265,7 +269,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
 	"github.com/aws/aws-sdk-go/service/servicequotas"
 	"github.com/aws/aws-sdk-go/service/ses"
-	"github.com/aws/aws-sdk-go/service/sesv2"
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/aws-sdk-go/service/shield"
 	"github.com/aws/aws-sdk-go/service/signer"
@@ -316,6 +319,7 @@ type AWSClient struct {
 	MediaConvertAccountConn   *mediaconvert.MediaConvert
 	Partition                 string
 	Region                    string
+	RequiredTagsConfig        *tftags.RequiredConfig
 	ReverseDNSPrefix          string
 	S3ConnURICleaningDisabled *s3.S3
 	Session                   *session.Session
@@ -455,7 +459,7 @@ type AWSClient struct {
 	IdentityStoreConn                *identitystore.IdentityStore
 	ImageBuilderConn                 *imagebuilder.Imagebuilder
 	InspectorConn                    *inspector.Inspector
-	Inspector2Conn                   *inspector2.Inspector2
+	Inspector2Conn                   *inspector2.Client
 	IoTConn                          *iot.IoT
 	IoT1ClickDevicesConn             *iot1clickdevicesservice.IoT1ClickDevicesService
 	IoT1ClickProjectsConn            *iot1clickprojects.IoT1ClickProjects
@@ -539,6 +543,7 @@ type AWSClient struct {
 	PinpointConn                     *pinpoint.Pinpoint
 	PinpointEmailConn                *pinpointemail.PinpointEmail
 	PinpointSMSVoiceConn             *pinpointsmsvoice.PinpointSMSVoice
+	PipesConn                        *pipes.Client
 	PollyConn                        *polly.Polly
 	PricingConn                      *pricing.Pricing
 	ProtonConn                       *proton.Proton
@@ -567,7 +572,7 @@ type AWSClient struct {
 	S3ControlConn                    *s3control.S3Control
 	S3OutpostsConn                   *s3outposts.S3Outposts
 	SESConn                          *ses.SES
-	SESV2Conn                        *sesv2.SESV2
+	SESV2Conn                        *sesv2.Client
 	SFNConn                          *sfn.SFN
 	SMSConn                          *sms.SMS
 	SNSConn                          *sns.SNS
@@ -586,6 +591,7 @@ type AWSClient struct {
 	SageMakerFeatureStoreRuntimeConn *sagemakerfeaturestoreruntime.SageMakerFeatureStoreRuntime
 	SageMakerRuntimeConn             *sagemakerruntime.SageMakerRuntime
 	SavingsPlansConn                 *savingsplans.SavingsPlans
+	SchedulerConn                    *scheduler.Client
 	SchemasConn                      *schemas.Schemas
 	SecretsManagerConn               *secretsmanager.SecretsManager
 	SecurityHubConn                  *securityhub.SecurityHub
@@ -637,3 +643,26 @@ func (client *AWSClient) PartitionHostname(prefix string) string {
 func (client *AWSClient) RegionalHostname(prefix string) string {
 	return fmt.Sprintf("%s.%s.%s", prefix, client.Region, client.DNSSuffix)
 }
+
+// GlobalARN returns an ARN with the partition and account ID for a global service resource
+// e.g. arn:aws:SERVICE::123456789012:RESOURCE
+func (client *AWSClient) GlobalARN(service, resource string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   service,
+		AccountID: client.AccountID,
+		Resource:  resource,
+	}.String()
+}
+
+// RegionalARN returns an ARN with the partition, region and account ID for a regional service resource
+// e.g. arn:aws:SERVICE:us-west-2:123456789012:RESOURCE
+func (client *AWSClient) RegionalARN(service, resource string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   service,
+		Region:    client.Region,
+		AccountID: client.AccountID,
+		Resource:  resource,
+	}.String()
+}
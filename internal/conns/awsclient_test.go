@@ -77,3 +77,87 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:aws-in-func-name
 		})
 	}
 }
+
+func TestAWSClientGlobalARN(t *testing.T) { // nosemgrep:aws-in-func-name
+	testCases := []struct {
+		Name      string
+		AWSClient *AWSClient
+		Service   string
+		Resource  string
+		Expected  string
+	}{
+		{
+			Name: "AWS Commercial",
+			AWSClient: &AWSClient{
+				AccountID: "123456789012",
+				Partition: "aws",
+			},
+			Service:  "iam",
+			Resource: "role/test",
+			Expected: "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+		},
+		{
+			Name: "AWS GovCloud (US)",
+			AWSClient: &AWSClient{
+				AccountID: "123456789012",
+				Partition: "aws-us-gov",
+			},
+			Service:  "iam",
+			Resource: "role/test",
+			Expected: "arn:aws-us-gov:iam::123456789012:role/test", //lintignore:AWSAT005
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := testCase.AWSClient.GlobalARN(testCase.Service, testCase.Resource)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAWSClientRegionalARN(t *testing.T) { // nosemgrep:aws-in-func-name
+	testCases := []struct {
+		Name      string
+		AWSClient *AWSClient
+		Service   string
+		Resource  string
+		Expected  string
+	}{
+		{
+			Name: "AWS Commercial",
+			AWSClient: &AWSClient{
+				AccountID: "123456789012",
+				Partition: "aws",
+				Region:    "us-west-2", //lintignore:AWSAT003
+			},
+			Service:  "sqs",
+			Resource: "test",
+			Expected: "arn:aws:sqs:us-west-2:123456789012:test", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name: "AWS China",
+			AWSClient: &AWSClient{
+				AccountID: "123456789012",
+				Partition: "aws-cn",
+				Region:    "cn-northwest-1", //lintignore:AWSAT003
+			},
+			Service:  "sqs",
+			Resource: "test",
+			Expected: "arn:aws-cn:sqs:cn-northwest-1:123456789012:test", //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := testCase.AWSClient.RegionalARN(testCase.Service, testCase.Resource)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
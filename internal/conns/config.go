@@ -2,15 +2,23 @@ package conns
 
 import (
 	"context"
+	"errors"
 	"log"
+	"net/http"
+	"reflect"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
 	"github.com/aws/aws-sdk-go-v2/service/kendra"
+	"github.com/aws/aws-sdk-go-v2/service/pipes"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/appconfig"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
@@ -47,8 +55,12 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	AllowedOrganizationIDs         []string
+	AllowedOrganizationalUnitPaths []string
 	AssumeRole                     *awsbase.AssumeRole
+	AssumeRoleChain                []*awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	AuditLogPath                   string
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
@@ -61,7 +73,12 @@ type Config struct {
 	Insecure                       bool
 	MaxRetries                     int
 	Profile                        string
+	RateLimitConfigs               map[string]*RateLimitConfig
+	ReadOnly                       bool
+	ReadOnlyErrorFunc              func(*ReadOnlyError)
 	Region                         string
+	RequiredTagsConfig             *tftags.RequiredConfig
+	RetryConfigs                   map[string]*RetryConfig
 	S3UsePathStyle                 bool
 	SecretKey                      string
 	SharedConfigFiles              []string
@@ -76,6 +93,10 @@ type Config struct {
 	Token                          string
 	UseDualStackEndpoint           bool
 	UseFIPSEndpoint                bool
+
+	auditLog     *auditLog
+	credentials  *credentialsRefresher
+	rateLimiters map[string]*rateLimiter
 }
 
 // Client configures and returns a fully initialized AWSClient
@@ -129,9 +150,31 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 
 	cfg, err := awsbase.GetAwsConfig(ctx, &awsbaseConfig)
 	if err != nil {
+		var assumeRoleErr awsbase.CannotAssumeRoleError
+		if len(c.AssumeRoleChain) > 0 && errors.As(err, &assumeRoleErr) {
+			err = &AssumeRoleChainError{
+				Hop:     1,
+				Hops:    len(c.AssumeRoleChain) + 1,
+				RoleARN: c.AssumeRole.RoleARN,
+				Err:     assumeRoleErr.Err,
+			}
+		}
+
 		return nil, diag.Errorf("error configuring Terraform AWS Provider: %s", err)
 	}
 
+	if len(c.AssumeRoleChain) > 0 {
+		credentials, err := c.assumeRoleChain(ctx, cfg)
+		if err != nil {
+			return nil, diag.Errorf("error configuring Terraform AWS Provider: %s", err)
+		}
+
+		cfg.Credentials = credentials
+	}
+
+	c.credentials = newCredentialsRefresher(cfg.Credentials)
+	cfg.Credentials = c.credentials
+
 	if !c.SkipRegionValidation {
 		if err := awsbase.ValidateRegion(cfg.Region); err != nil {
 			return nil, diag.FromErr(err)
@@ -144,6 +187,28 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		return nil, diag.Errorf("error creating AWS SDK v1 session: %s", err)
 	}
 
+	if httpClient, ok := httpClientFromContext(ctx); ok {
+		cfg.HTTPClient = httpClient
+		sess.Config.HTTPClient = httpClient
+		applyRedactedParametersToSession(sess)
+	}
+
+	applyCredentialsExpiryToSession(sess, c.credentials)
+
+	if c.ReadOnly {
+		applyReadOnlyToSession(sess, c.ReadOnlyErrorFunc)
+	}
+
+	if c.AuditLogPath != "" {
+		auditLog, err := newAuditLog(c.AuditLogPath)
+		if err != nil {
+			return nil, diag.Errorf("error opening audit log (%s): %s", c.AuditLogPath, err)
+		}
+
+		c.auditLog = auditLog
+		applyAuditLogToSession(sess, auditLog)
+	}
+
 	accountID, partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	if err != nil {
 		return nil, diag.Errorf("error retrieving account details: %s", err)
@@ -153,24 +218,8 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		log.Println("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
 
-	if len(c.ForbiddenAccountIds) > 0 {
-		for _, forbiddenAccountID := range c.AllowedAccountIds {
-			if accountID == forbiddenAccountID {
-				return nil, diag.Errorf("AWS Account ID not allowed: %s", accountID)
-			}
-		}
-	}
-	if len(c.AllowedAccountIds) > 0 {
-		found := false
-		for _, allowedAccountID := range c.AllowedAccountIds {
-			if accountID == allowedAccountID {
-				found = true
-				break
-			}
-		}
-		if !found {
-			return nil, diag.Errorf("AWS Account ID not allowed: %s", accountID)
-		}
+	if err := c.checkAccountID(accountID); err != nil {
+		return nil, diag.FromErr(err)
 	}
 
 	DNSSuffix := "amazonaws.com"
@@ -178,6 +227,8 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		DNSSuffix = p.DNSSuffix()
 	}
 
+	c.rateLimiters = newRateLimiters(c.RateLimitConfigs)
+
 	client := c.clientConns(sess)
 
 	client.AccountID = accountID
@@ -186,14 +237,37 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
 	client.Region = c.Region
+	client.RequiredTagsConfig = c.RequiredTagsConfig
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
 	client.Session = sess
 	client.TerraformVersion = c.TerraformVersion
 
+	if err := c.checkAccountOrganization(ctx, client.OrganizationsConn, accountID); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	client.Inspector2Conn = inspector2.NewFromConfig(cfg, func(o *inspector2.Options) {
+		if endpoint := c.Endpoints[names.Inspector2]; endpoint != "" {
+			o.EndpointResolver = inspector2.EndpointResolverFromURL(endpoint)
+		}
+		o.APIOptions = append(o.APIOptions, c.apiOptions(names.Inspector2)...)
+		o.Retryer = c.RetryConfigs[names.Inspector2].retryer(o.Retryer)
+	})
+
 	client.KendraConn = kendra.NewFromConfig(cfg, func(o *kendra.Options) {
 		if endpoint := c.Endpoints[names.Kendra]; endpoint != "" {
 			o.EndpointResolver = kendra.EndpointResolverFromURL(endpoint)
 		}
+		o.APIOptions = append(o.APIOptions, c.apiOptions(names.Kendra)...)
+		o.Retryer = c.RetryConfigs[names.Kendra].retryer(o.Retryer)
+	})
+
+	client.PipesConn = pipes.NewFromConfig(cfg, func(o *pipes.Options) {
+		if endpoint := c.Endpoints[names.Pipes]; endpoint != "" {
+			o.EndpointResolver = pipes.EndpointResolverFromURL(endpoint)
+		}
+		o.APIOptions = append(o.APIOptions, c.apiOptions(names.Pipes)...)
+		o.Retryer = c.RetryConfigs[names.Pipes].retryer(o.Retryer)
 	})
 
 	client.Route53DomainsConn = route53domains.NewFromConfig(cfg, func(o *route53domains.Options) {
@@ -203,6 +277,24 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 			// Route 53 Domains is only available in AWS Commercial us-east-1 Region.
 			o.Region = endpoints.UsEast1RegionID
 		}
+		o.APIOptions = append(o.APIOptions, c.apiOptions(names.Route53Domains)...)
+		o.Retryer = c.RetryConfigs[names.Route53Domains].retryer(o.Retryer)
+	})
+
+	client.SchedulerConn = scheduler.NewFromConfig(cfg, func(o *scheduler.Options) {
+		if endpoint := c.Endpoints[names.Scheduler]; endpoint != "" {
+			o.EndpointResolver = scheduler.EndpointResolverFromURL(endpoint)
+		}
+		o.APIOptions = append(o.APIOptions, c.apiOptions(names.Scheduler)...)
+		o.Retryer = c.RetryConfigs[names.Scheduler].retryer(o.Retryer)
+	})
+
+	client.SESV2Conn = sesv2.NewFromConfig(cfg, func(o *sesv2.Options) {
+		if endpoint := c.Endpoints[names.SESV2]; endpoint != "" {
+			o.EndpointResolver = sesv2.EndpointResolverFromURL(endpoint)
+		}
+		o.APIOptions = append(o.APIOptions, c.apiOptions(names.SESV2)...)
+		o.Retryer = c.RetryConfigs[names.SESV2].retryer(o.Retryer)
 	})
 
 	// sts
@@ -214,7 +306,7 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		stsConfig.Region = aws.String(c.STSRegion)
 	}
 
-	client.STSConn = sts.New(sess.Copy(stsConfig))
+	client.STSConn = sts.New(c.serviceSession(sess, names.STS).Copy(stsConfig))
 
 	// "Global" services that require customizations
 	globalAcceleratorConfig := &aws.Config{
@@ -239,10 +331,10 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		S3ForcePathStyle: aws.Bool(c.S3UsePathStyle),
 	}
 
-	client.S3Conn = s3.New(sess.Copy(s3Config))
+	client.S3Conn = s3.New(c.serviceSession(sess, names.S3).Copy(s3Config))
 
 	s3Config.DisableRestProtocolURICleaning = aws.Bool(true)
-	client.S3ConnURICleaningDisabled = s3.New(sess.Copy(s3Config))
+	client.S3ConnURICleaningDisabled = s3.New(c.serviceSession(sess, names.S3).Copy(s3Config))
 
 	// Force "global" services to correct regions
 	switch partition {
@@ -263,11 +355,11 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		route53Config.Region = aws.String(endpoints.UsGovWest1RegionID)
 	}
 
-	client.GlobalAcceleratorConn = globalaccelerator.New(sess.Copy(globalAcceleratorConfig))
-	client.Route53Conn = route53.New(sess.Copy(route53Config))
-	client.Route53RecoveryControlConfigConn = route53recoverycontrolconfig.New(sess.Copy(route53RecoveryControlConfigConfig))
-	client.Route53RecoveryReadinessConn = route53recoveryreadiness.New(sess.Copy(route53RecoveryReadinessConfig))
-	client.ShieldConn = shield.New(sess.Copy(shieldConfig))
+	client.GlobalAcceleratorConn = globalaccelerator.New(c.serviceSession(sess, names.GlobalAccelerator).Copy(globalAcceleratorConfig))
+	client.Route53Conn = route53.New(c.serviceSession(sess, names.Route53).Copy(route53Config))
+	client.Route53RecoveryControlConfigConn = route53recoverycontrolconfig.New(c.serviceSession(sess, names.Route53RecoveryControlConfig).Copy(route53RecoveryControlConfigConfig))
+	client.Route53RecoveryReadinessConn = route53recoveryreadiness.New(c.serviceSession(sess, names.Route53RecoveryReadiness).Copy(route53RecoveryReadinessConfig))
+	client.ShieldConn = shield.New(c.serviceSession(sess, names.Shield).Copy(shieldConfig))
 
 	client.APIGatewayConn.Handlers.Retry.PushBack(func(r *request.Request) {
 		// Many operations can return an error such as:
@@ -337,8 +429,9 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 			// We only want to retry briefly as the default max retry count would
 			// excessively retry when the error could be legitimate.
 			// We currently depend on the DefaultRetryer exponential backoff here.
-			// ~10 retries gives a fair backoff of a few seconds.
-			if r.RetryCount < 9 {
+			// ~10 retries gives a fair backoff of a few seconds, unless
+			// max_attempts is configured in the provider retry block for the service.
+			if r.RetryCount < c.RetryConfigs[names.ConfigService].maxRetries(9) {
 				r.Retryable = aws.Bool(true)
 			} else {
 				r.Retryable = aws.Bool(false)
@@ -354,8 +447,9 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 			// We only want to retry briefly as the default max retry count would
 			// excessively retry when the error could be legitimate.
 			// We currently depend on the DefaultRetryer exponential backoff here.
-			// ~10 retries gives a fair backoff of a few seconds.
-			if r.RetryCount < 9 {
+			// ~10 retries gives a fair backoff of a few seconds, unless
+			// max_attempts is configured in the provider retry block for the service.
+			if r.RetryCount < c.RetryConfigs[names.ConfigService].maxRetries(9) {
 				r.Retryable = aws.Bool(true)
 			} else {
 				r.Retryable = aws.Bool(false)
@@ -539,3 +633,52 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 
 	return client, nil
 }
+
+type httpClientContextKey struct{}
+
+// NewHTTPClientContext returns a copy of the context that carries the HTTP client
+// used by Client for AWS API requests instead of the default HTTP clients.
+// Credentials validation is not done using the HTTP client.
+func NewHTTPClientContext(ctx context.Context, httpClient *http.Client) context.Context {
+	return context.WithValue(ctx, httpClientContextKey{}, httpClient)
+}
+
+func httpClientFromContext(ctx context.Context) (*http.Client, bool) {
+	httpClient, ok := ctx.Value(httpClientContextKey{}).(*http.Client)
+
+	return httpClient, ok && httpClient != nil
+}
+
+type redactedParametersContextKey struct{}
+
+// RedactedParametersFromContext returns the parameters, with sensitive values redacted, of the
+// AWS SDK for Go v1 API operation that made the HTTP request with the specified context.
+// Parameters are only available to the HTTP client carried by NewHTTPClientContext.
+func RedactedParametersFromContext(ctx context.Context) (interface{}, bool) {
+	v := ctx.Value(redactedParametersContextKey{})
+
+	return v, v != nil
+}
+
+// applyRedactedParametersToSession adds the redacted parameters of each AWS SDK for Go v1 API operation
+// made using the specified session to the context of its HTTP requests.
+func applyRedactedParametersToSession(sess *session.Session) {
+	// Sign handlers are run before each attempt, after the HTTP request is built or copied for a retry.
+	sess.Handlers.Sign.PushBackNamed(request.NamedHandler{
+		Name: "TerraformRedactedParameters",
+		Fn: func(r *request.Request) {
+			ctx := context.WithValue(r.HTTPRequest.Context(), redactedParametersContextKey{}, redactParameters(reflect.ValueOf(r.Params)))
+			r.HTTPRequest = r.HTTPRequest.WithContext(ctx)
+		},
+	})
+}
+
+// serviceSession returns a copy of the AWS SDK for Go v1 session customized for the specified service.
+func (c *Config) serviceSession(sess *session.Session, service string) *session.Session {
+	sess = sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[service])})
+
+	c.RetryConfigs[service].applyToSession(sess)
+	c.applyRequestHandlersToSession(sess, service)
+
+	return sess
+}
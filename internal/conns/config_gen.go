@@ -2,7 +2,6 @@
 package conns
 
 import (
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/account"
@@ -138,7 +137,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/identitystore"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/aws/aws-sdk-go/service/inspector"
-	"github.com/aws/aws-sdk-go/service/inspector2"
 	"github.com/aws/aws-sdk-go/service/iot"
 	"github.com/aws/aws-sdk-go/service/iot1clickdevicesservice"
 	"github.com/aws/aws-sdk-go/service/iot1clickprojects"
@@ -257,7 +255,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
 	"github.com/aws/aws-sdk-go/service/servicequotas"
 	"github.com/aws/aws-sdk-go/service/ses"
-	"github.com/aws/aws-sdk-go/service/sesv2"
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/aws-sdk-go/service/signer"
 	"github.com/aws/aws-sdk-go/service/simpledb"
@@ -300,296 +297,294 @@ import (
 
 func (c *Config) clientConns(sess *session.Session) *AWSClient {
 	return &AWSClient{
-		ACMConn:                          acm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ACM])})),
-		ACMPCAConn:                       acmpca.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ACMPCA])})),
-		AMPConn:                          prometheusservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AMP])})),
-		APIGatewayConn:                   apigateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGateway])})),
-		APIGatewayManagementAPIConn:      apigatewaymanagementapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGatewayManagementAPI])})),
-		APIGatewayV2Conn:                 apigatewayv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGatewayV2])})),
-		AccessAnalyzerConn:               accessanalyzer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AccessAnalyzer])})),
-		AccountConn:                      account.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Account])})),
-		AlexaForBusinessConn:             alexaforbusiness.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AlexaForBusiness])})),
-		AmplifyConn:                      amplify.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Amplify])})),
-		AmplifyBackendConn:               amplifybackend.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AmplifyBackend])})),
-		AmplifyUIBuilderConn:             amplifyuibuilder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AmplifyUIBuilder])})),
-		AppAutoScalingConn:               applicationautoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppAutoScaling])})),
-		AppConfigConn:                    appconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppConfig])})),
-		AppConfigDataConn:                appconfigdata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppConfigData])})),
-		AppFlowConn:                      appflow.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppFlow])})),
-		AppIntegrationsConn:              appintegrationsservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppIntegrations])})),
-		AppMeshConn:                      appmesh.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppMesh])})),
-		AppRunnerConn:                    apprunner.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppRunner])})),
-		AppStreamConn:                    appstream.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppStream])})),
-		AppSyncConn:                      appsync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppSync])})),
-		ApplicationCostProfilerConn:      applicationcostprofiler.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ApplicationCostProfiler])})),
-		ApplicationInsightsConn:          applicationinsights.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ApplicationInsights])})),
-		AthenaConn:                       athena.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Athena])})),
-		AuditManagerConn:                 auditmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AuditManager])})),
-		AutoScalingConn:                  autoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AutoScaling])})),
-		AutoScalingPlansConn:             autoscalingplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AutoScalingPlans])})),
-		BackupConn:                       backup.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Backup])})),
-		BackupGatewayConn:                backupgateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.BackupGateway])})),
-		BatchConn:                        batch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Batch])})),
-		BillingConductorConn:             billingconductor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.BillingConductor])})),
-		BraketConn:                       braket.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Braket])})),
-		BudgetsConn:                      budgets.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Budgets])})),
-		CEConn:                           costexplorer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CE])})),
-		CURConn:                          costandusagereportservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CUR])})),
-		ChimeConn:                        chime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Chime])})),
-		ChimeSDKIdentityConn:             chimesdkidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKIdentity])})),
-		ChimeSDKMeetingsConn:             chimesdkmeetings.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKMeetings])})),
-		ChimeSDKMessagingConn:            chimesdkmessaging.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKMessaging])})),
-		Cloud9Conn:                       cloud9.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Cloud9])})),
-		CloudControlConn:                 cloudcontrolapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudControl])})),
-		CloudDirectoryConn:               clouddirectory.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudDirectory])})),
-		CloudFormationConn:               cloudformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudFormation])})),
-		CloudFrontConn:                   cloudfront.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudFront])})),
-		CloudHSMV2Conn:                   cloudhsmv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudHSMV2])})),
-		CloudSearchConn:                  cloudsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudSearch])})),
-		CloudSearchDomainConn:            cloudsearchdomain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudSearchDomain])})),
-		CloudTrailConn:                   cloudtrail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudTrail])})),
-		CloudWatchConn:                   cloudwatch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudWatch])})),
-		CodeArtifactConn:                 codeartifact.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeArtifact])})),
-		CodeBuildConn:                    codebuild.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeBuild])})),
-		CodeCommitConn:                   codecommit.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeCommit])})),
-		CodeGuruProfilerConn:             codeguruprofiler.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeGuruProfiler])})),
-		CodeGuruReviewerConn:             codegurureviewer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeGuruReviewer])})),
-		CodePipelineConn:                 codepipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodePipeline])})),
-		CodeStarConn:                     codestar.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStar])})),
-		CodeStarConnectionsConn:          codestarconnections.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStarConnections])})),
-		CodeStarNotificationsConn:        codestarnotifications.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStarNotifications])})),
-		CognitoIDPConn:                   cognitoidentityprovider.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoIDP])})),
-		CognitoIdentityConn:              cognitoidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoIdentity])})),
-		CognitoSyncConn:                  cognitosync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoSync])})),
-		ComprehendConn:                   comprehend.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Comprehend])})),
-		ComprehendMedicalConn:            comprehendmedical.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ComprehendMedical])})),
-		ComputeOptimizerConn:             computeoptimizer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ComputeOptimizer])})),
-		ConfigServiceConn:                configservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConfigService])})),
-		ConnectConn:                      connect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Connect])})),
-		ConnectContactLensConn:           connectcontactlens.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConnectContactLens])})),
-		ConnectParticipantConn:           connectparticipant.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConnectParticipant])})),
-		CustomerProfilesConn:             customerprofiles.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CustomerProfiles])})),
-		DAXConn:                          dax.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DAX])})),
-		DLMConn:                          dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DLM])})),
-		DMSConn:                          databasemigrationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DMS])})),
-		DRSConn:                          drs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DRS])})),
-		DSConn:                           directoryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DS])})),
-		DataBrewConn:                     gluedatabrew.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataBrew])})),
-		DataExchangeConn:                 dataexchange.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataExchange])})),
-		DataPipelineConn:                 datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataPipeline])})),
-		DataSyncConn:                     datasync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataSync])})),
-		DeployConn:                       codedeploy.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Deploy])})),
-		DetectiveConn:                    detective.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Detective])})),
-		DevOpsGuruConn:                   devopsguru.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DevOpsGuru])})),
-		DeviceFarmConn:                   devicefarm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DeviceFarm])})),
-		DirectConnectConn:                directconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DirectConnect])})),
-		DiscoveryConn:                    applicationdiscoveryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Discovery])})),
-		DocDBConn:                        docdb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DocDB])})),
-		DynamoDBConn:                     dynamodb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DynamoDB])})),
-		DynamoDBStreamsConn:              dynamodbstreams.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DynamoDBStreams])})),
-		EBSConn:                          ebs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EBS])})),
-		EC2Conn:                          ec2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EC2])})),
-		EC2InstanceConnectConn:           ec2instanceconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EC2InstanceConnect])})),
-		ECRConn:                          ecr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECR])})),
-		ECRPublicConn:                    ecrpublic.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECRPublic])})),
-		ECSConn:                          ecs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECS])})),
-		EFSConn:                          efs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EFS])})),
-		EKSConn:                          eks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EKS])})),
-		ELBConn:                          elb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ELB])})),
-		ELBV2Conn:                        elbv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ELBV2])})),
-		EMRConn:                          emr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMR])})),
-		EMRContainersConn:                emrcontainers.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMRContainers])})),
-		EMRServerlessConn:                emrserverless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMRServerless])})),
-		ElastiCacheConn:                  elasticache.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElastiCache])})),
-		ElasticBeanstalkConn:             elasticbeanstalk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticBeanstalk])})),
-		ElasticInferenceConn:             elasticinference.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticInference])})),
-		ElasticTranscoderConn:            elastictranscoder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticTranscoder])})),
-		ElasticsearchConn:                elasticsearchservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Elasticsearch])})),
-		EventsConn:                       eventbridge.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Events])})),
-		EvidentlyConn:                    cloudwatchevidently.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Evidently])})),
-		FISConn:                          fis.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FIS])})),
-		FMSConn:                          fms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FMS])})),
-		FSxConn:                          fsx.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FSx])})),
-		FinSpaceConn:                     finspace.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FinSpace])})),
-		FinSpaceDataConn:                 finspacedata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FinSpaceData])})),
-		FirehoseConn:                     firehose.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Firehose])})),
-		ForecastConn:                     forecastservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Forecast])})),
-		ForecastQueryConn:                forecastqueryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ForecastQuery])})),
-		FraudDetectorConn:                frauddetector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FraudDetector])})),
-		GameLiftConn:                     gamelift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GameLift])})),
-		GlacierConn:                      glacier.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Glacier])})),
-		GlueConn:                         glue.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Glue])})),
-		GrafanaConn:                      managedgrafana.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Grafana])})),
-		GreengrassConn:                   greengrass.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Greengrass])})),
-		GreengrassV2Conn:                 greengrassv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GreengrassV2])})),
-		GroundStationConn:                groundstation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GroundStation])})),
-		GuardDutyConn:                    guardduty.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GuardDuty])})),
-		HealthConn:                       health.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Health])})),
-		HealthLakeConn:                   healthlake.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.HealthLake])})),
-		HoneycodeConn:                    honeycode.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Honeycode])})),
-		IAMConn:                          iam.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IAM])})),
-		IVSConn:                          ivs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IVS])})),
-		IdentityStoreConn:                identitystore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IdentityStore])})),
-		ImageBuilderConn:                 imagebuilder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ImageBuilder])})),
-		InspectorConn:                    inspector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Inspector])})),
-		Inspector2Conn:                   inspector2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Inspector2])})),
-		IoTConn:                          iot.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT])})),
-		IoT1ClickDevicesConn:             iot1clickdevicesservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT1ClickDevices])})),
-		IoT1ClickProjectsConn:            iot1clickprojects.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT1ClickProjects])})),
-		IoTAnalyticsConn:                 iotanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTAnalytics])})),
-		IoTDataConn:                      iotdataplane.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTData])})),
-		IoTDeviceAdvisorConn:             iotdeviceadvisor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTDeviceAdvisor])})),
-		IoTEventsConn:                    iotevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTEvents])})),
-		IoTEventsDataConn:                ioteventsdata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTEventsData])})),
-		IoTFleetHubConn:                  iotfleethub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTFleetHub])})),
-		IoTJobsDataConn:                  iotjobsdataplane.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTJobsData])})),
-		IoTSecureTunnelingConn:           iotsecuretunneling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTSecureTunneling])})),
-		IoTSiteWiseConn:                  iotsitewise.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTSiteWise])})),
-		IoTThingsGraphConn:               iotthingsgraph.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTThingsGraph])})),
-		IoTTwinMakerConn:                 iottwinmaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTTwinMaker])})),
-		IoTWirelessConn:                  iotwireless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTWireless])})),
-		KMSConn:                          kms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KMS])})),
-		KafkaConn:                        kafka.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Kafka])})),
-		KafkaConnectConn:                 kafkaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KafkaConnect])})),
-		KeyspacesConn:                    keyspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Keyspaces])})),
-		KinesisConn:                      kinesis.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Kinesis])})),
-		KinesisAnalyticsConn:             kinesisanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisAnalytics])})),
-		KinesisAnalyticsV2Conn:           kinesisanalyticsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisAnalyticsV2])})),
-		KinesisVideoConn:                 kinesisvideo.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideo])})),
-		KinesisVideoArchivedMediaConn:    kinesisvideoarchivedmedia.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoArchivedMedia])})),
-		KinesisVideoMediaConn:            kinesisvideomedia.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoMedia])})),
-		KinesisVideoSignalingConn:        kinesisvideosignalingchannels.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoSignaling])})),
-		LakeFormationConn:                lakeformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LakeFormation])})),
-		LambdaConn:                       lambda.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Lambda])})),
-		LexModelsConn:                    lexmodelbuildingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexModels])})),
-		LexModelsV2Conn:                  lexmodelsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexModelsV2])})),
-		LexRuntimeConn:                   lexruntimeservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexRuntime])})),
-		LexRuntimeV2Conn:                 lexruntimev2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexRuntimeV2])})),
-		LicenseManagerConn:               licensemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LicenseManager])})),
-		LightsailConn:                    lightsail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Lightsail])})),
-		LocationConn:                     locationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Location])})),
-		LogsConn:                         cloudwatchlogs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Logs])})),
-		LookoutEquipmentConn:             lookoutequipment.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutEquipment])})),
-		LookoutMetricsConn:               lookoutmetrics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutMetrics])})),
-		LookoutVisionConn:                lookoutforvision.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutVision])})),
-		MQConn:                           mq.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MQ])})),
-		MTurkConn:                        mturk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MTurk])})),
-		MWAAConn:                         mwaa.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MWAA])})),
-		MachineLearningConn:              machinelearning.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MachineLearning])})),
-		MacieConn:                        macie.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Macie])})),
-		Macie2Conn:                       macie2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Macie2])})),
-		ManagedBlockchainConn:            managedblockchain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ManagedBlockchain])})),
-		MarketplaceCatalogConn:           marketplacecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceCatalog])})),
-		MarketplaceCommerceAnalyticsConn: marketplacecommerceanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceCommerceAnalytics])})),
-		MarketplaceEntitlementConn:       marketplaceentitlementservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceEntitlement])})),
-		MarketplaceMeteringConn:          marketplacemetering.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceMetering])})),
-		MediaConnectConn:                 mediaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaConnect])})),
-		MediaConvertConn:                 mediaconvert.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaConvert])})),
-		MediaLiveConn:                    medialive.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaLive])})),
-		MediaPackageConn:                 mediapackage.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaPackage])})),
-		MediaPackageVODConn:              mediapackagevod.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaPackageVOD])})),
-		MediaStoreConn:                   mediastore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaStore])})),
-		MediaStoreDataConn:               mediastoredata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaStoreData])})),
-		MediaTailorConn:                  mediatailor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaTailor])})),
-		MemoryDBConn:                     memorydb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MemoryDB])})),
-		MgHConn:                          migrationhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MgH])})),
-		MgnConn:                          mgn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Mgn])})),
-		MigrationHubConfigConn:           migrationhubconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubConfig])})),
-		MigrationHubRefactorSpacesConn:   migrationhubrefactorspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubRefactorSpaces])})),
-		MigrationHubStrategyConn:         migrationhubstrategyrecommendations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubStrategy])})),
-		MobileConn:                       mobile.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Mobile])})),
-		NeptuneConn:                      neptune.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Neptune])})),
-		NetworkFirewallConn:              networkfirewall.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.NetworkFirewall])})),
-		NetworkManagerConn:               networkmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.NetworkManager])})),
-		NimbleConn:                       nimblestudio.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Nimble])})),
-		OpenSearchConn:                   opensearchservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpenSearch])})),
-		OpsWorksConn:                     opsworks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpsWorks])})),
-		OpsWorksCMConn:                   opsworkscm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpsWorksCM])})),
-		OrganizationsConn:                organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Organizations])})),
-		OutpostsConn:                     outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Outposts])})),
-		PIConn:                           pi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PI])})),
-		PanoramaConn:                     panorama.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Panorama])})),
-		PersonalizeConn:                  personalize.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Personalize])})),
-		PersonalizeEventsConn:            personalizeevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PersonalizeEvents])})),
-		PersonalizeRuntimeConn:           personalizeruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PersonalizeRuntime])})),
-		PinpointConn:                     pinpoint.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Pinpoint])})),
-		PinpointEmailConn:                pinpointemail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PinpointEmail])})),
-		PinpointSMSVoiceConn:             pinpointsmsvoice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PinpointSMSVoice])})),
-		PollyConn:                        polly.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Polly])})),
-		PricingConn:                      pricing.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Pricing])})),
-		ProtonConn:                       proton.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Proton])})),
-		QLDBConn:                         qldb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QLDB])})),
-		QLDBSessionConn:                  qldbsession.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QLDBSession])})),
-		QuickSightConn:                   quicksight.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QuickSight])})),
-		RAMConn:                          ram.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RAM])})),
-		RBinConn:                         recyclebin.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RBin])})),
-		RDSConn:                          rds.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RDS])})),
-		RDSDataConn:                      rdsdataservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RDSData])})),
-		RUMConn:                          cloudwatchrum.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RUM])})),
-		RedshiftConn:                     redshift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Redshift])})),
-		RedshiftDataConn:                 redshiftdataapiservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RedshiftData])})),
-		RekognitionConn:                  rekognition.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Rekognition])})),
-		ResilienceHubConn:                resiliencehub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResilienceHub])})),
-		ResourceGroupsConn:               resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResourceGroups])})),
-		ResourceGroupsTaggingAPIConn:     resourcegroupstaggingapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResourceGroupsTaggingAPI])})),
-		RoboMakerConn:                    robomaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RoboMaker])})),
-		Route53RecoveryClusterConn:       route53recoverycluster.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Route53RecoveryCluster])})),
-		Route53ResolverConn:              route53resolver.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Route53Resolver])})),
-		S3ControlConn:                    s3control.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.S3Control])})),
-		S3OutpostsConn:                   s3outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.S3Outposts])})),
-		SESConn:                          ses.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SES])})),
-		SESV2Conn:                        sesv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SESV2])})),
-		SFNConn:                          sfn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SFN])})),
-		SMSConn:                          sms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SMS])})),
-		SNSConn:                          sns.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SNS])})),
-		SQSConn:                          sqs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SQS])})),
-		SSMConn:                          ssm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSM])})),
-		SSMContactsConn:                  ssmcontacts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSMContacts])})),
-		SSMIncidentsConn:                 ssmincidents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSMIncidents])})),
-		SSOConn:                          sso.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSO])})),
-		SSOAdminConn:                     ssoadmin.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSOAdmin])})),
-		SSOOIDCConn:                      ssooidc.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSOOIDC])})),
-		SWFConn:                          swf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SWF])})),
-		SageMakerConn:                    sagemaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMaker])})),
-		SageMakerA2IRuntimeConn:          augmentedairuntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerA2IRuntime])})),
-		SageMakerEdgeConn:                sagemakeredgemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerEdge])})),
-		SageMakerFeatureStoreRuntimeConn: sagemakerfeaturestoreruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerFeatureStoreRuntime])})),
-		SageMakerRuntimeConn:             sagemakerruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerRuntime])})),
-		SavingsPlansConn:                 savingsplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SavingsPlans])})),
-		SchemasConn:                      schemas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Schemas])})),
-		SecretsManagerConn:               secretsmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SecretsManager])})),
-		SecurityHubConn:                  securityhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SecurityHub])})),
-		ServerlessRepoConn:               serverlessapplicationrepository.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServerlessRepo])})),
-		ServiceCatalogConn:               servicecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceCatalog])})),
-		ServiceCatalogAppRegistryConn:    appregistry.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceCatalogAppRegistry])})),
-		ServiceDiscoveryConn:             servicediscovery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceDiscovery])})),
-		ServiceQuotasConn:                servicequotas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceQuotas])})),
-		SignerConn:                       signer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Signer])})),
-		SimpleDBConn:                     simpledb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SimpleDB])})),
-		SnowDeviceManagementConn:         snowdevicemanagement.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SnowDeviceManagement])})),
-		SnowballConn:                     snowball.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Snowball])})),
-		StorageGatewayConn:               storagegateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.StorageGateway])})),
-		SupportConn:                      support.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Support])})),
-		SyntheticsConn:                   synthetics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Synthetics])})),
-		TextractConn:                     textract.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Textract])})),
-		TimestreamQueryConn:              timestreamquery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TimestreamQuery])})),
-		TimestreamWriteConn:              timestreamwrite.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TimestreamWrite])})),
-		TranscribeConn:                   transcribeservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Transcribe])})),
-		TranscribeStreamingConn:          transcribestreamingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TranscribeStreaming])})),
-		TransferConn:                     transfer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Transfer])})),
-		TranslateConn:                    translate.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Translate])})),
-		VoiceIDConn:                      voiceid.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.VoiceID])})),
-		WAFConn:                          waf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAF])})),
-		WAFRegionalConn:                  wafregional.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAFRegional])})),
-		WAFV2Conn:                        wafv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAFV2])})),
-		WellArchitectedConn:              wellarchitected.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WellArchitected])})),
-		WisdomConn:                       connectwisdomservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Wisdom])})),
-		WorkDocsConn:                     workdocs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkDocs])})),
-		WorkLinkConn:                     worklink.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkLink])})),
-		WorkMailConn:                     workmail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkMail])})),
-		WorkMailMessageFlowConn:          workmailmessageflow.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkMailMessageFlow])})),
-		WorkSpacesConn:                   workspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkSpaces])})),
-		WorkSpacesWebConn:                workspacesweb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkSpacesWeb])})),
-		XRayConn:                         xray.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.XRay])})),
+		ACMConn:                          acm.New(c.serviceSession(sess, names.ACM)),
+		ACMPCAConn:                       acmpca.New(c.serviceSession(sess, names.ACMPCA)),
+		AMPConn:                          prometheusservice.New(c.serviceSession(sess, names.AMP)),
+		APIGatewayConn:                   apigateway.New(c.serviceSession(sess, names.APIGateway)),
+		APIGatewayManagementAPIConn:      apigatewaymanagementapi.New(c.serviceSession(sess, names.APIGatewayManagementAPI)),
+		APIGatewayV2Conn:                 apigatewayv2.New(c.serviceSession(sess, names.APIGatewayV2)),
+		AccessAnalyzerConn:               accessanalyzer.New(c.serviceSession(sess, names.AccessAnalyzer)),
+		AccountConn:                      account.New(c.serviceSession(sess, names.Account)),
+		AlexaForBusinessConn:             alexaforbusiness.New(c.serviceSession(sess, names.AlexaForBusiness)),
+		AmplifyConn:                      amplify.New(c.serviceSession(sess, names.Amplify)),
+		AmplifyBackendConn:               amplifybackend.New(c.serviceSession(sess, names.AmplifyBackend)),
+		AmplifyUIBuilderConn:             amplifyuibuilder.New(c.serviceSession(sess, names.AmplifyUIBuilder)),
+		AppAutoScalingConn:               applicationautoscaling.New(c.serviceSession(sess, names.AppAutoScaling)),
+		AppConfigConn:                    appconfig.New(c.serviceSession(sess, names.AppConfig)),
+		AppConfigDataConn:                appconfigdata.New(c.serviceSession(sess, names.AppConfigData)),
+		AppFlowConn:                      appflow.New(c.serviceSession(sess, names.AppFlow)),
+		AppIntegrationsConn:              appintegrationsservice.New(c.serviceSession(sess, names.AppIntegrations)),
+		AppMeshConn:                      appmesh.New(c.serviceSession(sess, names.AppMesh)),
+		AppRunnerConn:                    apprunner.New(c.serviceSession(sess, names.AppRunner)),
+		AppStreamConn:                    appstream.New(c.serviceSession(sess, names.AppStream)),
+		AppSyncConn:                      appsync.New(c.serviceSession(sess, names.AppSync)),
+		ApplicationCostProfilerConn:      applicationcostprofiler.New(c.serviceSession(sess, names.ApplicationCostProfiler)),
+		ApplicationInsightsConn:          applicationinsights.New(c.serviceSession(sess, names.ApplicationInsights)),
+		AthenaConn:                       athena.New(c.serviceSession(sess, names.Athena)),
+		AuditManagerConn:                 auditmanager.New(c.serviceSession(sess, names.AuditManager)),
+		AutoScalingConn:                  autoscaling.New(c.serviceSession(sess, names.AutoScaling)),
+		AutoScalingPlansConn:             autoscalingplans.New(c.serviceSession(sess, names.AutoScalingPlans)),
+		BackupConn:                       backup.New(c.serviceSession(sess, names.Backup)),
+		BackupGatewayConn:                backupgateway.New(c.serviceSession(sess, names.BackupGateway)),
+		BatchConn:                        batch.New(c.serviceSession(sess, names.Batch)),
+		BillingConductorConn:             billingconductor.New(c.serviceSession(sess, names.BillingConductor)),
+		BraketConn:                       braket.New(c.serviceSession(sess, names.Braket)),
+		BudgetsConn:                      budgets.New(c.serviceSession(sess, names.Budgets)),
+		CEConn:                           costexplorer.New(c.serviceSession(sess, names.CE)),
+		CURConn:                          costandusagereportservice.New(c.serviceSession(sess, names.CUR)),
+		ChimeConn:                        chime.New(c.serviceSession(sess, names.Chime)),
+		ChimeSDKIdentityConn:             chimesdkidentity.New(c.serviceSession(sess, names.ChimeSDKIdentity)),
+		ChimeSDKMeetingsConn:             chimesdkmeetings.New(c.serviceSession(sess, names.ChimeSDKMeetings)),
+		ChimeSDKMessagingConn:            chimesdkmessaging.New(c.serviceSession(sess, names.ChimeSDKMessaging)),
+		Cloud9Conn:                       cloud9.New(c.serviceSession(sess, names.Cloud9)),
+		CloudControlConn:                 cloudcontrolapi.New(c.serviceSession(sess, names.CloudControl)),
+		CloudDirectoryConn:               clouddirectory.New(c.serviceSession(sess, names.CloudDirectory)),
+		CloudFormationConn:               cloudformation.New(c.serviceSession(sess, names.CloudFormation)),
+		CloudFrontConn:                   cloudfront.New(c.serviceSession(sess, names.CloudFront)),
+		CloudHSMV2Conn:                   cloudhsmv2.New(c.serviceSession(sess, names.CloudHSMV2)),
+		CloudSearchConn:                  cloudsearch.New(c.serviceSession(sess, names.CloudSearch)),
+		CloudSearchDomainConn:            cloudsearchdomain.New(c.serviceSession(sess, names.CloudSearchDomain)),
+		CloudTrailConn:                   cloudtrail.New(c.serviceSession(sess, names.CloudTrail)),
+		CloudWatchConn:                   cloudwatch.New(c.serviceSession(sess, names.CloudWatch)),
+		CodeArtifactConn:                 codeartifact.New(c.serviceSession(sess, names.CodeArtifact)),
+		CodeBuildConn:                    codebuild.New(c.serviceSession(sess, names.CodeBuild)),
+		CodeCommitConn:                   codecommit.New(c.serviceSession(sess, names.CodeCommit)),
+		CodeGuruProfilerConn:             codeguruprofiler.New(c.serviceSession(sess, names.CodeGuruProfiler)),
+		CodeGuruReviewerConn:             codegurureviewer.New(c.serviceSession(sess, names.CodeGuruReviewer)),
+		CodePipelineConn:                 codepipeline.New(c.serviceSession(sess, names.CodePipeline)),
+		CodeStarConn:                     codestar.New(c.serviceSession(sess, names.CodeStar)),
+		CodeStarConnectionsConn:          codestarconnections.New(c.serviceSession(sess, names.CodeStarConnections)),
+		CodeStarNotificationsConn:        codestarnotifications.New(c.serviceSession(sess, names.CodeStarNotifications)),
+		CognitoIDPConn:                   cognitoidentityprovider.New(c.serviceSession(sess, names.CognitoIDP)),
+		CognitoIdentityConn:              cognitoidentity.New(c.serviceSession(sess, names.CognitoIdentity)),
+		CognitoSyncConn:                  cognitosync.New(c.serviceSession(sess, names.CognitoSync)),
+		ComprehendConn:                   comprehend.New(c.serviceSession(sess, names.Comprehend)),
+		ComprehendMedicalConn:            comprehendmedical.New(c.serviceSession(sess, names.ComprehendMedical)),
+		ComputeOptimizerConn:             computeoptimizer.New(c.serviceSession(sess, names.ComputeOptimizer)),
+		ConfigServiceConn:                configservice.New(c.serviceSession(sess, names.ConfigService)),
+		ConnectConn:                      connect.New(c.serviceSession(sess, names.Connect)),
+		ConnectContactLensConn:           connectcontactlens.New(c.serviceSession(sess, names.ConnectContactLens)),
+		ConnectParticipantConn:           connectparticipant.New(c.serviceSession(sess, names.ConnectParticipant)),
+		CustomerProfilesConn:             customerprofiles.New(c.serviceSession(sess, names.CustomerProfiles)),
+		DAXConn:                          dax.New(c.serviceSession(sess, names.DAX)),
+		DLMConn:                          dlm.New(c.serviceSession(sess, names.DLM)),
+		DMSConn:                          databasemigrationservice.New(c.serviceSession(sess, names.DMS)),
+		DRSConn:                          drs.New(c.serviceSession(sess, names.DRS)),
+		DSConn:                           directoryservice.New(c.serviceSession(sess, names.DS)),
+		DataBrewConn:                     gluedatabrew.New(c.serviceSession(sess, names.DataBrew)),
+		DataExchangeConn:                 dataexchange.New(c.serviceSession(sess, names.DataExchange)),
+		DataPipelineConn:                 datapipeline.New(c.serviceSession(sess, names.DataPipeline)),
+		DataSyncConn:                     datasync.New(c.serviceSession(sess, names.DataSync)),
+		DeployConn:                       codedeploy.New(c.serviceSession(sess, names.Deploy)),
+		DetectiveConn:                    detective.New(c.serviceSession(sess, names.Detective)),
+		DevOpsGuruConn:                   devopsguru.New(c.serviceSession(sess, names.DevOpsGuru)),
+		DeviceFarmConn:                   devicefarm.New(c.serviceSession(sess, names.DeviceFarm)),
+		DirectConnectConn:                directconnect.New(c.serviceSession(sess, names.DirectConnect)),
+		DiscoveryConn:                    applicationdiscoveryservice.New(c.serviceSession(sess, names.Discovery)),
+		DocDBConn:                        docdb.New(c.serviceSession(sess, names.DocDB)),
+		DynamoDBConn:                     dynamodb.New(c.serviceSession(sess, names.DynamoDB)),
+		DynamoDBStreamsConn:              dynamodbstreams.New(c.serviceSession(sess, names.DynamoDBStreams)),
+		EBSConn:                          ebs.New(c.serviceSession(sess, names.EBS)),
+		EC2Conn:                          ec2.New(c.serviceSession(sess, names.EC2)),
+		EC2InstanceConnectConn:           ec2instanceconnect.New(c.serviceSession(sess, names.EC2InstanceConnect)),
+		ECRConn:                          ecr.New(c.serviceSession(sess, names.ECR)),
+		ECRPublicConn:                    ecrpublic.New(c.serviceSession(sess, names.ECRPublic)),
+		ECSConn:                          ecs.New(c.serviceSession(sess, names.ECS)),
+		EFSConn:                          efs.New(c.serviceSession(sess, names.EFS)),
+		EKSConn:                          eks.New(c.serviceSession(sess, names.EKS)),
+		ELBConn:                          elb.New(c.serviceSession(sess, names.ELB)),
+		ELBV2Conn:                        elbv2.New(c.serviceSession(sess, names.ELBV2)),
+		EMRConn:                          emr.New(c.serviceSession(sess, names.EMR)),
+		EMRContainersConn:                emrcontainers.New(c.serviceSession(sess, names.EMRContainers)),
+		EMRServerlessConn:                emrserverless.New(c.serviceSession(sess, names.EMRServerless)),
+		ElastiCacheConn:                  elasticache.New(c.serviceSession(sess, names.ElastiCache)),
+		ElasticBeanstalkConn:             elasticbeanstalk.New(c.serviceSession(sess, names.ElasticBeanstalk)),
+		ElasticInferenceConn:             elasticinference.New(c.serviceSession(sess, names.ElasticInference)),
+		ElasticTranscoderConn:            elastictranscoder.New(c.serviceSession(sess, names.ElasticTranscoder)),
+		ElasticsearchConn:                elasticsearchservice.New(c.serviceSession(sess, names.Elasticsearch)),
+		EventsConn:                       eventbridge.New(c.serviceSession(sess, names.Events)),
+		EvidentlyConn:                    cloudwatchevidently.New(c.serviceSession(sess, names.Evidently)),
+		FISConn:                          fis.New(c.serviceSession(sess, names.FIS)),
+		FMSConn:                          fms.New(c.serviceSession(sess, names.FMS)),
+		FSxConn:                          fsx.New(c.serviceSession(sess, names.FSx)),
+		FinSpaceConn:                     finspace.New(c.serviceSession(sess, names.FinSpace)),
+		FinSpaceDataConn:                 finspacedata.New(c.serviceSession(sess, names.FinSpaceData)),
+		FirehoseConn:                     firehose.New(c.serviceSession(sess, names.Firehose)),
+		ForecastConn:                     forecastservice.New(c.serviceSession(sess, names.Forecast)),
+		ForecastQueryConn:                forecastqueryservice.New(c.serviceSession(sess, names.ForecastQuery)),
+		FraudDetectorConn:                frauddetector.New(c.serviceSession(sess, names.FraudDetector)),
+		GameLiftConn:                     gamelift.New(c.serviceSession(sess, names.GameLift)),
+		GlacierConn:                      glacier.New(c.serviceSession(sess, names.Glacier)),
+		GlueConn:                         glue.New(c.serviceSession(sess, names.Glue)),
+		GrafanaConn:                      managedgrafana.New(c.serviceSession(sess, names.Grafana)),
+		GreengrassConn:                   greengrass.New(c.serviceSession(sess, names.Greengrass)),
+		GreengrassV2Conn:                 greengrassv2.New(c.serviceSession(sess, names.GreengrassV2)),
+		GroundStationConn:                groundstation.New(c.serviceSession(sess, names.GroundStation)),
+		GuardDutyConn:                    guardduty.New(c.serviceSession(sess, names.GuardDuty)),
+		HealthConn:                       health.New(c.serviceSession(sess, names.Health)),
+		HealthLakeConn:                   healthlake.New(c.serviceSession(sess, names.HealthLake)),
+		HoneycodeConn:                    honeycode.New(c.serviceSession(sess, names.Honeycode)),
+		IAMConn:                          iam.New(c.serviceSession(sess, names.IAM)),
+		IVSConn:                          ivs.New(c.serviceSession(sess, names.IVS)),
+		IdentityStoreConn:                identitystore.New(c.serviceSession(sess, names.IdentityStore)),
+		ImageBuilderConn:                 imagebuilder.New(c.serviceSession(sess, names.ImageBuilder)),
+		InspectorConn:                    inspector.New(c.serviceSession(sess, names.Inspector)),
+		IoTConn:                          iot.New(c.serviceSession(sess, names.IoT)),
+		IoT1ClickDevicesConn:             iot1clickdevicesservice.New(c.serviceSession(sess, names.IoT1ClickDevices)),
+		IoT1ClickProjectsConn:            iot1clickprojects.New(c.serviceSession(sess, names.IoT1ClickProjects)),
+		IoTAnalyticsConn:                 iotanalytics.New(c.serviceSession(sess, names.IoTAnalytics)),
+		IoTDataConn:                      iotdataplane.New(c.serviceSession(sess, names.IoTData)),
+		IoTDeviceAdvisorConn:             iotdeviceadvisor.New(c.serviceSession(sess, names.IoTDeviceAdvisor)),
+		IoTEventsConn:                    iotevents.New(c.serviceSession(sess, names.IoTEvents)),
+		IoTEventsDataConn:                ioteventsdata.New(c.serviceSession(sess, names.IoTEventsData)),
+		IoTFleetHubConn:                  iotfleethub.New(c.serviceSession(sess, names.IoTFleetHub)),
+		IoTJobsDataConn:                  iotjobsdataplane.New(c.serviceSession(sess, names.IoTJobsData)),
+		IoTSecureTunnelingConn:           iotsecuretunneling.New(c.serviceSession(sess, names.IoTSecureTunneling)),
+		IoTSiteWiseConn:                  iotsitewise.New(c.serviceSession(sess, names.IoTSiteWise)),
+		IoTThingsGraphConn:               iotthingsgraph.New(c.serviceSession(sess, names.IoTThingsGraph)),
+		IoTTwinMakerConn:                 iottwinmaker.New(c.serviceSession(sess, names.IoTTwinMaker)),
+		IoTWirelessConn:                  iotwireless.New(c.serviceSession(sess, names.IoTWireless)),
+		KMSConn:                          kms.New(c.serviceSession(sess, names.KMS)),
+		KafkaConn:                        kafka.New(c.serviceSession(sess, names.Kafka)),
+		KafkaConnectConn:                 kafkaconnect.New(c.serviceSession(sess, names.KafkaConnect)),
+		KeyspacesConn:                    keyspaces.New(c.serviceSession(sess, names.Keyspaces)),
+		KinesisConn:                      kinesis.New(c.serviceSession(sess, names.Kinesis)),
+		KinesisAnalyticsConn:             kinesisanalytics.New(c.serviceSession(sess, names.KinesisAnalytics)),
+		KinesisAnalyticsV2Conn:           kinesisanalyticsv2.New(c.serviceSession(sess, names.KinesisAnalyticsV2)),
+		KinesisVideoConn:                 kinesisvideo.New(c.serviceSession(sess, names.KinesisVideo)),
+		KinesisVideoArchivedMediaConn:    kinesisvideoarchivedmedia.New(c.serviceSession(sess, names.KinesisVideoArchivedMedia)),
+		KinesisVideoMediaConn:            kinesisvideomedia.New(c.serviceSession(sess, names.KinesisVideoMedia)),
+		KinesisVideoSignalingConn:        kinesisvideosignalingchannels.New(c.serviceSession(sess, names.KinesisVideoSignaling)),
+		LakeFormationConn:                lakeformation.New(c.serviceSession(sess, names.LakeFormation)),
+		LambdaConn:                       lambda.New(c.serviceSession(sess, names.Lambda)),
+		LexModelsConn:                    lexmodelbuildingservice.New(c.serviceSession(sess, names.LexModels)),
+		LexModelsV2Conn:                  lexmodelsv2.New(c.serviceSession(sess, names.LexModelsV2)),
+		LexRuntimeConn:                   lexruntimeservice.New(c.serviceSession(sess, names.LexRuntime)),
+		LexRuntimeV2Conn:                 lexruntimev2.New(c.serviceSession(sess, names.LexRuntimeV2)),
+		LicenseManagerConn:               licensemanager.New(c.serviceSession(sess, names.LicenseManager)),
+		LightsailConn:                    lightsail.New(c.serviceSession(sess, names.Lightsail)),
+		LocationConn:                     locationservice.New(c.serviceSession(sess, names.Location)),
+		LogsConn:                         cloudwatchlogs.New(c.serviceSession(sess, names.Logs)),
+		LookoutEquipmentConn:             lookoutequipment.New(c.serviceSession(sess, names.LookoutEquipment)),
+		LookoutMetricsConn:               lookoutmetrics.New(c.serviceSession(sess, names.LookoutMetrics)),
+		LookoutVisionConn:                lookoutforvision.New(c.serviceSession(sess, names.LookoutVision)),
+		MQConn:                           mq.New(c.serviceSession(sess, names.MQ)),
+		MTurkConn:                        mturk.New(c.serviceSession(sess, names.MTurk)),
+		MWAAConn:                         mwaa.New(c.serviceSession(sess, names.MWAA)),
+		MachineLearningConn:              machinelearning.New(c.serviceSession(sess, names.MachineLearning)),
+		MacieConn:                        macie.New(c.serviceSession(sess, names.Macie)),
+		Macie2Conn:                       macie2.New(c.serviceSession(sess, names.Macie2)),
+		ManagedBlockchainConn:            managedblockchain.New(c.serviceSession(sess, names.ManagedBlockchain)),
+		MarketplaceCatalogConn:           marketplacecatalog.New(c.serviceSession(sess, names.MarketplaceCatalog)),
+		MarketplaceCommerceAnalyticsConn: marketplacecommerceanalytics.New(c.serviceSession(sess, names.MarketplaceCommerceAnalytics)),
+		MarketplaceEntitlementConn:       marketplaceentitlementservice.New(c.serviceSession(sess, names.MarketplaceEntitlement)),
+		MarketplaceMeteringConn:          marketplacemetering.New(c.serviceSession(sess, names.MarketplaceMetering)),
+		MediaConnectConn:                 mediaconnect.New(c.serviceSession(sess, names.MediaConnect)),
+		MediaConvertConn:                 mediaconvert.New(c.serviceSession(sess, names.MediaConvert)),
+		MediaLiveConn:                    medialive.New(c.serviceSession(sess, names.MediaLive)),
+		MediaPackageConn:                 mediapackage.New(c.serviceSession(sess, names.MediaPackage)),
+		MediaPackageVODConn:              mediapackagevod.New(c.serviceSession(sess, names.MediaPackageVOD)),
+		MediaStoreConn:                   mediastore.New(c.serviceSession(sess, names.MediaStore)),
+		MediaStoreDataConn:               mediastoredata.New(c.serviceSession(sess, names.MediaStoreData)),
+		MediaTailorConn:                  mediatailor.New(c.serviceSession(sess, names.MediaTailor)),
+		MemoryDBConn:                     memorydb.New(c.serviceSession(sess, names.MemoryDB)),
+		MgHConn:                          migrationhub.New(c.serviceSession(sess, names.MgH)),
+		MgnConn:                          mgn.New(c.serviceSession(sess, names.Mgn)),
+		MigrationHubConfigConn:           migrationhubconfig.New(c.serviceSession(sess, names.MigrationHubConfig)),
+		MigrationHubRefactorSpacesConn:   migrationhubrefactorspaces.New(c.serviceSession(sess, names.MigrationHubRefactorSpaces)),
+		MigrationHubStrategyConn:         migrationhubstrategyrecommendations.New(c.serviceSession(sess, names.MigrationHubStrategy)),
+		MobileConn:                       mobile.New(c.serviceSession(sess, names.Mobile)),
+		NeptuneConn:                      neptune.New(c.serviceSession(sess, names.Neptune)),
+		NetworkFirewallConn:              networkfirewall.New(c.serviceSession(sess, names.NetworkFirewall)),
+		NetworkManagerConn:               networkmanager.New(c.serviceSession(sess, names.NetworkManager)),
+		NimbleConn:                       nimblestudio.New(c.serviceSession(sess, names.Nimble)),
+		OpenSearchConn:                   opensearchservice.New(c.serviceSession(sess, names.OpenSearch)),
+		OpsWorksConn:                     opsworks.New(c.serviceSession(sess, names.OpsWorks)),
+		OpsWorksCMConn:                   opsworkscm.New(c.serviceSession(sess, names.OpsWorksCM)),
+		OrganizationsConn:                organizations.New(c.serviceSession(sess, names.Organizations)),
+		OutpostsConn:                     outposts.New(c.serviceSession(sess, names.Outposts)),
+		PIConn:                           pi.New(c.serviceSession(sess, names.PI)),
+		PanoramaConn:                     panorama.New(c.serviceSession(sess, names.Panorama)),
+		PersonalizeConn:                  personalize.New(c.serviceSession(sess, names.Personalize)),
+		PersonalizeEventsConn:            personalizeevents.New(c.serviceSession(sess, names.PersonalizeEvents)),
+		PersonalizeRuntimeConn:           personalizeruntime.New(c.serviceSession(sess, names.PersonalizeRuntime)),
+		PinpointConn:                     pinpoint.New(c.serviceSession(sess, names.Pinpoint)),
+		PinpointEmailConn:                pinpointemail.New(c.serviceSession(sess, names.PinpointEmail)),
+		PinpointSMSVoiceConn:             pinpointsmsvoice.New(c.serviceSession(sess, names.PinpointSMSVoice)),
+		PollyConn:                        polly.New(c.serviceSession(sess, names.Polly)),
+		PricingConn:                      pricing.New(c.serviceSession(sess, names.Pricing)),
+		ProtonConn:                       proton.New(c.serviceSession(sess, names.Proton)),
+		QLDBConn:                         qldb.New(c.serviceSession(sess, names.QLDB)),
+		QLDBSessionConn:                  qldbsession.New(c.serviceSession(sess, names.QLDBSession)),
+		QuickSightConn:                   quicksight.New(c.serviceSession(sess, names.QuickSight)),
+		RAMConn:                          ram.New(c.serviceSession(sess, names.RAM)),
+		RBinConn:                         recyclebin.New(c.serviceSession(sess, names.RBin)),
+		RDSConn:                          rds.New(c.serviceSession(sess, names.RDS)),
+		RDSDataConn:                      rdsdataservice.New(c.serviceSession(sess, names.RDSData)),
+		RUMConn:                          cloudwatchrum.New(c.serviceSession(sess, names.RUM)),
+		RedshiftConn:                     redshift.New(c.serviceSession(sess, names.Redshift)),
+		RedshiftDataConn:                 redshiftdataapiservice.New(c.serviceSession(sess, names.RedshiftData)),
+		RekognitionConn:                  rekognition.New(c.serviceSession(sess, names.Rekognition)),
+		ResilienceHubConn:                resiliencehub.New(c.serviceSession(sess, names.ResilienceHub)),
+		ResourceGroupsConn:               resourcegroups.New(c.serviceSession(sess, names.ResourceGroups)),
+		ResourceGroupsTaggingAPIConn:     resourcegroupstaggingapi.New(c.serviceSession(sess, names.ResourceGroupsTaggingAPI)),
+		RoboMakerConn:                    robomaker.New(c.serviceSession(sess, names.RoboMaker)),
+		Route53RecoveryClusterConn:       route53recoverycluster.New(c.serviceSession(sess, names.Route53RecoveryCluster)),
+		Route53ResolverConn:              route53resolver.New(c.serviceSession(sess, names.Route53Resolver)),
+		S3ControlConn:                    s3control.New(c.serviceSession(sess, names.S3Control)),
+		S3OutpostsConn:                   s3outposts.New(c.serviceSession(sess, names.S3Outposts)),
+		SESConn:                          ses.New(c.serviceSession(sess, names.SES)),
+		SFNConn:                          sfn.New(c.serviceSession(sess, names.SFN)),
+		SMSConn:                          sms.New(c.serviceSession(sess, names.SMS)),
+		SNSConn:                          sns.New(c.serviceSession(sess, names.SNS)),
+		SQSConn:                          sqs.New(c.serviceSession(sess, names.SQS)),
+		SSMConn:                          ssm.New(c.serviceSession(sess, names.SSM)),
+		SSMContactsConn:                  ssmcontacts.New(c.serviceSession(sess, names.SSMContacts)),
+		SSMIncidentsConn:                 ssmincidents.New(c.serviceSession(sess, names.SSMIncidents)),
+		SSOConn:                          sso.New(c.serviceSession(sess, names.SSO)),
+		SSOAdminConn:                     ssoadmin.New(c.serviceSession(sess, names.SSOAdmin)),
+		SSOOIDCConn:                      ssooidc.New(c.serviceSession(sess, names.SSOOIDC)),
+		SWFConn:                          swf.New(c.serviceSession(sess, names.SWF)),
+		SageMakerConn:                    sagemaker.New(c.serviceSession(sess, names.SageMaker)),
+		SageMakerA2IRuntimeConn:          augmentedairuntime.New(c.serviceSession(sess, names.SageMakerA2IRuntime)),
+		SageMakerEdgeConn:                sagemakeredgemanager.New(c.serviceSession(sess, names.SageMakerEdge)),
+		SageMakerFeatureStoreRuntimeConn: sagemakerfeaturestoreruntime.New(c.serviceSession(sess, names.SageMakerFeatureStoreRuntime)),
+		SageMakerRuntimeConn:             sagemakerruntime.New(c.serviceSession(sess, names.SageMakerRuntime)),
+		SavingsPlansConn:                 savingsplans.New(c.serviceSession(sess, names.SavingsPlans)),
+		SchemasConn:                      schemas.New(c.serviceSession(sess, names.Schemas)),
+		SecretsManagerConn:               secretsmanager.New(c.serviceSession(sess, names.SecretsManager)),
+		SecurityHubConn:                  securityhub.New(c.serviceSession(sess, names.SecurityHub)),
+		ServerlessRepoConn:               serverlessapplicationrepository.New(c.serviceSession(sess, names.ServerlessRepo)),
+		ServiceCatalogConn:               servicecatalog.New(c.serviceSession(sess, names.ServiceCatalog)),
+		ServiceCatalogAppRegistryConn:    appregistry.New(c.serviceSession(sess, names.ServiceCatalogAppRegistry)),
+		ServiceDiscoveryConn:             servicediscovery.New(c.serviceSession(sess, names.ServiceDiscovery)),
+		ServiceQuotasConn:                servicequotas.New(c.serviceSession(sess, names.ServiceQuotas)),
+		SignerConn:                       signer.New(c.serviceSession(sess, names.Signer)),
+		SimpleDBConn:                     simpledb.New(c.serviceSession(sess, names.SimpleDB)),
+		SnowDeviceManagementConn:         snowdevicemanagement.New(c.serviceSession(sess, names.SnowDeviceManagement)),
+		SnowballConn:                     snowball.New(c.serviceSession(sess, names.Snowball)),
+		StorageGatewayConn:               storagegateway.New(c.serviceSession(sess, names.StorageGateway)),
+		SupportConn:                      support.New(c.serviceSession(sess, names.Support)),
+		SyntheticsConn:                   synthetics.New(c.serviceSession(sess, names.Synthetics)),
+		TextractConn:                     textract.New(c.serviceSession(sess, names.Textract)),
+		TimestreamQueryConn:              timestreamquery.New(c.serviceSession(sess, names.TimestreamQuery)),
+		TimestreamWriteConn:              timestreamwrite.New(c.serviceSession(sess, names.TimestreamWrite)),
+		TranscribeConn:                   transcribeservice.New(c.serviceSession(sess, names.Transcribe)),
+		TranscribeStreamingConn:          transcribestreamingservice.New(c.serviceSession(sess, names.TranscribeStreaming)),
+		TransferConn:                     transfer.New(c.serviceSession(sess, names.Transfer)),
+		TranslateConn:                    translate.New(c.serviceSession(sess, names.Translate)),
+		VoiceIDConn:                      voiceid.New(c.serviceSession(sess, names.VoiceID)),
+		WAFConn:                          waf.New(c.serviceSession(sess, names.WAF)),
+		WAFRegionalConn:                  wafregional.New(c.serviceSession(sess, names.WAFRegional)),
+		WAFV2Conn:                        wafv2.New(c.serviceSession(sess, names.WAFV2)),
+		WellArchitectedConn:              wellarchitected.New(c.serviceSession(sess, names.WellArchitected)),
+		WisdomConn:                       connectwisdomservice.New(c.serviceSession(sess, names.Wisdom)),
+		WorkDocsConn:                     workdocs.New(c.serviceSession(sess, names.WorkDocs)),
+		WorkLinkConn:                     worklink.New(c.serviceSession(sess, names.WorkLink)),
+		WorkMailConn:                     workmail.New(c.serviceSession(sess, names.WorkMail)),
+		WorkMailMessageFlowConn:          workmailmessageflow.New(c.serviceSession(sess, names.WorkMailMessageFlow)),
+		WorkSpacesConn:                   workspaces.New(c.serviceSession(sess, names.WorkSpaces)),
+		WorkSpacesWebConn:                workspacesweb.New(c.serviceSession(sess, names.WorkSpacesWeb)),
+		XRayConn:                         xray.New(c.serviceSession(sess, names.XRay)),
 	}
 }
@@ -0,0 +1,198 @@
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync/atomic"
+	"time"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// credentialsExpiryWindow is how long before they expire that temporary credentials are refreshed.
+// Long-running operations, such as waiting for a resource to be created, must not fail because
+// the credentials used to start them expire part way through.
+const credentialsExpiryWindow = 5 * time.Minute
+
+// credentialsExpiredErrorCodes are the API error codes returned when the credentials used to sign a request have expired.
+var credentialsExpiredErrorCodes = map[string]bool{
+	"ExpiredToken":          true,
+	"ExpiredTokenException": true,
+}
+
+// CredentialsExpiredError is returned for API operations that fail because the AWS credentials used have expired.
+type CredentialsExpiredError struct {
+	Expires   time.Time
+	Operation string
+	Service   string
+	Source    string
+	Err       error
+}
+
+func (e *CredentialsExpiredError) Error() string {
+	msg := fmt.Sprintf("%s %s API operation failed because the AWS credentials", e.Service, e.Operation)
+
+	if e.Source != "" {
+		msg = fmt.Sprintf("%s (source: %s)", msg, e.Source)
+	}
+
+	msg = fmt.Sprintf("%s have expired", msg)
+
+	if !e.Expires.IsZero() {
+		msg = fmt.Sprintf("%s (expiration: %s)", msg, e.Expires.Format(time.RFC3339))
+	}
+
+	return fmt.Sprintf("%s. The credentials will be refreshed on the next API operation; "+
+		"if this error persists, the source credentials used to refresh them (for example those used to assume an IAM Role) may themselves have expired: %s", msg, e.Err)
+}
+
+func (e *CredentialsExpiredError) Unwrap() error {
+	return e.Err
+}
+
+// credentialsRefresher caches temporary credentials, refreshing them before they expire.
+// Both the AWS SDK for Go v1 session and the v2 configuration use the same refresher.
+type credentialsRefresher struct {
+	cache    *awsv2.CredentialsCache
+	current  atomic.Value
+	provider awsv2.CredentialsProvider
+}
+
+func newCredentialsRefresher(provider awsv2.CredentialsProvider) *credentialsRefresher {
+	r := &credentialsRefresher{
+		provider: provider,
+	}
+
+	r.cache = awsv2.NewCredentialsCache(credentialsRefresherProvider{r}, func(o *awsv2.CredentialsCacheOptions) {
+		o.ExpiryWindow = credentialsExpiryWindow
+	})
+
+	return r
+}
+
+// credentialsRefresherProvider is the credentials provider used by the refresher's cache.
+type credentialsRefresherProvider struct {
+	r *credentialsRefresher
+}
+
+func (p credentialsRefresherProvider) Retrieve(ctx context.Context) (awsv2.Credentials, error) {
+	return p.r.retrieve(ctx)
+}
+
+// AdjustExpiresBy brings forward the expiration of credentials by the expiry window.
+// If the underlying provider returned credentials that are already within the expiry window,
+// their expiration is left unchanged so that they are not refreshed on every API operation.
+func (p credentialsRefresherProvider) AdjustExpiresBy(creds awsv2.Credentials, dur time.Duration) (awsv2.Credentials, error) {
+	if expires := creds.Expires.Add(dur); creds.CanExpire && expires.After(time.Now()) {
+		creds.Expires = expires
+	}
+
+	return creds, nil
+}
+
+// Retrieve returns the cached credentials, refreshing them if they are within the expiry window.
+func (r *credentialsRefresher) Retrieve(ctx context.Context) (awsv2.Credentials, error) {
+	return r.cache.Retrieve(ctx)
+}
+
+// Invalidate discards the cached credentials so that the next call to Retrieve refreshes them.
+func (r *credentialsRefresher) Invalidate() {
+	r.invalidateProvider()
+	r.cache.Invalidate()
+}
+
+// retrieve returns credentials from the underlying provider.
+// The underlying provider usually caches credentials itself, only refreshing them once they have expired,
+// so credentials that are within the expiry window are discarded and retrieved again.
+func (r *credentialsRefresher) retrieve(ctx context.Context) (awsv2.Credentials, error) {
+	creds, err := r.provider.Retrieve(ctx)
+
+	if err == nil && creds.CanExpire && time.Until(creds.Expires) < credentialsExpiryWindow {
+		log.Printf("[DEBUG] Refreshing AWS credentials (source: %s) expiring at %s", creds.Source, creds.Expires.Format(time.RFC3339))
+		r.invalidateProvider()
+		creds, err = r.provider.Retrieve(ctx)
+	}
+
+	if err != nil {
+		return awsv2.Credentials{}, err
+	}
+
+	if creds.CanExpire {
+		log.Printf("[DEBUG] Retrieved AWS credentials (source: %s) expiring at %s", creds.Source, creds.Expires.Format(time.RFC3339))
+	}
+
+	r.current.Store(creds)
+
+	return creds, nil
+}
+
+func (r *credentialsRefresher) invalidateProvider() {
+	if v, ok := r.provider.(interface{ Invalidate() }); ok {
+		v.Invalidate()
+	}
+}
+
+// expired returns an error describing an API operation that failed because the credentials expired.
+// The cached credentials are invalidated so that subsequent API operations, such as the next attempt
+// of a wait loop, use refreshed credentials.
+func (r *credentialsRefresher) expired(service, operation string, err error) error {
+	expiredErr := &CredentialsExpiredError{
+		Operation: operation,
+		Service:   service,
+		Err:       err,
+	}
+
+	if v, ok := r.current.Load().(awsv2.Credentials); ok {
+		expiredErr.Expires = v.Expires
+		expiredErr.Source = v.Source
+	}
+
+	log.Printf("[WARN] %s", expiredErr)
+	r.Invalidate()
+
+	return expiredErr
+}
+
+// applyCredentialsExpiryToSession reports API operations made using the specified AWS SDK for Go v1 session
+// that fail because the credentials have expired.
+func applyCredentialsExpiryToSession(sess *session.Session, r *credentialsRefresher) {
+	sess.Handlers.Complete.PushFrontNamed(request.NamedHandler{
+		Name: "TerraformCredentialsExpiry",
+		Fn: func(req *request.Request) {
+			if req.Error == nil || req.Operation == nil {
+				return
+			}
+
+			var awsErr awserr.Error
+			if errors.As(req.Error, &awsErr) && credentialsExpiredErrorCodes[awsErr.Code()] {
+				req.Error = r.expired(req.ClientInfo.ServiceID, req.Operation.Name, req.Error)
+			}
+		},
+	})
+}
+
+// credentialsExpiryAPIOption returns an AWS SDK for Go v2 API option that reports API operations
+// that fail because the credentials have expired.
+func credentialsExpiryAPIOption(r *credentialsRefresher) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		// Added after the service metadata is registered, wrapping any retries.
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TerraformCredentialsExpiry", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			out, metadata, err := next.HandleInitialize(ctx, in)
+
+			var apiErr smithy.APIError
+			if errors.As(err, &apiErr) && credentialsExpiredErrorCodes[apiErr.ErrorCode()] {
+				err = r.expired(awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx), err)
+			}
+
+			return out, metadata, err
+		}), middleware.After)
+	}
+}
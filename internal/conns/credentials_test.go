@@ -0,0 +1,216 @@
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kendra"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+)
+
+// testCredentialsProvider returns temporary credentials whose expiration is the next of its expirations.
+type testCredentialsProvider struct {
+	expirations []time.Duration
+	retrievals  int
+}
+
+func (p *testCredentialsProvider) Retrieve(ctx context.Context) (awsv2.Credentials, error) {
+	expiration := p.expirations[len(p.expirations)-1]
+	if p.retrievals < len(p.expirations) {
+		expiration = p.expirations[p.retrievals]
+	}
+
+	p.retrievals++
+
+	return awsv2.Credentials{
+		AccessKeyID:     fmt.Sprintf("AKID%d", p.retrievals),
+		SecretAccessKey: "SECRET",
+		SessionToken:    "TOKEN",
+		Source:          "test",
+		CanExpire:       true,
+		Expires:         time.Now().Add(expiration),
+	}, nil
+}
+
+func TestCredentialsRefresherRetrieve(t *testing.T) {
+	testCases := []struct {
+		Name                string
+		Expirations         []time.Duration
+		ExpectedAccessKeyID string
+		ExpectedRetrievals  int
+	}{
+		{
+			Name:                "not expiring",
+			Expirations:         []time.Duration{1 * time.Hour},
+			ExpectedAccessKeyID: "AKID1",
+			ExpectedRetrievals:  1,
+		},
+		{
+			Name:                "expiring",
+			Expirations:         []time.Duration{2 * time.Minute, 1 * time.Hour},
+			ExpectedAccessKeyID: "AKID2",
+			ExpectedRetrievals:  2,
+		},
+		{
+			Name:                "refreshed credentials expiring",
+			Expirations:         []time.Duration{2 * time.Minute},
+			ExpectedAccessKeyID: "AKID2",
+			ExpectedRetrievals:  2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			provider := &testCredentialsProvider{expirations: testCase.Expirations}
+			r := newCredentialsRefresher(awsv2.NewCredentialsCache(provider))
+
+			// Subsequent retrievals use the cached credentials.
+			for i := 0; i < 3; i++ {
+				creds, err := r.Retrieve(context.Background())
+
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if got, expected := creds.AccessKeyID, testCase.ExpectedAccessKeyID; got != expected {
+					t.Errorf("got access key ID %q, expected %q", got, expected)
+				}
+			}
+
+			if got, expected := provider.retrievals, testCase.ExpectedRetrievals; got != expected {
+				t.Errorf("got %d retrievals, expected %d", got, expected)
+			}
+		})
+	}
+}
+
+func TestCredentialsRefresherRetrieveExpiryWindow(t *testing.T) {
+	provider := &testCredentialsProvider{expirations: []time.Duration{1 * time.Hour}}
+	r := newCredentialsRefresher(awsv2.NewCredentialsCache(provider))
+
+	creds, err := r.Retrieve(context.Background())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The expiration seen by SDK clients is brought forward so that they refresh the credentials early.
+	if got, expected := time.Until(creds.Expires), 1*time.Hour-credentialsExpiryWindow; got > expected {
+		t.Errorf("got expiration in %s, expected no later than %s", got, expected)
+	}
+}
+
+func TestApplyCredentialsExpiryToSession(t *testing.T) {
+	provider := &testCredentialsProvider{expirations: []time.Duration{1 * time.Hour}}
+	r := newCredentialsRefresher(awsv2.NewCredentialsCache(provider))
+
+	if _, err := r.Retrieve(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	sess := session.Must(session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+	}))
+	applyCredentialsExpiryToSession(sess, r)
+	conn := sts.New(sess)
+
+	req, _ := conn.GetCallerIdentityRequest(&sts.GetCallerIdentityInput{})
+	req.Error = awserr.New(sts.ErrCodeExpiredTokenException, "The security token included in the request is expired", nil)
+	req.Handlers.Complete.Run(req)
+
+	var expiredErr *CredentialsExpiredError
+	if !errors.As(req.Error, &expiredErr) {
+		t.Fatalf("expected CredentialsExpiredError, got %v", req.Error)
+	}
+
+	if got, expected := expiredErr.Source, "test"; got != expected {
+		t.Errorf("got source %q, expected %q", got, expected)
+	}
+
+	if got := req.Error.Error(); !strings.HasPrefix(got, "STS GetCallerIdentity API operation failed because the AWS credentials (source: test) have expired") {
+		t.Errorf("unexpected error message: %s", got)
+	}
+
+	if !tfawserr.ErrCodeEquals(req.Error, sts.ErrCodeExpiredTokenException) {
+		t.Errorf("expected error code %s", sts.ErrCodeExpiredTokenException)
+	}
+
+	// The credentials are refreshed by the next API operation.
+	if _, err := r.Retrieve(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := provider.retrievals, 2; got != expected {
+		t.Errorf("got %d retrievals, expected %d", got, expected)
+	}
+
+	req, _ = conn.GetCallerIdentityRequest(&sts.GetCallerIdentityInput{})
+	req.Error = awserr.New("AccessDenied", "User is not authorized", nil)
+	req.Handlers.Complete.Run(req)
+
+	if errors.As(req.Error, &expiredErr) {
+		t.Errorf("unexpected CredentialsExpiredError: %s", req.Error)
+	}
+}
+
+type testExpiredTokenHTTPClient struct{}
+
+func (c *testExpiredTokenHTTPClient) Do(*http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusBadRequest,
+		Header: http.Header{
+			"Content-Type":     []string{"application/x-amz-json-1.1"},
+			"X-Amzn-Errortype": []string{"ExpiredTokenException"},
+		},
+		Body: io.NopCloser(strings.NewReader(`{"__type":"ExpiredTokenException","message":"The security token included in the request is expired"}`)),
+	}, nil
+}
+
+func TestCredentialsExpiryAPIOption(t *testing.T) {
+	provider := &testCredentialsProvider{expirations: []time.Duration{1 * time.Hour}}
+	r := newCredentialsRefresher(awsv2.NewCredentialsCache(provider))
+	c := &Config{credentials: r}
+	conn := kendra.New(kendra.Options{
+		APIOptions:  c.apiOptions("kendra"),
+		Credentials: r,
+		HTTPClient:  &testExpiredTokenHTTPClient{},
+		Region:      "us-west-2", //lintignore:AWSAT003
+		Retryer:     awsv2.NopRetryer{},
+	})
+
+	_, err := conn.ListIndices(context.Background(), &kendra.ListIndicesInput{})
+
+	var expiredErr *CredentialsExpiredError
+	if !errors.As(err, &expiredErr) {
+		t.Fatalf("expected CredentialsExpiredError, got %v", err)
+	}
+
+	if got, expected := expiredErr.Operation, "ListIndices"; got != expected {
+		t.Errorf("got operation %q, expected %q", got, expected)
+	}
+
+	if got, expected := expiredErr.Service, "kendra"; got != expected {
+		t.Errorf("got service %q, expected %q", got, expected)
+	}
+
+	if _, err := r.Retrieve(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := provider.retrievals, 2; got != expected {
+		t.Errorf("got %d retrievals, expected %d", got, expected)
+	}
+}
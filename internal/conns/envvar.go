@@ -54,6 +54,14 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	EnvVarAccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For recording or replaying the AWS API interactions of acceptance tests,
+	// either RECORD_ONLY or REPLAY_ONLY
+	EnvVarAccVCRMode = "TF_ACC_VCR_MODE"
+
+	// For recording or replaying the AWS API interactions of acceptance tests,
+	// the directory containing each test's recorded interactions
+	EnvVarAccVCRPath = "TF_ACC_VCR_PATH"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -72,6 +80,36 @@ const (
 	EnvVarAssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used for controlling how resource sweepers delete resources
+const (
+	// The maximum number of resources each sweeper deletes at once.
+	// Defaults to no limit.
+	EnvVarSweepConcurrency = "TF_AWS_SWEEP_CONCURRENCY"
+
+	// The maximum number of times each sweeper deletes resources whose deletions fail because of dependent resources.
+	// Defaults to 5.
+	EnvVarSweepDependencyMaxWaves = "TF_AWS_SWEEP_DEPENDENCY_MAX_WAVES"
+
+	// The delay, such as "1m", before each sweeper re-deletes resources whose deletions fail because of dependent resources.
+	// Defaults to 30s.
+	EnvVarSweepDependencyWaveDelay = "TF_AWS_SWEEP_DEPENDENCY_WAVE_DELAY"
+
+	// If set, resources are reported instead of deleted
+	EnvVarSweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Comma-separated list of "key" or "key=value" tags.
+	// Only resources with at least one matching tag are deleted.
+	EnvVarSweepIncludeTags = "TF_AWS_SWEEP_INCLUDE_TAGS"
+
+	// Comma-separated list of "key" or "key=value" tags.
+	// Resources with any matching tag are not deleted.
+	EnvVarSweepExcludeTags = "TF_AWS_SWEEP_EXCLUDE_TAGS"
+
+	// The file to write the JSON report of resources that would be deleted in dry-run mode.
+	// Defaults to the log.
+	EnvVarSweepReportFile = "TF_AWS_SWEEP_REPORT_FILE"
+)
+
 // GetEnvVarWithDefault gets an environment variable value if non-empty or returns the default.
 func GetEnvVarWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
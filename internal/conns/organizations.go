@@ -0,0 +1,141 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+)
+
+// checkAccountID returns an error if the account ID is forbidden or not allowed.
+func (c *Config) checkAccountID(accountID string) error {
+	for _, forbiddenAccountID := range c.ForbiddenAccountIds {
+		if accountID == forbiddenAccountID {
+			return fmt.Errorf("AWS Account ID not allowed: %s", accountID)
+		}
+	}
+
+	if len(c.AllowedAccountIds) > 0 {
+		for _, allowedAccountID := range c.AllowedAccountIds {
+			if accountID == allowedAccountID {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("AWS Account ID not allowed: %s", accountID)
+	}
+
+	return nil
+}
+
+// checkAccountOrganization returns an error if the account is not a member of
+// one of the allowed organizations or organizational units.
+// The organization is read using DescribeOrganization, which any member account can call.
+// Organizational units are only read if allowed paths are configured, as ListParents can only be called
+// from the organization's management account or a delegated administrator account.
+func (c *Config) checkAccountOrganization(ctx context.Context, conn *organizations.Organizations, accountID string) error {
+	if len(c.AllowedOrganizationIDs) == 0 && len(c.AllowedOrganizationalUnitPaths) == 0 {
+		return nil
+	}
+
+	output, err := conn.DescribeOrganizationWithContext(ctx, &organizations.DescribeOrganizationInput{})
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAWSOrganizationsNotInUseException) {
+		return fmt.Errorf("AWS Account ID (%s) not allowed: not a member of an organization", accountID)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Organizations Organization: %w", err)
+	}
+
+	if output == nil || output.Organization == nil {
+		return fmt.Errorf("error reading Organizations Organization: empty result")
+	}
+
+	organizationID := aws.StringValue(output.Organization.Id)
+
+	if len(c.AllowedOrganizationIDs) > 0 {
+		found := false
+		for _, allowedOrganizationID := range c.AllowedOrganizationIDs {
+			if organizationID == allowedOrganizationID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("AWS Account ID (%s) not allowed: Organization ID not allowed: %s", accountID, organizationID)
+		}
+	}
+
+	if len(c.AllowedOrganizationalUnitPaths) > 0 {
+		if accountID == "" {
+			return fmt.Errorf("AWS account ID is required to check the account's organizational unit")
+		}
+
+		path, err := organizationalUnitPath(ctx, conn, organizationID, accountID)
+
+		if err != nil {
+			return fmt.Errorf("checking allowed_organizational_unit_paths requires the organizations:ListParents permission in the organization's management account or a delegated administrator account: %w", err)
+		}
+
+		if !organizationalUnitPathAllowed(path, c.AllowedOrganizationalUnitPaths) {
+			return fmt.Errorf("AWS Account ID (%s) not allowed: Organizational Unit path not allowed: %s", accountID, path)
+		}
+	}
+
+	return nil
+}
+
+// organizationalUnitPath returns the path of the account's parent organizational unit in the
+// format used by the aws:PrincipalOrgPaths condition key, e.g. o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/.
+func organizationalUnitPath(ctx context.Context, conn *organizations.Organizations, organizationID, accountID string) (string, error) {
+	var ids []string
+
+	for childID := accountID; ; {
+		input := &organizations.ListParentsInput{
+			ChildId: aws.String(childID),
+		}
+
+		output, err := conn.ListParentsWithContext(ctx, input)
+
+		if err != nil {
+			return "", fmt.Errorf("error listing Organizations parents of (%s): %w", childID, err)
+		}
+
+		if output == nil || len(output.Parents) == 0 || output.Parents[0] == nil {
+			return "", fmt.Errorf("error listing Organizations parents of (%s): empty result", childID)
+		}
+
+		parent := output.Parents[0]
+		ids = append([]string{aws.StringValue(parent.Id)}, ids...)
+
+		if aws.StringValue(parent.Type) == organizations.ParentTypeRoot {
+			break
+		}
+
+		childID = aws.StringValue(parent.Id)
+	}
+
+	return organizationID + "/" + strings.Join(ids, "/") + "/", nil
+}
+
+// organizationalUnitPathAllowed returns whether the path is equal to, or a descendant of, any of the allowed paths.
+// Allowed paths may optionally end with a "*" wildcard.
+func organizationalUnitPathAllowed(path string, allowedPaths []string) bool {
+	for _, allowedPath := range allowedPaths {
+		allowedPath = strings.TrimSuffix(allowedPath, "*")
+
+		if !strings.HasSuffix(allowedPath, "/") {
+			allowedPath += "/"
+		}
+
+		if strings.HasPrefix(path, allowedPath) {
+			return true
+		}
+	}
+
+	return false
+}
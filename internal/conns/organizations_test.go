@@ -0,0 +1,245 @@
+package conns
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+	mockdatav1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/mockdata"
+	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
+)
+
+func TestConfigCheckAccountID(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Config      *Config
+		AccountID   string
+		ExpectError bool
+	}{
+		{
+			Name:      "no restrictions",
+			Config:    &Config{},
+			AccountID: "111111111111",
+		},
+		{
+			Name:      "allowed",
+			Config:    &Config{AllowedAccountIds: []string{"111111111111", "222222222222"}},
+			AccountID: "222222222222",
+		},
+		{
+			Name:        "not allowed",
+			Config:      &Config{AllowedAccountIds: []string{"111111111111"}},
+			AccountID:   "333333333333",
+			ExpectError: true,
+		},
+		{
+			Name:        "forbidden",
+			Config:      &Config{ForbiddenAccountIds: []string{"111111111111", "222222222222"}},
+			AccountID:   "222222222222",
+			ExpectError: true,
+		},
+		{
+			Name:      "not forbidden",
+			Config:    &Config{ForbiddenAccountIds: []string{"111111111111"}},
+			AccountID: "333333333333",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := testCase.Config.checkAccountID(testCase.AccountID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatal("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestOrganizationalUnitPathAllowed(t *testing.T) {
+	path := "o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/ou-ab12-22222222/"
+
+	testCases := []struct {
+		AllowedPaths []string
+		Expected     bool
+	}{
+		{
+			AllowedPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/ou-ab12-22222222/"},
+			Expected:     true,
+		},
+		{
+			AllowedPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/"},
+			Expected:     true,
+		},
+		{
+			AllowedPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/*"},
+			Expected:     true,
+		},
+		{
+			AllowedPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-1111111"},
+			Expected:     false,
+		},
+		{
+			AllowedPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-33333333/", "o-a1b2c3d4e5/r-ab12/"},
+			Expected:     true,
+		},
+		{
+			AllowedPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-33333333/"},
+			Expected:     false,
+		},
+	}
+
+	for i, testCase := range testCases {
+		if got, want := organizationalUnitPathAllowed(path, testCase.AllowedPaths), testCase.Expected; got != want {
+			t.Errorf("test case %d: got %t, want %t", i, got, want)
+		}
+	}
+}
+
+func TestConfigCheckAccountOrganization(t *testing.T) {
+	orgEndpoints := []*servicemocks.MockEndpoint{
+		{
+			Request: &servicemocks.MockRequest{
+				Method: "POST",
+				Uri:    "/",
+				Body:   `{}`,
+			},
+			Response: &servicemocks.MockResponse{
+				StatusCode:  200,
+				Body:        `{"Organization":{"Arn":"arn:aws:organizations::111111111111:organization/o-a1b2c3d4e5","Id":"o-a1b2c3d4e5","MasterAccountId":"111111111111"}}`,
+				ContentType: "application/x-amz-json-1.1",
+			},
+		},
+		{
+			Request: &servicemocks.MockRequest{
+				Method: "POST",
+				Uri:    "/",
+				Body:   `{"ChildId":"555555555555"}`,
+			},
+			Response: &servicemocks.MockResponse{
+				StatusCode:  200,
+				Body:        `{"Parents":[{"Id":"ou-ab12-11111111","Type":"ORGANIZATIONAL_UNIT"}]}`,
+				ContentType: "application/x-amz-json-1.1",
+			},
+		},
+		{
+			Request: &servicemocks.MockRequest{
+				Method: "POST",
+				Uri:    "/",
+				Body:   `{"ChildId":"ou-ab12-11111111"}`,
+			},
+			Response: &servicemocks.MockResponse{
+				StatusCode:  200,
+				Body:        `{"Parents":[{"Id":"r-ab12","Type":"ROOT"}]}`,
+				ContentType: "application/x-amz-json-1.1",
+			},
+		},
+	}
+	closeFunc, sess, err := mockdatav1.GetMockedAwsApiSession("Organizations", orgEndpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFunc()
+	conn := organizations.New(sess)
+
+	testCases := []struct {
+		Name        string
+		Config      *Config
+		ExpectError bool
+	}{
+		{
+			Name:   "no restrictions",
+			Config: &Config{},
+		},
+		{
+			Name:   "organization allowed",
+			Config: &Config{AllowedOrganizationIDs: []string{"o-a1b2c3d4e5"}},
+		},
+		{
+			Name:        "organization not allowed",
+			Config:      &Config{AllowedOrganizationIDs: []string{"o-zzzzzzzzzz"}},
+			ExpectError: true,
+		},
+		{
+			Name:   "organizational unit allowed",
+			Config: &Config{AllowedOrganizationalUnitPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/"}},
+		},
+		{
+			Name:        "organizational unit not allowed",
+			Config:      &Config{AllowedOrganizationalUnitPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-22222222/"}},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := testCase.Config.checkAccountOrganization(context.Background(), conn, "555555555555")
+
+			if err == nil && testCase.ExpectError {
+				t.Fatal("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestConfigCheckAccountOrganizationMemberAccount(t *testing.T) {
+	// Member accounts can describe their organization, but cannot list parents.
+	orgEndpoints := []*servicemocks.MockEndpoint{
+		{
+			Request: &servicemocks.MockRequest{
+				Method: "POST",
+				Uri:    "/",
+				Body:   `{}`,
+			},
+			Response: &servicemocks.MockResponse{
+				StatusCode:  200,
+				Body:        `{"Organization":{"Arn":"arn:aws:organizations::111111111111:organization/o-a1b2c3d4e5","Id":"o-a1b2c3d4e5","MasterAccountId":"111111111111"}}`,
+				ContentType: "application/x-amz-json-1.1",
+			},
+		},
+		{
+			Request: &servicemocks.MockRequest{
+				Method: "POST",
+				Uri:    "/",
+				Body:   `{"ChildId":"555555555555"}`,
+			},
+			Response: &servicemocks.MockResponse{
+				StatusCode:  400,
+				Body:        `{"__type":"AccessDeniedException","message":"You don't have permissions to access this resource."}`,
+				ContentType: "application/x-amz-json-1.1",
+			},
+		},
+	}
+	closeFunc, sess, err := mockdatav1.GetMockedAwsApiSession("Organizations", orgEndpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFunc()
+	conn := organizations.New(sess)
+
+	config := &Config{AllowedOrganizationIDs: []string{"o-a1b2c3d4e5"}}
+
+	if err := config.checkAccountOrganization(context.Background(), conn, "555555555555"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	config = &Config{AllowedOrganizationalUnitPaths: []string{"o-a1b2c3d4e5/r-ab12/"}}
+	err = config.checkAccountOrganization(context.Background(), conn, "555555555555")
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if !strings.Contains(err.Error(), "organizations:ListParents") {
+		t.Errorf("expected error to name the required permission, got: %s", err)
+	}
+}
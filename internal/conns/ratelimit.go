@@ -0,0 +1,207 @@
+package conns
+
+import (
+	"context"
+	"log"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// GlobalRequestCounts is a global RequestCounts for use within this plugin.
+var GlobalRequestCounts = NewRequestCounts()
+
+// RateLimitConfig contains per-service client-side rate limiting settings.
+type RateLimitConfig struct {
+	Burst             int
+	RequestsPerSecond float64
+}
+
+// RequestCounts is a simple per-service counter of AWS API requests.
+// Every attempt, including retries, is counted.
+type RequestCounts struct {
+	lock  sync.Mutex
+	store map[string]int64
+}
+
+// Increment increments the request count for the given service.
+func (rc *RequestCounts) Increment(service string) {
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+
+	rc.store[service]++
+}
+
+// Get returns the request count for the given service.
+func (rc *RequestCounts) Get(service string) int64 {
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+
+	return rc.store[service]
+}
+
+// Log writes the request counts, ordered by service, to the debug log.
+func (rc *RequestCounts) Log() {
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+
+	services := make([]string, 0, len(rc.store))
+	for service := range rc.store {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	for _, service := range services {
+		log.Printf("[DEBUG] AWS API requests (%s): %d", service, rc.store[service])
+	}
+}
+
+// Returns a properly initialized RequestCounts
+func NewRequestCounts() *RequestCounts {
+	return &RequestCounts{
+		store: make(map[string]int64),
+	}
+}
+
+// rateLimiter is a token bucket rate limiter.
+// Tokens are added at a fixed rate up to the bucket size (burst) and each
+// request consumes one token, waiting for it to become available if necessary.
+type rateLimiter struct {
+	lock   sync.Mutex
+	burst  float64
+	last   time.Time
+	rate   float64
+	tokens float64
+}
+
+func newRateLimiter(config *RateLimitConfig) *rateLimiter {
+	burst := config.Burst
+	if burst < 1 {
+		burst = int(math.Max(1, math.Ceil(config.RequestsPerSecond)))
+	}
+
+	return &rateLimiter{
+		burst:  float64(burst),
+		last:   time.Now(),
+		rate:   config.RequestsPerSecond,
+		tokens: float64(burst),
+	}
+}
+
+// reserve takes a token from the bucket and returns how long the caller must wait before using it.
+func (l *rateLimiter) reserve() time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns an unused token to the bucket.
+func (l *rateLimiter) cancel() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.tokens = math.Min(l.burst, l.tokens+1)
+}
+
+// Wait blocks until a token is available or the context is done.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	delay := l.reserve()
+
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// newRateLimiters returns a rate limiter for each rate limited service.
+// Rate limiters are shared by all clients of the service.
+func newRateLimiters(configs map[string]*RateLimitConfig) map[string]*rateLimiter {
+	rateLimiters := make(map[string]*rateLimiter)
+
+	for service, config := range configs {
+		if config == nil || config.RequestsPerSecond <= 0 {
+			continue
+		}
+
+		rateLimiters[service] = newRateLimiter(config)
+	}
+
+	return rateLimiters
+}
+
+// applyRequestHandlersToSession counts and rate limits requests made using the specified AWS SDK for Go v1 session.
+func (c *Config) applyRequestHandlersToSession(sess *session.Session, service string) {
+	l := c.rateLimiters[service]
+
+	sess.Handlers.Send.PushFront(func(r *request.Request) {
+		if l != nil {
+			// A canceled context causes the send to fail, so the error can be ignored here.
+			_ = l.Wait(r.Context())
+		}
+
+		GlobalRequestCounts.Increment(service)
+	})
+}
+
+// apiOptions returns AWS SDK for Go v2 API options that count and rate limit requests for the specified service
+// and, if configured, reject or record mutating API operations.
+func (c *Config) apiOptions(service string) []func(*middleware.Stack) error {
+	l := c.rateLimiters[service]
+
+	apiOptions := []func(*middleware.Stack) error{
+		func(stack *middleware.Stack) error {
+			// Added after the retry middleware so that every attempt is counted and rate limited.
+			return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("TerraformRequestHandlers", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+				if l != nil {
+					if err := l.Wait(ctx); err != nil {
+						return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+					}
+				}
+
+				GlobalRequestCounts.Increment(service)
+
+				return next.HandleFinalize(ctx, in)
+			}), middleware.After)
+		},
+	}
+
+	if c.credentials != nil {
+		apiOptions = append(apiOptions, credentialsExpiryAPIOption(c.credentials))
+	}
+
+	// Added before any read-only rejection so that rejected API operations are also recorded.
+	if c.auditLog != nil {
+		apiOptions = append(apiOptions, auditLogAPIOption(c.auditLog))
+	}
+
+	if c.ReadOnly {
+		apiOptions = append(apiOptions, readOnlyAPIOption(c.ReadOnlyErrorFunc))
+	}
+
+	return apiOptions
+}
@@ -0,0 +1,98 @@
+package conns
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+func TestRateLimiterWait(t *testing.T) {
+	l := newRateLimiter(&RateLimitConfig{
+		Burst:             2,
+		RequestsPerSecond: 10,
+	})
+
+	ctx := context.Background()
+	start := time.Now()
+
+	for i := 0; i < 4; i++ {
+		if err := l.Wait(ctx); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	// 2 requests are allowed immediately, the remaining 2 are spaced 100ms apart.
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("expected requests to be rate limited, elapsed %s", elapsed)
+	}
+}
+
+func TestRateLimiterWaitCanceled(t *testing.T) {
+	l := newRateLimiter(&RateLimitConfig{
+		Burst:             1,
+		RequestsPerSecond: 0.1,
+	})
+
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.Wait(ctx); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestRateLimiterDefaultBurst(t *testing.T) {
+	testCases := []struct {
+		Config   *RateLimitConfig
+		Expected float64
+	}{
+		{
+			Config:   &RateLimitConfig{RequestsPerSecond: 0.5},
+			Expected: 1,
+		},
+		{
+			Config:   &RateLimitConfig{RequestsPerSecond: 2.5},
+			Expected: 3,
+		},
+		{
+			Config:   &RateLimitConfig{Burst: 10, RequestsPerSecond: 2.5},
+			Expected: 10,
+		},
+	}
+
+	for i, testCase := range testCases {
+		if got, want := newRateLimiter(testCase.Config).burst, testCase.Expected; got != want {
+			t.Errorf("test case %d: got burst %f, want %f", i, got, want)
+		}
+	}
+}
+
+func TestApplyRequestHandlersToSession(t *testing.T) {
+	sess := session.Must(session.NewSession(&aws.Config{}))
+	sess.Handlers.Send.Clear()
+
+	c := &Config{
+		rateLimiters: newRateLimiters(map[string]*RateLimitConfig{
+			"test": {RequestsPerSecond: 10},
+		}),
+	}
+	c.applyRequestHandlersToSession(sess, "test")
+
+	before := GlobalRequestCounts.Get("test")
+
+	for i := 0; i < 3; i++ {
+		sess.Handlers.Send.Run(&request.Request{})
+	}
+
+	if got, want := GlobalRequestCounts.Get("test"), before+3; got != want {
+		t.Errorf("got %d requests, want %d", got, want)
+	}
+}
@@ -0,0 +1,118 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// readOnlyOperationPrefixes are the API operation name prefixes of operations that do not mutate resources.
+var readOnlyOperationPrefixes = []string{
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Search",
+}
+
+// readOnlyOperations are API operations that do not mutate resources but whose names
+// do not start with one of the read-only operation name prefixes.
+var readOnlyOperations = map[string]bool{
+	"BatchGetBuilds":             true, // CodeBuild
+	"BatchGetImage":              true, // ECR
+	"BatchGetItem":               true, // DynamoDB
+	"BatchGetNamedQuery":         true, // Athena
+	"BatchGetProjects":           true, // CodeBuild
+	"BatchGetQueryExecution":     true, // Athena
+	"BatchGetRepositories":       true, // CodeCommit
+	"DecodeAuthorizationMessage": true, // STS
+	"DownloadDBLogFilePortion":   true, // RDS
+	"EstimateTemplateCost":       true, // CloudFormation
+	"FilterLogEvents":            true, // CloudWatch Logs
+	"Query":                      true, // DynamoDB
+	"Scan":                       true, // DynamoDB
+	"SelectObjectContent":        true, // S3
+	"SimulateCustomPolicy":       true, // IAM
+	"SimulatePrincipalPolicy":    true, // IAM
+	"ValidateTemplate":           true, // CloudFormation
+}
+
+// IsReadOnlyOperation returns whether the API operation is permitted in read-only mode.
+func IsReadOnlyOperation(operation string) bool {
+	if readOnlyOperations[operation] {
+		return true
+	}
+
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ReadOnlyError is returned for API operations that are rejected in read-only mode.
+type ReadOnlyError struct {
+	Operation string
+	Service   string
+}
+
+func (e *ReadOnlyError) Error() string {
+	return fmt.Sprintf("%s %s API operation is not permitted in read-only mode", e.Service, e.Operation)
+}
+
+// applyReadOnlyToSession rejects mutating API operations made using the specified AWS SDK for Go v1 session.
+// Operations are rejected before being signed and sent.
+// If set, f is called with each rejection.
+func applyReadOnlyToSession(sess *session.Session, f func(*ReadOnlyError)) {
+	sess.Handlers.Validate.PushFrontNamed(request.NamedHandler{
+		Name: "TerraformReadOnly",
+		Fn: func(r *request.Request) {
+			if r.Operation == nil || IsReadOnlyOperation(r.Operation.Name) {
+				return
+			}
+
+			err := &ReadOnlyError{
+				Operation: r.Operation.Name,
+				Service:   r.ClientInfo.ServiceID,
+			}
+
+			if f != nil {
+				f(err)
+			}
+
+			r.Error = err
+		},
+	})
+}
+
+// readOnlyAPIOption returns an AWS SDK for Go v2 API option that rejects mutating API operations.
+// If set, f is called with each rejection.
+func readOnlyAPIOption(f func(*ReadOnlyError)) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		// Added after the service metadata is registered so that the operation name is available.
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TerraformReadOnly", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			if operation := awsmiddleware.GetOperationName(ctx); !IsReadOnlyOperation(operation) {
+				err := &ReadOnlyError{
+					Operation: operation,
+					Service:   awsmiddleware.GetServiceID(ctx),
+				}
+
+				if f != nil {
+					f(err)
+				}
+
+				return middleware.InitializeOutput{}, middleware.Metadata{}, err
+			}
+
+			return next.HandleInitialize(ctx, in)
+		}), middleware.After)
+	}
+}
@@ -0,0 +1,132 @@
+package conns
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kendra"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	testCases := []struct {
+		Operation string
+		Expected  bool
+	}{
+		{Operation: "DescribeVpcs", Expected: true},
+		{Operation: "GetCallerIdentity", Expected: true},
+		{Operation: "HeadObject", Expected: true},
+		{Operation: "ListBuckets", Expected: true},
+		{Operation: "LookupEvents", Expected: true},
+		{Operation: "SearchResources", Expected: true},
+		{Operation: "BatchGetItem", Expected: true},
+		{Operation: "Query", Expected: true},
+		{Operation: "AssumeRole", Expected: false},
+		{Operation: "BatchWriteItem", Expected: false},
+		{Operation: "CreateVpc", Expected: false},
+		{Operation: "DeleteBucket", Expected: false},
+		{Operation: "PutObject", Expected: false},
+		{Operation: "UpdateItem", Expected: false},
+		{Operation: "", Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		if got := IsReadOnlyOperation(testCase.Operation); got != testCase.Expected {
+			t.Errorf("%q: got %t, expected %t", testCase.Operation, got, testCase.Expected)
+		}
+	}
+}
+
+func TestApplyReadOnlyToSession(t *testing.T) {
+	sess := session.Must(session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+	}))
+	var rejected []*ReadOnlyError
+	applyReadOnlyToSession(sess, func(err *ReadOnlyError) {
+		rejected = append(rejected, err)
+	})
+	conn := sts.New(sess)
+
+	req, _ := conn.GetCallerIdentityRequest(&sts.GetCallerIdentityInput{})
+
+	if err := req.Build(); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	req, _ = conn.AssumeRoleRequest(&sts.AssumeRoleInput{
+		RoleArn:         aws.String("arn:aws:iam::123456789012:role/test"), //lintignore:AWSAT005
+		RoleSessionName: aws.String("test"),
+	})
+
+	err := req.Build()
+
+	var readOnlyErr *ReadOnlyError
+	if !errors.As(err, &readOnlyErr) {
+		t.Fatalf("expected ReadOnlyError, got %v", err)
+	}
+
+	if got, expected := readOnlyErr.Error(), "STS AssumeRole API operation is not permitted in read-only mode"; got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+
+	if len(rejected) != 1 || rejected[0] != readOnlyErr {
+		t.Errorf("expected rejection to be reported once, got %v", rejected)
+	}
+}
+
+type testHTTPClient struct {
+	requests int
+}
+
+func (c *testHTTPClient) Do(*http.Request) (*http.Response, error) {
+	c.requests++
+
+	return nil, errors.New("test")
+}
+
+func TestReadOnlyAPIOption(t *testing.T) {
+	httpClient := &testHTTPClient{}
+	c := &Config{ReadOnly: true}
+	conn := kendra.New(kendra.Options{
+		APIOptions:  c.apiOptions("kendra"),
+		Credentials: awsv2.AnonymousCredentials{},
+		HTTPClient:  httpClient,
+		Region:      "us-west-2", //lintignore:AWSAT003
+		Retryer:     awsv2.NopRetryer{},
+	})
+
+	_, err := conn.ListIndices(context.Background(), &kendra.ListIndicesInput{})
+
+	var readOnlyErr *ReadOnlyError
+	if errors.As(err, &readOnlyErr) {
+		t.Errorf("unexpected ReadOnlyError: %s", err)
+	}
+
+	if httpClient.requests != 1 {
+		t.Errorf("got %d requests, expected 1", httpClient.requests)
+	}
+
+	_, err = conn.CreateIndex(context.Background(), &kendra.CreateIndexInput{
+		Name:    awsv2.String("test"),
+		RoleArn: awsv2.String("arn:aws:iam::123456789012:role/test"), //lintignore:AWSAT005
+	})
+
+	if !errors.As(err, &readOnlyErr) {
+		t.Fatalf("expected ReadOnlyError, got %v", err)
+	}
+
+	if got, expected := readOnlyErr.Error(), "kendra CreateIndex API operation is not permitted in read-only mode"; got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+
+	if httpClient.requests != 1 {
+		t.Errorf("got %d requests, expected 1", httpClient.requests)
+	}
+}
@@ -0,0 +1,165 @@
+package conns
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	smithy "github.com/aws/smithy-go"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+)
+
+// RetryConfig contains per-service retry settings that supplement the
+// provider-wide max_retries setting and the built-in retry handlers.
+type RetryConfig struct {
+	ErrorCodes    []string
+	ErrorMessages []string
+	MaxAttempts   int
+	MaxBackoff    time.Duration
+}
+
+// applyToSession configures the specified AWS SDK for Go v1 session with the retry settings.
+func (rc *RetryConfig) applyToSession(sess *session.Session) {
+	if rc == nil {
+		return
+	}
+
+	// The AWS SDK for Go v1 counts retries, excluding the initial attempt.
+	if rc.MaxAttempts > 0 {
+		sess.Config.MaxRetries = aws.Int(rc.MaxAttempts - 1)
+	}
+
+	// Without a retryer, service clients construct a DefaultRetryer from MaxRetries.
+	// An existing retryer is kept, with only its retry count and delay overridden.
+	if r, ok := sess.Config.Retryer.(request.Retryer); ok || rc.MaxBackoff > 0 {
+		if !ok {
+			maxRetries := aws.IntValue(sess.Config.MaxRetries)
+
+			if sess.Config.MaxRetries == nil || maxRetries == aws.UseServiceDefaultRetries {
+				maxRetries = client.DefaultRetryerMaxNumRetries
+			}
+
+			r = client.DefaultRetryer{
+				NumMaxRetries: maxRetries,
+			}
+		}
+
+		sess.Config.Retryer = &retryerV1{
+			Retryer:    r,
+			maxBackoff: rc.MaxBackoff,
+			maxRetries: rc.maxRetries(r.MaxRetries()),
+		}
+	}
+
+	if len(rc.ErrorCodes) > 0 || len(rc.ErrorMessages) > 0 {
+		sess.Handlers.Retry.PushBack(func(r *request.Request) {
+			if rc.isErrorRetryable(r.Error) {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+	}
+}
+
+// retryer returns the specified AWS SDK for Go v2 retryer wrapped with the retry settings.
+func (rc *RetryConfig) retryer(r aws_sdkv2.Retryer) aws_sdkv2.Retryer {
+	if rc == nil {
+		return r
+	}
+
+	if r == nil {
+		r = retry_sdkv2.NewStandard()
+	}
+
+	if len(rc.ErrorCodes) > 0 || len(rc.ErrorMessages) > 0 {
+		r = &retryerWithRetryableErrors{
+			Retryer: r,
+			config:  rc,
+		}
+	}
+
+	if rc.MaxAttempts > 0 {
+		r = retry_sdkv2.AddWithMaxAttempts(r, rc.MaxAttempts)
+	}
+
+	if rc.MaxBackoff > 0 {
+		r = retry_sdkv2.AddWithMaxBackoffDelay(r, rc.MaxBackoff)
+	}
+
+	return r
+}
+
+// isErrorRetryable returns whether the error matches any of the configured error codes or messages.
+// Both AWS SDK for Go v1 and v2 errors are handled.
+func (rc *RetryConfig) isErrorRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if tfawserr.ErrCodeEquals(err, rc.ErrorCodes...) {
+		return true
+	}
+
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		for _, v := range rc.ErrorCodes {
+			if apiErr.ErrorCode() == v {
+				return true
+			}
+		}
+	}
+
+	for _, v := range rc.ErrorMessages {
+		if strings.Contains(err.Error(), v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// retryerWithRetryableErrors marks errors matching the retry settings as retryable.
+type retryerWithRetryableErrors struct {
+	aws_sdkv2.Retryer
+	config *RetryConfig
+}
+
+func (r *retryerWithRetryableErrors) IsErrorRetryable(err error) bool {
+	return r.config.isErrorRetryable(err) || r.Retryer.IsErrorRetryable(err)
+}
+
+// maxRetries returns the AWS SDK for Go v1 maximum number of retries for the retry settings,
+// or the specified default if no maximum number of attempts is configured.
+func (rc *RetryConfig) maxRetries(defaultMaxRetries int) int {
+	if rc == nil || rc.MaxAttempts <= 0 {
+		return defaultMaxRetries
+	}
+
+	return rc.MaxAttempts - 1
+}
+
+// retryerV1 overrides the maximum number of retries and the maximum retry delay of an AWS SDK for Go v1 retryer.
+type retryerV1 struct {
+	request.Retryer
+	maxBackoff time.Duration
+	maxRetries int
+}
+
+func (r *retryerV1) MaxRetries() int {
+	return r.maxRetries
+}
+
+func (r *retryerV1) RetryRules(req *request.Request) time.Duration {
+	delay := r.Retryer.RetryRules(req)
+
+	if r.maxBackoff > 0 && delay > r.maxBackoff {
+		return r.maxBackoff
+	}
+
+	return delay
+}
@@ -0,0 +1,202 @@
+package conns
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	smithy "github.com/aws/smithy-go"
+)
+
+func TestRetryConfigIsErrorRetryable(t *testing.T) {
+	rc := &RetryConfig{
+		ErrorCodes:    []string{"TooManyRequestsException"},
+		ErrorMessages: []string{"Rate exceeded"},
+	}
+
+	testCases := []struct {
+		Name     string
+		Err      error
+		Expected bool
+	}{
+		{
+			Name: "nil error",
+		},
+		{
+			Name:     "SDK v1 matching code",
+			Err:      awserr.New("TooManyRequestsException", "slow down", nil),
+			Expected: true,
+		},
+		{
+			Name:     "SDK v1 matching message",
+			Err:      awserr.New("ThrottlingException", "Rate exceeded", nil),
+			Expected: true,
+		},
+		{
+			Name: "SDK v1 non-matching error",
+			Err:  awserr.New("ValidationException", "invalid input", nil),
+		},
+		{
+			Name:     "SDK v2 matching code",
+			Err:      &smithy.GenericAPIError{Code: "TooManyRequestsException", Message: "slow down"},
+			Expected: true,
+		},
+		{
+			Name:     "SDK v2 matching message",
+			Err:      &smithy.GenericAPIError{Code: "ThrottlingException", Message: "Rate exceeded"},
+			Expected: true,
+		},
+		{
+			Name: "SDK v2 non-matching error",
+			Err:  &smithy.GenericAPIError{Code: "ValidationException", Message: "invalid input"},
+		},
+		{
+			Name: "other error",
+			Err:  errors.New("test"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got, want := rc.isErrorRetryable(testCase.Err), testCase.Expected; got != want {
+				t.Errorf("got %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestRetryConfigApplyToSession(t *testing.T) {
+	sess := session.Must(session.NewSession(&aws.Config{MaxRetries: aws.Int(25)}))
+	c := &Config{
+		RetryConfigs: map[string]*RetryConfig{
+			"ec2": {
+				ErrorCodes:  []string{"RequestLimitExceeded"},
+				MaxAttempts: 50,
+				MaxBackoff:  time.Minute,
+			},
+		},
+	}
+
+	if got, want := aws.IntValue(c.serviceSession(sess, "ec2").Config.MaxRetries), 49; got != want {
+		t.Errorf("MaxRetries got %d, want %d", got, want)
+	}
+
+	if got, want := aws.IntValue(c.serviceSession(sess, "sqs").Config.MaxRetries), 25; got != want {
+		t.Errorf("MaxRetries got %d, want %d", got, want)
+	}
+
+	r := &request.Request{Error: awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil)}
+	c.serviceSession(sess, "ec2").Handlers.Retry.Run(r)
+
+	if !aws.BoolValue(r.Retryable) {
+		t.Error("expected request to be retryable")
+	}
+
+	r = &request.Request{Error: awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil)}
+	c.serviceSession(sess, "sqs").Handlers.Retry.Run(r)
+
+	if r.Retryable != nil {
+		t.Error("expected request retryability to be unset")
+	}
+}
+
+func TestRetryConfigApplyToSessionMaxAttempts(t *testing.T) {
+	rc := &RetryConfig{
+		MaxAttempts: 5,
+		MaxBackoff:  time.Second,
+	}
+
+	testCases := []struct {
+		Name    string
+		Retryer request.Retryer
+	}{
+		{
+			Name: "no retryer",
+		},
+		{
+			Name:    "existing retryer",
+			Retryer: client.DefaultRetryer{NumMaxRetries: 25, MinRetryDelay: 2 * time.Second},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			config := &aws.Config{MaxRetries: aws.Int(25)}
+
+			if testCase.Retryer != nil {
+				config.Retryer = testCase.Retryer
+			}
+
+			sess := session.Must(session.NewSession(config))
+			rc.applyToSession(sess)
+
+			req := client.New(*sess.Config, metadata.ClientInfo{}, sess.Handlers).NewRequest(&request.Operation{Name: "Test"}, nil, nil)
+
+			// Both AWS SDKs make the same number of attempts, including the initial attempt.
+			if got, want := req.MaxRetries()+1, rc.retryer(nil).MaxAttempts(); got != want {
+				t.Errorf("AWS SDK for Go v1 attempts got %d, want %d", got, want)
+			}
+
+			if got, want := req.Retryer.RetryRules(req), time.Second; got > want {
+				t.Errorf("RetryRules got %s, want at most %s", got, want)
+			}
+
+			if testCase.Retryer == nil {
+				return
+			}
+
+			if got, want := req.Retryer.(*retryerV1).Retryer, testCase.Retryer; got != want {
+				t.Errorf("Retryer got %#v, want %#v", got, want)
+			}
+		})
+	}
+}
+
+func TestRetryConfigMaxRetries(t *testing.T) {
+	var rc *RetryConfig
+
+	if got, want := rc.maxRetries(9), 9; got != want {
+		t.Errorf("maxRetries got %d, want %d", got, want)
+	}
+
+	rc = &RetryConfig{MaxAttempts: 3}
+
+	if got, want := rc.maxRetries(9), 2; got != want {
+		t.Errorf("maxRetries got %d, want %d", got, want)
+	}
+}
+
+func TestRetryConfigRetryer(t *testing.T) {
+	var rc *RetryConfig
+
+	if got := rc.retryer(nil); got != nil {
+		t.Errorf("expected nil retryer, got %T", got)
+	}
+
+	rc = &RetryConfig{
+		ErrorCodes:  []string{"TooManyRequestsException"},
+		MaxAttempts: 10,
+		MaxBackoff:  30 * time.Second,
+	}
+
+	r := rc.retryer(retry_sdkv2.NewStandard())
+
+	if got, want := r.MaxAttempts(), 10; got != want {
+		t.Errorf("MaxAttempts got %d, want %d", got, want)
+	}
+
+	if !r.IsErrorRetryable(&smithy.GenericAPIError{Code: "TooManyRequestsException"}) {
+		t.Error("expected error to be retryable")
+	}
+
+	if r.IsErrorRetryable(&smithy.GenericAPIError{Code: "ValidationException"}) {
+		t.Error("expected error not to be retryable")
+	}
+}
@@ -131,6 +131,7 @@ import (
 {{ range .Services }}
 	"github.com/aws/aws-sdk-go{{ if eq .SDKVersion "2" }}-v2{{ end }}/service/{{ .GoPackage }}"
 {{- end }}
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/session"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
@@ -143,6 +144,7 @@ type AWSClient struct {
 	MediaConvertAccountConn   *mediaconvert.MediaConvert
 	Partition                 string
 	Region                    string
+	RequiredTagsConfig        *tftags.RequiredConfig
 	ReverseDNSPrefix          string
 	S3ConnURICleaningDisabled *s3.S3
 	Session                   *session.Session
@@ -167,4 +169,27 @@ func (client *AWSClient) PartitionHostname(prefix string) string {
 func (client *AWSClient) RegionalHostname(prefix string) string {
 	return fmt.Sprintf("%s.%s.%s", prefix, client.Region, client.DNSSuffix)
 }
+
+// GlobalARN returns an ARN with the partition and account ID for a global service resource
+// e.g. arn:aws:SERVICE::123456789012:RESOURCE
+func (client *AWSClient) GlobalARN(service, resource string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   service,
+		AccountID: client.AccountID,
+		Resource:  resource,
+	}.String()
+}
+
+// RegionalARN returns an ARN with the partition, region and account ID for a regional service resource
+// e.g. arn:aws:SERVICE:us-west-2:123456789012:RESOURCE
+func (client *AWSClient) RegionalARN(service, resource string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   service,
+		Region:    client.Region,
+		AccountID: client.AccountID,
+		Resource:  resource,
+	}.String()
+}
 `
@@ -122,7 +122,6 @@ var tmpl = `
 package conns
 
 import (
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 {{- range .Services }}
 	"github.com/aws/aws-sdk-go{{ if eq .SDKVersion "2" }}-v2{{ end }}/service/{{ .GoPackage }}"
@@ -133,7 +132,7 @@ import (
 func (c *Config) clientConns(sess *session.Session) *AWSClient {
 	return &AWSClient{
 		{{- range .Services }}
-		{{ .ProviderNameUpper }}Conn: {{ .GoPackage }}.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.{{ .ProviderNameUpper }}])})),
+		{{ .ProviderNameUpper }}Conn: {{ .GoPackage }}.New(c.serviceSession(sess, names.{{ .ProviderNameUpper }})),
 		{{- end }}
 	}
 }
@@ -0,0 +1,922 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+)
+
+//go:embed resource.tmpl
+var resourceTmpl string
+
+//go:embed resources.tmpl
+var resourcesTmpl string
+
+//go:embed doc.tmpl
+var docTmpl string
+
+const (
+	allowListFile  = "resource_types.txt"
+	docsDir        = "../../../website/docs/r"
+	resourcesFile  = "resources_gen.go"
+	schemasDir     = "schemas"
+	tfTypeNameBase = "aws_cloudcontrolapi_"
+)
+
+var download = flag.Bool("download", false, "whether to download missing CloudFormation resource schemas using the CloudFormation DescribeType API")
+
+// Attribute is a Terraform attribute generated from a CloudFormation resource property.
+type Attribute struct {
+	Name        string
+	Property    string
+	Description string
+
+	Type     string // Terraform schema type, e.g. "TypeString"
+	ElemType string // Terraform schema type of primitive list or set elements
+	Nested   []*Attribute
+
+	Computed  bool
+	ForceNew  bool
+	JSON      bool
+	MaxItems  int
+	MinItems  int
+	Object    bool
+	Optional  bool
+	Required  bool
+	Tags      bool // Whether the property is the resource tags, represented by the "tags" and "tags_all" maps
+	WriteOnly bool
+
+	ValidateFunc string
+}
+
+// Resource is a Terraform resource generated from a CloudFormation resource schema.
+type Resource struct {
+	TypeName    string // CloudFormation resource type name, e.g. "AWS::Forecast::Dataset"
+	TFTypeName  string // Terraform resource type name, e.g. "aws_cloudcontrolapi_forecast_dataset"
+	FuncName    string // e.g. "ResourceForecastDataset"
+	HumanName   string // e.g. "Forecast Dataset"
+	Description string
+	Updatable   bool
+	Attributes  []*Attribute
+	Identifier  []string // Attribute names of the primary identifier properties
+
+	UsesTags       bool
+	UsesValidation bool
+	UsesVerify     bool
+}
+
+type generator struct {
+	resource       *cfschema.Resource
+	updatable      bool
+	usesTags       bool
+	usesValidation bool
+	usesVerify     bool
+}
+
+func main() {
+	flag.Parse()
+
+	typeNames, err := readAllowList(allowListFile)
+
+	if err != nil {
+		log.Fatalf("error reading %s: %s", allowListFile, err)
+	}
+
+	var resources []*Resource
+
+	for _, typeName := range typeNames {
+		r, err := newResource(typeName)
+
+		if err != nil {
+			log.Fatalf("error generating %s: %s", typeName, err)
+		}
+
+		filename := strings.TrimPrefix(r.TFTypeName, tfTypeNameBase) + "_gen.go"
+
+		fmt.Printf("Generating internal/service/cloudcontrol/%s\n", filename)
+
+		if err := writeTemplate(filename, resourceTmpl, r, true); err != nil {
+			log.Fatalf("error generating %s: %s", filename, err)
+		}
+
+		docFilename := filepath.Join(docsDir, strings.TrimPrefix(r.TFTypeName, "aws_")+".html.markdown")
+
+		fmt.Printf("Generating %s\n", strings.TrimPrefix(docFilename, "../../../"))
+
+		if err := writeTemplate(docFilename, docTmpl, r, false); err != nil {
+			log.Fatalf("error generating %s: %s", docFilename, err)
+		}
+
+		resources = append(resources, r)
+	}
+
+	fmt.Printf("Generating internal/service/cloudcontrol/%s\n", resourcesFile)
+
+	if err := writeTemplate(resourcesFile, resourcesTmpl, resources, true); err != nil {
+		log.Fatalf("error generating %s: %s", resourcesFile, err)
+	}
+}
+
+// readAllowList returns the CloudFormation resource type names listed in the file, ignoring blank lines and comments.
+func readAllowList(filename string) ([]string, error) {
+	f, err := os.Open(filename)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	var typeNames []string
+	scanner := bufio.NewScanner(f)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if parts := strings.Split(line, "::"); len(parts) != 3 {
+			return nil, fmt.Errorf("invalid CloudFormation resource type name: %s", line)
+		}
+
+		typeNames = append(typeNames, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Strings(typeNames)
+
+	return typeNames, nil
+}
+
+// readSchema returns the CloudFormation resource schema for the type name, downloading it if requested and not present.
+func readSchema(typeName string) (*cfschema.Resource, error) {
+	filename := filepath.Join(schemasDir, strings.ReplaceAll(typeName, "::", "_")+".json")
+
+	if _, err := os.Stat(filename); os.IsNotExist(err) && *download {
+		if err := downloadSchema(typeName, filename); err != nil {
+			return nil, err
+		}
+	}
+
+	b, err := os.ReadFile(filename)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading schema (run with -download to fetch missing schemas): %w", err)
+	}
+
+	document, err := cfschema.Sanitize(string(b))
+
+	if err != nil {
+		return nil, fmt.Errorf("error sanitizing schema: %w", err)
+	}
+
+	jsonSchema, err := cfschema.NewResourceJsonSchemaDocument(document)
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing schema: %w", err)
+	}
+
+	resource, err := jsonSchema.Resource()
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing schema: %w", err)
+	}
+
+	if err := resource.Expand(); err != nil {
+		return nil, fmt.Errorf("error expanding schema: %w", err)
+	}
+
+	return resource, nil
+}
+
+func downloadSchema(typeName, filename string) error {
+	fmt.Printf("Downloading %s schema\n", typeName)
+
+	sess, err := session.NewSessionWithOptions(session.Options{
+		SharedConfigState: session.SharedConfigEnable,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating AWS session: %w", err)
+	}
+
+	output, err := cloudformation.New(sess).DescribeType(&cloudformation.DescribeTypeInput{
+		Type:     aws.String(cloudformation.RegistryTypeResource),
+		TypeName: aws.String(typeName),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error describing CloudFormation Type (%s): %w", typeName, err)
+	}
+
+	return os.WriteFile(filename, []byte(aws.StringValue(output.Schema)), 0644)
+}
+
+func newResource(typeName string) (*Resource, error) {
+	schema, err := readSchema(typeName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parts := strings.Split(typeName, "::")
+	_, updatable := schema.Handlers["update"]
+
+	r := &Resource{
+		TypeName:    typeName,
+		TFTypeName:  tfTypeNameBase + strings.ToLower(parts[1]) + "_" + snakeCase(parts[2]),
+		FuncName:    "Resource" + parts[1] + parts[2],
+		HumanName:   parts[1] + " " + words(parts[2]),
+		Description: aws.StringValue(schema.Description),
+		Updatable:   updatable,
+	}
+
+	g := &generator{
+		resource:  schema,
+		updatable: updatable,
+	}
+
+	if r.Attributes, err = g.attributes(schema.Properties, schema.Required, nil, r.TFTypeName); err != nil {
+		return nil, err
+	}
+
+	for _, ptr := range schema.PrimaryIdentifier {
+		for _, a := range r.Attributes {
+			if path := ptr.Path(); len(path) == 1 && a.Property == path[0] {
+				r.Identifier = append(r.Identifier, a.Name)
+			}
+		}
+	}
+
+	r.UsesTags = g.usesTags
+	r.UsesValidation = g.usesValidation
+	r.UsesVerify = g.usesVerify
+
+	return r, nil
+}
+
+// attributes returns the Terraform attributes for CloudFormation properties.
+// The path is the property path of the parent object, and is empty for top-level properties.
+func (g *generator) attributes(properties map[string]*cfschema.Property, required []string, path []string, tfTypeName string) ([]*Attribute, error) {
+	var attributes []*Attribute
+	names := make(map[string]string)
+
+	for _, propertyName := range sortedKeys(properties) {
+		property := properties[propertyName]
+		name := snakeCase(propertyName)
+
+		// Top-level attribute names reserved by Terraform are prefixed with the resource name.
+		if len(path) == 0 && reservedNames[name] {
+			name = strings.TrimPrefix(tfTypeName, tfTypeNameBase) + "_" + name
+		}
+
+		if v, ok := names[name]; ok {
+			return nil, fmt.Errorf("properties %s and %s have the same attribute name: %s", v, propertyName, name)
+		}
+
+		names[name] = propertyName
+
+		a, err := g.attribute(name, propertyName, property, contains(required, propertyName), append(path[:len(path):len(path)], propertyName))
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", propertyName, err)
+		}
+
+		attributes = append(attributes, a)
+	}
+
+	return attributes, nil
+}
+
+func (g *generator) attribute(name, propertyName string, property *cfschema.Property, required bool, path []string) (*Attribute, error) {
+	a := &Attribute{
+		Name:        name,
+		Property:    propertyName,
+		Description: aws.StringValue(property.Description),
+	}
+
+	switch {
+	case g.isPath(g.resource.ReadOnlyProperties, path):
+		a.Computed = true
+	case required:
+		a.Required = true
+	default:
+		a.Optional = true
+		a.Computed = true
+	}
+
+	if !a.Computed || a.Optional {
+		a.ForceNew = g.isPath(g.resource.CreateOnlyProperties, path) || (len(path) == 1 && !g.updatable)
+	}
+
+	a.WriteOnly = len(path) == 1 && g.isPath(g.resource.WriteOnlyProperties, path)
+
+	// Top-level resource tags use the provider's tags handling, including default and ignored tags.
+	if len(path) == 1 && propertyName == "Tags" && !g.isPath(g.resource.ReadOnlyProperties, path) && isKeyValueTags(property) {
+		a.Type = "TypeMap"
+		a.ElemType = "TypeString"
+		a.Tags = true
+		a.Computed = false
+		a.Required = false
+		a.Optional = true
+		g.usesTags = true
+
+		return a, nil
+	}
+
+	switch property.Type.String() {
+	case cfschema.PropertyTypeBoolean:
+		a.Type = "TypeBool"
+	case cfschema.PropertyTypeInteger:
+		a.Type = "TypeInt"
+		a.ValidateFunc = intValidateFunc(property)
+	case cfschema.PropertyTypeNumber:
+		a.Type = "TypeFloat"
+	case cfschema.PropertyTypeString:
+		a.Type = "TypeString"
+		a.ValidateFunc = stringValidateFunc(property)
+	case cfschema.PropertyTypeArray:
+		a.Type = "TypeList"
+
+		if property.InsertionOrder != nil && !*property.InsertionOrder {
+			a.Type = "TypeSet"
+		}
+
+		if !a.Computed || a.Optional {
+			a.MaxItems = aws.IntValue(property.MaxItems)
+			a.MinItems = aws.IntValue(property.MinItems)
+		}
+
+		items := property.Items
+
+		switch {
+		case items == nil:
+			setJSON(a)
+		case isPrimitive(items.Type.String()):
+			a.ElemType = primitiveTypes[items.Type.String()]
+		case len(items.Properties) > 0:
+			nested, err := g.attributes(items.Properties, items.Required, append(path, "*"), "")
+
+			if err != nil {
+				return nil, err
+			}
+
+			a.Nested = nested
+		default:
+			setJSON(a)
+		}
+	case cfschema.PropertyTypeObject, "":
+		switch {
+		case len(property.Properties) > 0:
+			nested, err := g.attributes(property.Properties, property.Required, path, "")
+
+			if err != nil {
+				return nil, err
+			}
+
+			a.Type = "TypeList"
+			a.Object = true
+			a.Nested = nested
+
+			if !a.Computed || a.Optional {
+				a.MaxItems = 1
+			}
+		case isStringMap(property):
+			a.Type = "TypeMap"
+			a.ElemType = "TypeString"
+		default:
+			setJSON(a)
+		}
+	default:
+		setJSON(a)
+	}
+
+	if a.ValidateFunc != "" && a.Computed && !a.Optional {
+		a.ValidateFunc = ""
+	}
+
+	if a.ValidateFunc != "" {
+		g.usesValidation = true
+	}
+
+	if a.JSON {
+		g.usesVerify = true
+
+		if a.ValidateFunc != "" {
+			g.usesValidation = true
+		}
+	}
+
+	return a, nil
+}
+
+// isPath returns whether the JSON pointers contain the property path, with or without array item wildcards.
+func (g *generator) isPath(ptrs cfschema.PropertyJsonPointers, path []string) bool {
+	if ptrs.ContainsPath(path) {
+		return true
+	}
+
+	var withoutWildcards []string
+
+	for _, v := range path {
+		if v != "*" {
+			withoutWildcards = append(withoutWildcards, v)
+		}
+	}
+
+	return ptrs.ContainsPath(withoutWildcards)
+}
+
+func setJSON(a *Attribute) {
+	a.Type = "TypeString"
+	a.ElemType = ""
+	a.JSON = true
+	a.MaxItems = 0
+	a.MinItems = 0
+	a.Nested = nil
+	a.Object = false
+
+	if !a.Computed || a.Optional {
+		a.ValidateFunc = "validation.StringIsJSON"
+	}
+}
+
+var primitiveTypes = map[string]string{
+	cfschema.PropertyTypeBoolean: "TypeBool",
+	cfschema.PropertyTypeInteger: "TypeInt",
+	cfschema.PropertyTypeNumber:  "TypeFloat",
+	cfschema.PropertyTypeString:  "TypeString",
+}
+
+func isPrimitive(t string) bool {
+	_, ok := primitiveTypes[t]
+
+	return ok
+}
+
+// isKeyValueTags returns whether the property is an array of objects with Key and Value string properties.
+func isKeyValueTags(property *cfschema.Property) bool {
+	if property.Type.String() != cfschema.PropertyTypeArray || property.Items == nil || len(property.Items.Properties) != 2 {
+		return false
+	}
+
+	for _, k := range []string{"Key", "Value"} {
+		if v, ok := property.Items.Properties[k]; !ok || v.Type.String() != cfschema.PropertyTypeString {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isStringMap returns whether the property is an object with string values for any key.
+func isStringMap(property *cfschema.Property) bool {
+	if len(property.PatternProperties) != 1 {
+		return false
+	}
+
+	for _, v := range property.PatternProperties {
+		return v.Type.String() == cfschema.PropertyTypeString
+	}
+
+	return false
+}
+
+func intValidateFunc(property *cfschema.Property) string {
+	switch {
+	case property.Minimum != nil && property.Maximum != nil:
+		return fmt.Sprintf("validation.IntBetween(%d, %d)", *property.Minimum, *property.Maximum)
+	case property.Minimum != nil:
+		return fmt.Sprintf("validation.IntAtLeast(%d)", *property.Minimum)
+	case property.Maximum != nil:
+		return fmt.Sprintf("validation.IntAtMost(%d)", *property.Maximum)
+	}
+
+	return ""
+}
+
+func stringValidateFunc(property *cfschema.Property) string {
+	if len(property.Enum) > 0 {
+		var values []string
+
+		for _, v := range property.Enum {
+			if v, ok := v.(string); ok {
+				values = append(values, fmt.Sprintf("%q", v))
+			}
+		}
+
+		return fmt.Sprintf("validation.StringInSlice([]string{%s}, false)", strings.Join(values, ", "))
+	}
+
+	switch {
+	case property.MinLength != nil && property.MaxLength != nil:
+		return fmt.Sprintf("validation.StringLenBetween(%d, %d)", *property.MinLength, *property.MaxLength)
+	case property.MinLength != nil && *property.MinLength > 0:
+		return fmt.Sprintf("validation.StringLenBetween(%d, %d)", *property.MinLength, 1<<31-1)
+	case property.MaxLength != nil:
+		return fmt.Sprintf("validation.StringLenBetween(%d, %d)", 0, *property.MaxLength)
+	}
+
+	return ""
+}
+
+// reservedNames are top-level attribute names which cannot be used in Terraform resource schemas.
+var reservedNames = map[string]bool{
+	"connection":  true,
+	"count":       true,
+	"depends_on":  true,
+	"for_each":    true,
+	"id":          true,
+	"lifecycle":   true,
+	"provider":    true,
+	"provisioner": true,
+}
+
+// snakeCase converts a CloudFormation property name to a Terraform attribute name, e.g. "KmsKeyArn" to "kms_key_arn".
+func snakeCase(s string) string {
+	runes := []rune(s)
+
+	var b strings.Builder
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteRune('_')
+			}
+		}
+
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
+
+// words converts a CloudFormation resource name to space separated words, e.g. "JobTemplate" to "Job Template".
+func words(s string) string {
+	parts := strings.Split(snakeCase(s), "_")
+
+	for i, v := range parts {
+		if v == "id" {
+			parts[i] = "ID"
+			continue
+		}
+
+		parts[i] = strings.ToUpper(v[:1]) + v[1:]
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortedKeys(m map[string]*cfschema.Property) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+var funcMap = template.FuncMap{
+	"attributeMap":  attributeMap,
+	"schemaMap":     schemaMap,
+	"example":       example,
+	"argumentDocs":  argumentDocs,
+	"attributeDocs": attributeDocs,
+	"nestedDocs":    nestedDocs,
+	"join":          strings.Join,
+}
+
+func writeTemplate(filename, body string, data interface{}, goSource bool) error {
+	tmpl, err := template.New(filename).Funcs(funcMap).Parse(body)
+
+	if err != nil {
+		return fmt.Errorf("error parsing template: %w", err)
+	}
+
+	var buffer bytes.Buffer
+
+	if err := tmpl.Execute(&buffer, data); err != nil {
+		return fmt.Errorf("error executing template: %w", err)
+	}
+
+	contents := buffer.Bytes()
+
+	if goSource {
+		if contents, err = format.Source(contents); err != nil {
+			return fmt.Errorf("error formatting generated source: %w", err)
+		}
+	}
+
+	return os.WriteFile(filename, contents, 0644)
+}
+
+// attributeMap returns Go source for the attribute to CloudFormation property mapping.
+func attributeMap(attributes []*Attribute) string {
+	var b strings.Builder
+
+	b.WriteString("map[string]*attribute{\n")
+
+	for _, a := range attributes {
+		fmt.Fprintf(&b, "%q: {\nProperty: %q,\n", a.Name, a.Property)
+
+		if a.Tags {
+			b.WriteString("Tags: true,\n")
+		}
+
+		if a.Object {
+			b.WriteString("Object: true,\n")
+		}
+
+		if a.JSON {
+			b.WriteString("JSON: true,\n")
+		}
+
+		if a.WriteOnly {
+			b.WriteString("WriteOnly: true,\n")
+		}
+
+		if len(a.Nested) > 0 {
+			fmt.Fprintf(&b, "Attributes: %s,\n", attributeMap(a.Nested))
+		}
+
+		b.WriteString("},\n")
+	}
+
+	b.WriteString("}")
+
+	return b.String()
+}
+
+// schemaMap returns Go source for the Terraform schema of the attributes.
+func schemaMap(attributes []*Attribute) string {
+	var b strings.Builder
+
+	b.WriteString("map[string]*schema.Schema{\n")
+
+	for _, a := range attributes {
+		if a.Tags {
+			if a.ForceNew {
+				fmt.Fprintf(&b, "%q: tftags.TagsSchemaForceNew(),\n", a.Name)
+			} else {
+				fmt.Fprintf(&b, "%q: tftags.TagsSchema(),\n", a.Name)
+			}
+
+			b.WriteString("\"tags_all\": tftags.TagsSchemaComputed(),\n")
+
+			continue
+		}
+
+		fmt.Fprintf(&b, "%q: {\nType: schema.%s,\n", a.Name, a.Type)
+
+		for _, v := range []struct {
+			field string
+			value bool
+		}{
+			{"Required", a.Required},
+			{"Optional", a.Optional},
+			{"Computed", a.Computed},
+			{"ForceNew", a.ForceNew},
+		} {
+			if v.value {
+				fmt.Fprintf(&b, "%s: true,\n", v.field)
+			}
+		}
+
+		if a.MaxItems > 0 {
+			fmt.Fprintf(&b, "MaxItems: %d,\n", a.MaxItems)
+		}
+
+		if a.MinItems > 0 {
+			fmt.Fprintf(&b, "MinItems: %d,\n", a.MinItems)
+		}
+
+		if a.ValidateFunc != "" {
+			fmt.Fprintf(&b, "ValidateFunc: %s,\n", a.ValidateFunc)
+		}
+
+		if a.JSON {
+			b.WriteString("DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,\n")
+		}
+
+		switch {
+		case len(a.Nested) > 0:
+			fmt.Fprintf(&b, "Elem: &schema.Resource{\nSchema: %s,\n},\n", schemaMap(a.Nested))
+		case a.ElemType != "":
+			fmt.Fprintf(&b, "Elem: &schema.Schema{Type: schema.%s},\n", a.ElemType)
+		}
+
+		b.WriteString("},\n")
+	}
+
+	b.WriteString("}")
+
+	return b.String()
+}
+
+// example returns a Terraform configuration body with placeholder values for the required attributes.
+// Nested blocks without required attributes are populated with their optional attributes instead.
+func example(attributes []*Attribute, indent string) string {
+	body := exampleAttributes(attributes, indent, func(a *Attribute) bool { return a.Required })
+
+	if body == "" && indent != "  " {
+		body = exampleAttributes(attributes, indent, func(a *Attribute) bool { return a.Optional })
+	}
+
+	return body
+}
+
+func exampleAttributes(attributes []*Attribute, indent string, include func(*Attribute) bool) string {
+	var values []*Attribute
+	var blocks []string
+	width := 0
+
+	for _, a := range attributes {
+		if !include(a) {
+			continue
+		}
+
+		if len(a.Nested) > 0 {
+			blocks = append(blocks, fmt.Sprintf("\n%s%s {\n%s%s}\n", indent, a.Name, example(a.Nested, indent+"  "), indent))
+
+			continue
+		}
+
+		if len(a.Name) > width {
+			width = len(a.Name)
+		}
+
+		values = append(values, a)
+	}
+
+	var b strings.Builder
+
+	for _, a := range values {
+		fmt.Fprintf(&b, "%s%-*s = %s\n", indent, width, a.Name, exampleValue(a))
+	}
+
+	for i, block := range blocks {
+		if i == 0 && len(values) == 0 {
+			block = strings.TrimPrefix(block, "\n")
+		}
+
+		b.WriteString(block)
+	}
+
+	return b.String()
+}
+
+func exampleValue(a *Attribute) string {
+	if a.JSON {
+		return "jsonencode({})"
+	}
+
+	elem := func(t string) string {
+		switch t {
+		case "TypeBool":
+			return "true"
+		case "TypeFloat", "TypeInt":
+			return "1"
+		default:
+			return `"example"`
+		}
+	}
+
+	switch a.Type {
+	case "TypeList", "TypeSet":
+		return "[" + elem(a.ElemType) + "]"
+	case "TypeMap":
+		return `{ example = "example" }`
+	}
+
+	if strings.HasPrefix(a.ValidateFunc, "validation.StringInSlice([]string{") {
+		return strings.SplitN(strings.TrimPrefix(a.ValidateFunc, "validation.StringInSlice([]string{"), ",", 2)[0]
+	}
+
+	return elem(a.Type)
+}
+
+func docDescription(a *Attribute) string {
+	description := strings.Join(strings.Fields(a.Description), " ")
+
+	if description == "" {
+		description = fmt.Sprintf("Value of the `%s` property.", a.Property)
+	}
+
+	if !strings.HasSuffix(description, ".") {
+		description += "."
+	}
+
+	if a.JSON {
+		description += " JSON string."
+	}
+
+	if a.Tags {
+		description = "A map of tags to assign to the resource. If configured with a provider [`default_tags` configuration block](/docs/providers/aws/index.html#default_tags-configuration-block) present, tags with matching keys will overwrite those defined at the provider-level."
+	}
+
+	if len(a.Nested) > 0 {
+		description += fmt.Sprintf(" See [%s](#%s) below.", a.Name, a.Name)
+	}
+
+	return description
+}
+
+// argumentDocs returns Markdown list items for the required or optional arguments.
+func argumentDocs(attributes []*Attribute, required bool) string {
+	var b strings.Builder
+
+	for _, a := range attributes {
+		switch {
+		case required && a.Required:
+			fmt.Fprintf(&b, "* `%s` - (Required) %s\n", a.Name, docDescription(a))
+		case !required && a.Optional:
+			fmt.Fprintf(&b, "* `%s` - (Optional) %s\n", a.Name, docDescription(a))
+		}
+	}
+
+	return b.String()
+}
+
+// attributeDocs returns Markdown list items for the read-only attributes.
+func attributeDocs(attributes []*Attribute) string {
+	var b strings.Builder
+
+	for _, a := range attributes {
+		if a.Computed && !a.Optional {
+			fmt.Fprintf(&b, "* `%s` - %s\n", a.Name, docDescription(a))
+		}
+
+		if a.Tags {
+			b.WriteString("* `tags_all` - A map of tags assigned to the resource, including those inherited from the provider [`default_tags` configuration block](/docs/providers/aws/index.html#default_tags-configuration-block).\n")
+		}
+	}
+
+	return b.String()
+}
+
+// nestedDocs returns Markdown sections for the nested blocks.
+func nestedDocs(attributes []*Attribute) string {
+	var b strings.Builder
+
+	for _, a := range attributes {
+		if len(a.Nested) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&b, "\n### %s\n\n", a.Name)
+
+		for _, v := range a.Nested {
+			switch {
+			case v.Required:
+				fmt.Fprintf(&b, "* `%s` - (Required) %s\n", v.Name, docDescription(v))
+			case v.Optional:
+				fmt.Fprintf(&b, "* `%s` - (Optional) %s\n", v.Name, docDescription(v))
+			default:
+				fmt.Fprintf(&b, "* `%s` - %s\n", v.Name, docDescription(v))
+			}
+		}
+
+		b.WriteString(nestedDocs(a.Nested))
+	}
+
+	return b.String()
+}
@@ -148,6 +148,7 @@ type TemplateData struct {
 	HelperSchemaPkg bool
 	StrConvPkg      bool
 	TfResourcePkg   bool
+	TypesPkg        bool
 
 	AwsSdkVersion int
 }
@@ -206,6 +207,7 @@ func main() {
 		HelperSchemaPkg: awsPkg == "autoscaling",
 		StrConvPkg:      awsPkg == "autoscaling",
 		TfResourcePkg:   *getTag,
+		TypesPkg:        *serviceTagsSlice || *parentNotFoundErrCode != "",
 
 		ListTagsInFiltIDName:    *listTagsInFiltIDName,
 		ListTagsInIDElem:        *listTagsInIDElem,
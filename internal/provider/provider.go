@@ -6,6 +6,7 @@ import (
 	"log"
 	"os"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
@@ -101,6 +102,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/inspector"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iot"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kafkaconnect"
@@ -114,6 +116,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lexmodels"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/location"
@@ -135,6 +138,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/pipes"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pricing"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
@@ -154,6 +158,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/s3outposts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sagemaker"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/scheduler"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/schemas"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
@@ -162,6 +167,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicediscovery"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicequotas"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ses"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sfn"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/shield"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/signer"
@@ -185,6 +191,7 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"gopkg.in/yaml.v2"
 )
 
 // Provider returns a *schema.Provider.
@@ -209,8 +216,32 @@ func Provider() *schema.Provider {
 				ConflictsWith: []string{"forbidden_account_ids"},
 				Set:           schema.HashString,
 			},
+			"allowed_organization_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "List of allowed AWS Organizations organization IDs. The account must be a member of one of the organizations.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^o-[0-9a-z]{10,32}$`), "must be a valid AWS Organizations organization ID"),
+				},
+			},
+			"allowed_organizational_unit_paths": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "List of allowed AWS Organizations organizational unit paths, e.g. `o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/`. The account must be in one of the organizational units or their descendants.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^o-[0-9a-z]{10,32}/r-[0-9a-z]{4,32}/([0-9a-z-]+/)*\*?$`), "must be a valid AWS Organizations organizational unit path"),
+				},
+			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"audit_log_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path of a file to which a JSON line is appended for every AWS API operation " +
+					"that may modify resources. Sensitive request parameters are redacted.",
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -247,6 +278,12 @@ func Provider() *schema.Provider {
 					"Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
 			"endpoints": endpointsSchema(),
+			"endpoints_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path of a JSON or YAML file containing a map of service identifier or alias to endpoint URL. " +
+					"A `*` key sets the endpoint URL of all other services, with `{service}` replaced by the service identifier.",
+			},
 			"forbidden_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -281,6 +318,26 @@ func Provider() *schema.Provider {
 							Set:         schema.HashString,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_regexes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Set:         schema.HashString,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
+						"value_regexes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Set:         schema.HashString,
+							Description: "Regular expressions matching values of resource tags to ignore across all resources.",
+						},
 					},
 				},
 			},
@@ -306,12 +363,51 @@ func Provider() *schema.Provider {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"rate_limit": rateLimitSchema(),
+			"read_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Reject any AWS API operation that may modify resources. " +
+					"Used to run plans with credentials that should never mutate infrastructure.",
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"required_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to require resource tags across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"exempt_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource types, e.g. `aws_iam_role`, that are not required to have the resource tags.",
+						},
+						"keys": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource tag keys to require across all resources.",
+						},
+						"value_regexes": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Map of required resource tag keys to regular expressions that the tag values must match.",
+						},
+					},
+				},
+			},
+			"retry": retrySchema(),
 			"s3_force_path_style": {
 				Type:       schema.TypeBool,
 				Optional:   true,
@@ -824,7 +920,8 @@ func Provider() *schema.Provider {
 			"aws_redshift_service_account":     redshift.DataSourceServiceAccount(),
 			"aws_redshift_subnet_group":        redshift.DataSourceSubnetGroup(),
 
-			"aws_resourcegroupstaggingapi_resources": resourcegroupstaggingapi.DataSourceResources(),
+			"aws_resourcegroupstaggingapi_resources":          resourcegroupstaggingapi.DataSourceResources(),
+			"aws_resourcegroupstaggingapi_untagged_resources": resourcegroupstaggingapi.DataSourceUntaggedResources(),
 
 			"aws_route53_delegation_set":          route53.DataSourceDelegationSet(),
 			"aws_route53_traffic_policy_document": route53.DataSourceTrafficPolicyDocument(),
@@ -1583,6 +1680,11 @@ func Provider() *schema.Provider {
 			"aws_inspector_assessment_template": inspector.ResourceAssessmentTemplate(),
 			"aws_inspector_resource_group":      inspector.ResourceResourceGroup(),
 
+			"aws_inspector2_delegated_admin_account":    inspector2.ResourceDelegatedAdminAccount(),
+			"aws_inspector2_enabler":                    inspector2.ResourceEnabler(),
+			"aws_inspector2_member_association":         inspector2.ResourceMemberAssociation(),
+			"aws_inspector2_organization_configuration": inspector2.ResourceOrganizationConfiguration(),
+
 			"aws_iot_authorizer":                 iot.ResourceAuthorizer(),
 			"aws_iot_certificate":                iot.ResourceCertificate(),
 			"aws_iot_indexing_configuration":     iot.ResourceIndexingConfiguration(),
@@ -1656,6 +1758,13 @@ func Provider() *schema.Provider {
 			"aws_lex_intent":    lexmodels.ResourceIntent(),
 			"aws_lex_slot_type": lexmodels.ResourceSlotType(),
 
+			"aws_lexv2models_bot":         lexv2models.ResourceBot(),
+			"aws_lexv2models_bot_locale":  lexv2models.ResourceBotLocale(),
+			"aws_lexv2models_bot_version": lexv2models.ResourceBotVersion(),
+			"aws_lexv2models_intent":      lexv2models.ResourceIntent(),
+			"aws_lexv2models_slot":        lexv2models.ResourceSlot(),
+			"aws_lexv2models_slot_type":   lexv2models.ResourceSlotType(),
+
 			"aws_licensemanager_association":           licensemanager.ResourceAssociation(),
 			"aws_licensemanager_license_configuration": licensemanager.ResourceLicenseConfiguration(),
 
@@ -1768,6 +1877,8 @@ func Provider() *schema.Provider {
 			"aws_pinpoint_gcm_channel":               pinpoint.ResourceGCMChannel(),
 			"aws_pinpoint_sms_channel":               pinpoint.ResourceSMSChannel(),
 
+			"aws_pipes_pipe": pipes.ResourcePipe(),
+
 			"aws_qldb_ledger": qldb.ResourceLedger(),
 			"aws_qldb_stream": qldb.ResourceStream(),
 
@@ -1921,6 +2032,9 @@ func Provider() *schema.Provider {
 			"aws_sagemaker_workforce":                                 sagemaker.ResourceWorkforce(),
 			"aws_sagemaker_workteam":                                  sagemaker.ResourceWorkteam(),
 
+			"aws_scheduler_schedule":       scheduler.ResourceSchedule(),
+			"aws_scheduler_schedule_group": scheduler.ResourceScheduleGroup(),
+
 			"aws_schemas_discoverer": schemas.ResourceDiscoverer(),
 			"aws_schemas_registry":   schemas.ResourceRegistry(),
 			"aws_schemas_schema":     schemas.ResourceSchema(),
@@ -1981,6 +2095,12 @@ func Provider() *schema.Provider {
 			"aws_ses_receipt_rule_set":             ses.ResourceReceiptRuleSet(),
 			"aws_ses_template":                     ses.ResourceTemplate(),
 
+			"aws_sesv2_configuration_set":                   sesv2.ResourceConfigurationSet(),
+			"aws_sesv2_configuration_set_event_destination": sesv2.ResourceConfigurationSetEventDestination(),
+			"aws_sesv2_contact_list":                        sesv2.ResourceContactList(),
+			"aws_sesv2_dedicated_ip_pool":                   sesv2.ResourceDedicatedIPPool(),
+			"aws_sesv2_email_identity":                      sesv2.ResourceEmailIdentity(),
+
 			"aws_sfn_activity":      sfn.ResourceActivity(),
 			"aws_sfn_state_machine": sfn.ResourceStateMachine(),
 
@@ -2090,6 +2210,17 @@ func Provider() *schema.Provider {
 		},
 	}
 
+	// Resources generated from CloudFormation resource schemas and managed via the Cloud Control API.
+	for typeName, r := range cloudcontrol.GeneratedResources() {
+		provider.ResourcesMap[typeName] = r
+	}
+
+	// Resources as registered, before any required_tags exemptions are applied during configuration.
+	resources := make(map[string]*schema.Resource, len(provider.ResourcesMap))
+	for typeName, r := range provider.ResourcesMap {
+		resources[typeName] = r
+	}
+
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
@@ -2097,7 +2228,33 @@ func Provider() *schema.Provider {
 			// We can therefore assume that if it's missing it's 0.10 or 0.11
 			terraformVersion = "0.11+compatible"
 		}
-		return providerConfigure(ctx, d, terraformVersion)
+		meta, diags := providerConfigure(ctx, d, terraformVersion)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		// Required tags are enforced by verify.SetTagsDiff, which does not know the resource type.
+		// Exempt resource types therefore run their CustomizeDiff without the check.
+		if requiredTagsConfig := meta.(*conns.AWSClient).RequiredTagsConfig; requiredTagsConfig != nil {
+			for _, typeName := range requiredTagsConfig.ExemptResourceTypes {
+				r, ok := resources[typeName]
+
+				if !ok {
+					return nil, append(diags, diag.Errorf("required_tags exempt_resource_types: unknown resource type (%s)", typeName)...)
+				}
+
+				if r.CustomizeDiff == nil {
+					continue
+				}
+
+				exempt := *r
+				exempt.CustomizeDiff = verify.CustomizeDiffExemptFromRequiredTags(r.CustomizeDiff)
+				provider.ResourcesMap[typeName] = &exempt
+			}
+		}
+
+		return meta, diags
 	}
 
 	return provider
@@ -2106,16 +2263,17 @@ func Provider() *schema.Provider {
 func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVersion string) (interface{}, diag.Diagnostics) {
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		AuditLogPath:                   d.Get("audit_log_path").(string),
 		DefaultTagsConfig:              expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
 		Endpoints:                      make(map[string]string),
 		HTTPProxy:                      d.Get("http_proxy").(string),
-		IgnoreTagsConfig:               expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     d.Get("max_retries").(int),
 		Profile:                        d.Get("profile").(string),
+		ReadOnly:                       d.Get("read_only").(bool),
 		Region:                         d.Get("region").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool) || d.Get("s3_force_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
@@ -2149,9 +2307,18 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 		config.SharedCredentialsFiles = l
 	}
 
-	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 && l[0] != nil {
-		config.AssumeRole = expandAssumeRole(l[0].(map[string]interface{}))
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRole.RoleARN, config.AssumeRole.SessionName, config.AssumeRole.ExternalID)
+	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 {
+		assumeRoles, err := expandAssumeRoles(l)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		for i, v := range assumeRoles {
+			log.Printf("[INFO] assume_role configuration set (hop %d of %d): (ARN: %q, SessionID: %q, ExternalID: %q)", i+1, len(assumeRoles), v.RoleARN, v.SessionName, v.ExternalID)
+		}
+
+		config.AssumeRole = assumeRoles[0]
+		config.AssumeRoleChain = assumeRoles[1:]
 	}
 
 	if l, ok := d.Get("assume_role_with_web_identity").([]interface{}); ok && len(l) > 0 && l[0] != nil {
@@ -2164,6 +2331,12 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 		return nil, diag.FromErr(err)
 	}
 
+	if v := d.Get("endpoints_file").(string); v != "" {
+		if err := expandEndpointsFile(v, config.Endpoints); err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
 		for _, accountIDRaw := range v.(*schema.Set).List() {
 			config.AllowedAccountIds = append(config.AllowedAccountIds, accountIDRaw.(string))
@@ -2176,6 +2349,42 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 		}
 	}
 
+	if v, ok := d.GetOk("allowed_organization_ids"); ok {
+		for _, organizationIDRaw := range v.(*schema.Set).List() {
+			config.AllowedOrganizationIDs = append(config.AllowedOrganizationIDs, organizationIDRaw.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("allowed_organizational_unit_paths"); ok {
+		for _, pathRaw := range v.(*schema.Set).List() {
+			config.AllowedOrganizationalUnitPaths = append(config.AllowedOrganizationalUnitPaths, pathRaw.(string))
+		}
+	}
+
+	rateLimitConfigs, err := expandRateLimitConfigs(d.Get("rate_limit").([]interface{}))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	config.RateLimitConfigs = rateLimitConfigs
+
+	ignoreTagsConfig, err := expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{}))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	config.IgnoreTagsConfig = ignoreTagsConfig
+
+	requiredTagsConfig, err := expandProviderRequiredTags(d.Get("required_tags").([]interface{}))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	config.RequiredTagsConfig = requiredTagsConfig
+
+	retryConfigs, err := expandRetryConfigs(d.Get("retry").([]interface{}))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	config.RetryConfigs = retryConfigs
+
 	if v, null, _ := nullable.Bool(d.Get("skip_metadata_api_check").(string)).Value(); !null {
 		if v {
 			config.EC2MetadataServiceEnableState = imds.ClientDisabled
@@ -2191,23 +2400,20 @@ func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
-		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
-					Type:          schema.TypeString,
-					Optional:      true,
-					Description:   "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
-					ValidateFunc:  validAssumeRoleDuration,
-					ConflictsWith: []string{"assume_role.0.duration_seconds"},
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					ValidateFunc: validAssumeRoleDuration,
 				},
 				"duration_seconds": {
-					Type:          schema.TypeInt,
-					Optional:      true,
-					Deprecated:    "Use assume_role.duration instead",
-					Description:   "The duration, in seconds, of the role session.",
-					ValidateFunc:  validation.IntBetween(900, 43200),
-					ConflictsWith: []string{"assume_role.0.duration"},
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Deprecated:   "Use assume_role.duration instead",
+					Description:  "The duration, in seconds, of the role session.",
+					ValidateFunc: validation.IntBetween(900, 43200),
 				},
 				"external_id": {
 					Type:        schema.TypeString,
@@ -2339,6 +2545,107 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func rateLimitSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with per-service client-side rate limiting settings.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of API requests to the service that can be made at once. Defaults to `requests_per_second`, rounded up.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Required:     true,
+					Description:  "The sustained rate of API requests to the service.",
+					ValidateFunc: validation.FloatAtLeast(0.01),
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The service identifier, or one of its aliases, as used in the `endpoints` configuration block.",
+					ValidateFunc: validation.StringInSlice(names.Aliases(), false),
+				},
+			},
+		},
+	}
+}
+
+func retrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with per-service retry settings.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_attempts": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of attempts of an API request to the service, including the initial attempt. Overrides `max_retries` for the service.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"max_backoff": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The maximum delay between retries of an API request to the service. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					ValidateFunc: validRetryMaxBackoff,
+				},
+				"retryable_error_codes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Additional AWS API error codes on which to retry requests to the service.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"retryable_error_messages": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Additional AWS API error message substrings on which to retry requests to the service.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The service identifier, or one of its aliases, as used in the `endpoints` configuration block.",
+					ValidateFunc: validation.StringInSlice(names.Aliases(), false),
+				},
+			},
+		},
+	}
+}
+
+// expandAssumeRoles returns the ordered chain of IAM Roles to assume.
+// ConflictsWith cannot reference arguments of every element of a list, so conflicting arguments are checked here.
+func expandAssumeRoles(l []interface{}) ([]*awsbase.AssumeRole, error) {
+	assumeRoles := make([]*awsbase.AssumeRole, len(l))
+
+	for i, v := range l {
+		m, ok := v.(map[string]interface{})
+
+		if !ok {
+			m = map[string]interface{}{}
+		}
+
+		duration, _ := m["duration"].(string)
+		durationSeconds, _ := m["duration_seconds"].(int)
+
+		if duration != "" && durationSeconds != 0 {
+			return nil, fmt.Errorf("assume_role (hop %d of %d): only one of duration, duration_seconds can be specified", i+1, len(l))
+		}
+
+		assumeRoles[i] = expandAssumeRole(m)
+
+		if len(l) > 1 && assumeRoles[i].RoleARN == "" {
+			return nil, fmt.Errorf("assume_role (hop %d of %d): role_arn is required when assuming multiple IAM Roles", i+1, len(l))
+		}
+	}
+
+	return assumeRoles, nil
+}
+
 func expandAssumeRole(m map[string]interface{}) *awsbase.AssumeRole {
 	assumeRole := awsbase.AssumeRole{}
 
@@ -2469,9 +2776,9 @@ func expandProviderDefaultTags(l []interface{}) *tftags.DefaultConfig {
 	return defaultConfig
 }
 
-func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
+func expandProviderIgnoreTags(l []interface{}) (*tftags.IgnoreConfig, error) {
 	if len(l) == 0 || l[0] == nil {
-		return nil
+		return nil, nil
 	}
 
 	ignoreConfig := &tftags.IgnoreConfig{}
@@ -2485,7 +2792,163 @@ func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
 		ignoreConfig.KeyPrefixes = tftags.New(v.List())
 	}
 
-	return ignoreConfig
+	if v, ok := m["key_regexes"].(*schema.Set); ok {
+		for _, regexRaw := range v.List() {
+			r, err := regexp.Compile(regexRaw.(string))
+
+			if err != nil {
+				return nil, fmt.Errorf("error compiling ignore_tags key_regexes regular expression (%s): %w", regexRaw, err)
+			}
+
+			ignoreConfig.KeyRegexes = append(ignoreConfig.KeyRegexes, r)
+		}
+	}
+
+	if v, ok := m["value_regexes"].(*schema.Set); ok {
+		for _, regexRaw := range v.List() {
+			r, err := regexp.Compile(regexRaw.(string))
+
+			if err != nil {
+				return nil, fmt.Errorf("error compiling ignore_tags value_regexes regular expression (%s): %w", regexRaw, err)
+			}
+
+			ignoreConfig.ValueRegexes = append(ignoreConfig.ValueRegexes, r)
+		}
+	}
+
+	return ignoreConfig, nil
+}
+
+func expandProviderRequiredTags(l []interface{}) (*tftags.RequiredConfig, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+
+	requiredConfig := &tftags.RequiredConfig{}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["exempt_resource_types"].(*schema.Set); ok {
+		for _, resourceTypeRaw := range v.List() {
+			requiredConfig.ExemptResourceTypes = append(requiredConfig.ExemptResourceTypes, resourceTypeRaw.(string))
+		}
+	}
+
+	if v, ok := m["keys"].(*schema.Set); ok {
+		requiredConfig.Keys = tftags.New(v.List())
+	}
+
+	if v, ok := m["value_regexes"].(map[string]interface{}); ok && len(v) > 0 {
+		requiredConfig.ValueRegexes = make(map[string]*regexp.Regexp)
+
+		for k, v := range v {
+			if !requiredConfig.Keys.KeyExists(k) {
+				return nil, fmt.Errorf("required_tags value_regexes key (%s) is not a required tag key", k)
+			}
+
+			r, err := regexp.Compile(v.(string))
+
+			if err != nil {
+				return nil, fmt.Errorf("error compiling required_tags value_regexes (%s) regular expression: %w", k, err)
+			}
+
+			requiredConfig.ValueRegexes[k] = r
+		}
+	}
+
+	return requiredConfig, nil
+}
+
+func expandRateLimitConfigs(l []interface{}) (map[string]*conns.RateLimitConfig, error) {
+	if len(l) == 0 {
+		return nil, nil
+	}
+
+	rateLimitConfigs := make(map[string]*conns.RateLimitConfig)
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		service, err := names.ProviderPackageForAlias(tfMap["service"].(string))
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign rate limit configuration: %w", err)
+		}
+
+		if _, ok := rateLimitConfigs[service]; ok {
+			return nil, fmt.Errorf("duplicate rate limit configuration for service (%s)", service)
+		}
+
+		rateLimitConfig := &conns.RateLimitConfig{}
+
+		if v, ok := tfMap["burst"].(int); ok && v != 0 {
+			rateLimitConfig.Burst = v
+		}
+
+		if v, ok := tfMap["requests_per_second"].(float64); ok && v != 0 {
+			rateLimitConfig.RequestsPerSecond = v
+		}
+
+		rateLimitConfigs[service] = rateLimitConfig
+	}
+
+	return rateLimitConfigs, nil
+}
+
+func expandRetryConfigs(l []interface{}) (map[string]*conns.RetryConfig, error) {
+	if len(l) == 0 {
+		return nil, nil
+	}
+
+	retryConfigs := make(map[string]*conns.RetryConfig)
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		service, err := names.ProviderPackageForAlias(tfMap["service"].(string))
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign retry configuration: %w", err)
+		}
+
+		if _, ok := retryConfigs[service]; ok {
+			return nil, fmt.Errorf("duplicate retry configuration for service (%s)", service)
+		}
+
+		retryConfig := &conns.RetryConfig{}
+
+		if v, ok := tfMap["max_attempts"].(int); ok && v != 0 {
+			retryConfig.MaxAttempts = v
+		}
+
+		if v, ok := tfMap["max_backoff"].(string); ok && v != "" {
+			duration, _ := time.ParseDuration(v)
+			retryConfig.MaxBackoff = duration
+		}
+
+		if v, ok := tfMap["retryable_error_codes"].(*schema.Set); ok && v.Len() > 0 {
+			for _, v := range v.List() {
+				retryConfig.ErrorCodes = append(retryConfig.ErrorCodes, v.(string))
+			}
+		}
+
+		if v, ok := tfMap["retryable_error_messages"].(*schema.Set); ok && v.Len() > 0 {
+			for _, v := range v.List() {
+				retryConfig.ErrorMessages = append(retryConfig.ErrorMessages, v.(string))
+			}
+		}
+
+		retryConfigs[service] = retryConfig
+	}
+
+	return retryConfigs, nil
 }
 
 func expandEndpoints(endpointsSetList []interface{}, out map[string]string) error {
@@ -2521,8 +2984,75 @@ func expandEndpoints(endpointsSetList []interface{}, out map[string]string) erro
 			if v := os.Getenv(envvarDeprecated); v != "" {
 				log.Printf("[WARN] The environment variable %q is deprecated. Use %q instead.", envvarDeprecated, envvar)
 				out[service] = v
+				continue
 			}
 		}
+		for _, envvar := range names.EndpointURLEnvVars(service) {
+			if v := os.Getenv(envvar); v != "" {
+				out[service] = v
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// endpointsFileDefaultKey is the endpoints file key whose value is the endpoint URL of services
+// not otherwise configured. The {service} placeholder is replaced by the service identifier.
+const endpointsFileDefaultKey = "*"
+
+// expandEndpointsFile sets the endpoints of services not already configured from a JSON or YAML file
+// containing a map of service identifier or alias to endpoint URL.
+func expandEndpointsFile(filename string, out map[string]string) error {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("error reading endpoints file (%s): %w", filename, err)
+	}
+
+	// YAML is a superset of JSON.
+	var endpoints map[string]string
+	if err := yaml.Unmarshal(b, &endpoints); err != nil {
+		return fmt.Errorf("error parsing endpoints file (%s): %w", filename, err)
+	}
+
+	fileEndpoints := make(map[string]string)
+	for _, hclKey := range names.Aliases() {
+		if endpoints[hclKey] == "" {
+			continue
+		}
+
+		serviceKey, err := names.ProviderPackageForAlias(hclKey)
+		if err != nil {
+			return fmt.Errorf("failed to assign endpoint (%s): %w", hclKey, err)
+		}
+
+		// As with the endpoints configuration block, the service identifier takes precedence over its aliases.
+		if fileEndpoints[serviceKey] == "" || hclKey == serviceKey {
+			fileEndpoints[serviceKey] = endpoints[hclKey]
+		}
+	}
+
+	for k := range endpoints {
+		if k == endpointsFileDefaultKey {
+			continue
+		}
+
+		if _, err := names.ProviderPackageForAlias(k); err != nil {
+			return fmt.Errorf("error parsing endpoints file (%s): unsupported service: %s", filename, k)
+		}
+	}
+
+	for _, service := range names.ProviderPackages() {
+		if out[service] != "" {
+			continue
+		}
+
+		if v := fileEndpoints[service]; v != "" {
+			out[service] = v
+		} else if v := endpoints[endpointsFileDefaultKey]; v != "" {
+			out[service] = strings.ReplaceAll(v, "{service}", service)
+		}
 	}
 
 	return nil
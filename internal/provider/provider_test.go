@@ -2,9 +2,15 @@ package provider
 
 import (
 	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -135,6 +141,40 @@ func TestEndpointEnvVarPrecedence(t *testing.T) {
 			expectedService:  names.STS,
 			expectedEndpoint: "https://sts-config.fake.test",
 		},
+		{
+			endpoints: map[string]string{},
+			envvars: map[string]string{
+				"AWS_ENDPOINT_URL_STS": "https://sts-endpoint-url.fake.test",
+			},
+			expectedService:  names.STS,
+			expectedEndpoint: "https://sts-endpoint-url.fake.test",
+		},
+		{
+			endpoints: map[string]string{},
+			envvars: map[string]string{
+				"TF_AWS_STS_ENDPOINT":  "https://sts.fake.test",
+				"AWS_ENDPOINT_URL_STS": "https://sts-endpoint-url.fake.test",
+			},
+			expectedService:  names.STS,
+			expectedEndpoint: "https://sts.fake.test",
+		},
+		{
+			endpoints: map[string]string{},
+			envvars: map[string]string{
+				"AWS_ENDPOINT_URL_TRANSCRIBESERVICE": "https://transcribeservice.fake.test",
+			},
+			expectedService:  names.Transcribe,
+			expectedEndpoint: "https://transcribeservice.fake.test",
+		},
+		{
+			endpoints: map[string]string{},
+			envvars: map[string]string{
+				"AWS_ENDPOINT_URL_TRANSCRIBE":        "https://transcribe.fake.test",
+				"AWS_ENDPOINT_URL_TRANSCRIBESERVICE": "https://transcribeservice.fake.test",
+			},
+			expectedService:  names.Transcribe,
+			expectedEndpoint: "https://transcribe.fake.test",
+		},
 	}
 
 	for _, testcase := range testcases {
@@ -170,6 +210,428 @@ func TestEndpointEnvVarPrecedence(t *testing.T) {
 	}
 }
 
+func TestExpandEndpointsFile(t *testing.T) {
+	testcases := []struct {
+		name      string
+		filename  string
+		contents  string
+		endpoints map[string]string
+		expected  map[string]string
+		expectErr bool
+	}{
+		{
+			name:     "json",
+			filename: "endpoints.json",
+			contents: `{"dynamodb": "http://localhost:8000", "transcribeservice": "http://localhost:8001"}`,
+			expected: map[string]string{
+				names.DynamoDB:   "http://localhost:8000",
+				names.Transcribe: "http://localhost:8001",
+			},
+		},
+		{
+			name:     "yaml",
+			filename: "endpoints.yaml",
+			contents: "dynamodb: http://localhost:8000\ntranscribeservice: http://localhost:8001\n",
+			expected: map[string]string{
+				names.DynamoDB:   "http://localhost:8000",
+				names.Transcribe: "http://localhost:8001",
+			},
+		},
+		{
+			name:     "service identifier precedence",
+			filename: "endpoints.yaml",
+			contents: "transcribeservice: http://transcribeservice.fake.test\ntranscribe: http://transcribe.fake.test\n",
+			expected: map[string]string{
+				names.Transcribe: "http://transcribe.fake.test",
+			},
+		},
+		{
+			name:     "configured endpoint precedence",
+			filename: "endpoints.yaml",
+			contents: "dynamodb: http://localhost:8000\nsts: http://localhost:8002\n",
+			endpoints: map[string]string{
+				names.STS: "https://sts.fake.test",
+			},
+			expected: map[string]string{
+				names.DynamoDB: "http://localhost:8000",
+				names.STS:      "https://sts.fake.test",
+			},
+		},
+		{
+			name:      "unsupported service",
+			filename:  "endpoints.yaml",
+			contents:  "notaservice: http://localhost:8000\n",
+			expectErr: true,
+		},
+		{
+			name:      "invalid",
+			filename:  "endpoints.json",
+			contents:  `{"dynamodb": ["http://localhost:8000"]}`,
+			expectErr: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), testcase.filename)
+			if err := os.WriteFile(filename, []byte(testcase.contents), 0600); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			results := make(map[string]string)
+			for k, v := range testcase.endpoints {
+				results[k] = v
+			}
+
+			err := expandEndpointsFile(filename, results)
+
+			if testcase.expectErr {
+				if err == nil {
+					t.Fatal("Expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(results, testcase.expected) {
+				t.Errorf("Expected %v, got %v", testcase.expected, results)
+			}
+		})
+	}
+}
+
+func TestExpandEndpointsFileDefault(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "endpoints.yaml")
+	contents := `
+"*": https://{service}.onprem.test
+dynamodb: http://localhost:8000
+`
+	if err := os.WriteFile(filename, []byte(contents), 0600); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	results := map[string]string{
+		names.STS: "https://sts.fake.test",
+	}
+
+	if err := expandEndpointsFile(filename, results); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if a, e := len(results), len(names.ProviderPackages()); a != e {
+		t.Errorf("Expected %d endpoints, got %d", e, a)
+	}
+
+	for service, expected := range map[string]string{
+		names.DynamoDB: "http://localhost:8000",
+		names.EC2:      "https://ec2.onprem.test",
+		names.STS:      "https://sts.fake.test",
+	} {
+		if v := results[service]; v != expected {
+			t.Errorf("Expected endpoint[%s] to be %q, got %q", service, expected, v)
+		}
+	}
+}
+
+func TestExpandAssumeRoles(t *testing.T) {
+	testcases := []struct {
+		name      string
+		input     []interface{}
+		expected  []string
+		expectErr bool
+	}{
+		{
+			name:     "empty block",
+			input:    []interface{}{nil},
+			expected: []string{""},
+		},
+		{
+			name: "single role",
+			input: []interface{}{
+				map[string]interface{}{"role_arn": "arn:aws:iam::111111111111:role/identity"}, //lintignore:AWSAT005
+			},
+			expected: []string{"arn:aws:iam::111111111111:role/identity"}, //lintignore:AWSAT005
+		},
+		{
+			name: "chain",
+			input: []interface{}{
+				map[string]interface{}{"role_arn": "arn:aws:iam::111111111111:role/identity"},   //lintignore:AWSAT005
+				map[string]interface{}{"role_arn": "arn:aws:iam::222222222222:role/deployment"}, //lintignore:AWSAT005
+				map[string]interface{}{"role_arn": "arn:aws:iam::333333333333:role/workload"},   //lintignore:AWSAT005
+			},
+			expected: []string{
+				"arn:aws:iam::111111111111:role/identity",   //lintignore:AWSAT005
+				"arn:aws:iam::222222222222:role/deployment", //lintignore:AWSAT005
+				"arn:aws:iam::333333333333:role/workload",   //lintignore:AWSAT005
+			},
+		},
+		{
+			name: "chain missing role_arn",
+			input: []interface{}{
+				map[string]interface{}{"role_arn": "arn:aws:iam::111111111111:role/identity"}, //lintignore:AWSAT005
+				map[string]interface{}{"role_arn": ""},
+			},
+			expectErr: true,
+		},
+		{
+			name: "duration conflict",
+			input: []interface{}{
+				map[string]interface{}{"role_arn": "arn:aws:iam::111111111111:role/identity"}, //lintignore:AWSAT005
+				map[string]interface{}{
+					"role_arn":         "arn:aws:iam::222222222222:role/deployment", //lintignore:AWSAT005
+					"duration":         "1h",
+					"duration_seconds": 3600,
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			results, err := expandAssumeRoles(testcase.input)
+
+			if testcase.expectErr {
+				if err == nil {
+					t.Fatal("Expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			roleARNs := make([]string, len(results))
+			for i, v := range results {
+				roleARNs[i] = v.RoleARN
+			}
+
+			if !reflect.DeepEqual(roleARNs, testcase.expected) {
+				t.Errorf("Expected %v, got %v", testcase.expected, roleARNs)
+			}
+		})
+	}
+}
+
+func TestExpandRateLimitConfigs(t *testing.T) {
+	results, err := expandRateLimitConfigs([]interface{}{
+		map[string]interface{}{
+			"service":             "ec2",
+			"requests_per_second": 20.0,
+			"burst":               40,
+		},
+		map[string]interface{}{
+			"service":             "route53",
+			"requests_per_second": 5.0,
+			"burst":               0,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := map[string]*conns.RateLimitConfig{
+		names.EC2: {
+			Burst:             40,
+			RequestsPerSecond: 20,
+		},
+		names.Route53: {
+			RequestsPerSecond: 5,
+		},
+	}
+
+	if !reflect.DeepEqual(results, expected) {
+		t.Errorf("Expected %v, got %v", expected, results)
+	}
+
+	_, err = expandRateLimitConfigs([]interface{}{
+		map[string]interface{}{"service": "ec2", "requests_per_second": 1.0},
+		map[string]interface{}{"service": "ec2", "requests_per_second": 2.0},
+	})
+	if err == nil {
+		t.Errorf("Expected error for duplicate service")
+	}
+}
+
+func TestExpandRetryConfigs(t *testing.T) {
+	results, err := expandRetryConfigs([]interface{}{
+		map[string]interface{}{
+			"service":                  "organizations",
+			"max_attempts":             50,
+			"max_backoff":              "1m",
+			"retryable_error_codes":    schema.NewSet(schema.HashString, []interface{}{"TooManyRequestsException"}),
+			"retryable_error_messages": schema.NewSet(schema.HashString, []interface{}{}),
+		},
+		map[string]interface{}{
+			"service":                  "transcribeservice",
+			"max_attempts":             0,
+			"max_backoff":              "",
+			"retryable_error_codes":    schema.NewSet(schema.HashString, []interface{}{}),
+			"retryable_error_messages": schema.NewSet(schema.HashString, []interface{}{"Rate exceeded"}),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if a, e := len(results), 2; a != e {
+		t.Fatalf("Expected %d retry configurations, got %d", e, a)
+	}
+
+	expected := map[string]*conns.RetryConfig{
+		names.Organizations: {
+			ErrorCodes:  []string{"TooManyRequestsException"},
+			MaxAttempts: 50,
+			MaxBackoff:  time.Minute,
+		},
+		names.Transcribe: {
+			ErrorMessages: []string{"Rate exceeded"},
+		},
+	}
+
+	if !reflect.DeepEqual(results, expected) {
+		t.Errorf("Expected %v, got %v", expected, results)
+	}
+
+	_, err = expandRetryConfigs([]interface{}{
+		map[string]interface{}{"service": "transcribe"},
+		map[string]interface{}{"service": "transcribeservice"},
+	})
+	if err == nil {
+		t.Errorf("Expected error for duplicate service")
+	}
+}
+
+func TestExpandProviderIgnoreTags(t *testing.T) {
+	result, err := expandProviderIgnoreTags([]interface{}{
+		map[string]interface{}{
+			"keys":          schema.NewSet(schema.HashString, []interface{}{"Owner"}),
+			"key_prefixes":  schema.NewSet(schema.HashString, []interface{}{"kubernetes.io/"}),
+			"key_regexes":   schema.NewSet(schema.HashString, []interface{}{"(?i):createdby$"}),
+			"value_regexes": schema.NewSet(schema.HashString, []interface{}{"^generated-"}),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if a, e := len(result.KeyRegexes), 1; a != e {
+		t.Fatalf("Expected %d key regexes, got %d", e, a)
+	}
+
+	if a, e := result.KeyRegexes[0].String(), "(?i):createdby$"; a != e {
+		t.Errorf("Expected key regex %q, got %q", e, a)
+	}
+
+	if a, e := len(result.ValueRegexes), 1; a != e {
+		t.Fatalf("Expected %d value regexes, got %d", e, a)
+	}
+
+	if a, e := result.ValueRegexes[0].String(), "^generated-"; a != e {
+		t.Errorf("Expected value regex %q, got %q", e, a)
+	}
+
+	_, err = expandProviderIgnoreTags([]interface{}{
+		map[string]interface{}{
+			"key_regexes": schema.NewSet(schema.HashString, []interface{}{"^kubernetes[.io"}),
+		},
+	})
+	if err == nil {
+		t.Errorf("Expected error for invalid key regex")
+	}
+}
+
+func TestExpandProviderRequiredTags(t *testing.T) {
+	result, err := expandProviderRequiredTags([]interface{}{
+		map[string]interface{}{
+			"exempt_resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_iam_role"}),
+			"keys":                  schema.NewSet(schema.HashString, []interface{}{"CostCenter", "Owner"}),
+			"value_regexes": map[string]interface{}{
+				"CostCenter": "^cc-[0-9]{4}$",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if a, e := result.ExemptResourceTypes, []string{"aws_iam_role"}; !reflect.DeepEqual(a, e) {
+		t.Errorf("Expected exempt resource types %v, got %v", e, a)
+	}
+
+	if a, e := len(result.Keys), 2; a != e {
+		t.Errorf("Expected %d keys, got %d", e, a)
+	}
+
+	if a, e := result.ValueRegexes["CostCenter"].String(), "^cc-[0-9]{4}$"; a != e {
+		t.Errorf("Expected value regex %q, got %q", e, a)
+	}
+
+	_, err = expandProviderRequiredTags([]interface{}{
+		map[string]interface{}{
+			"keys": schema.NewSet(schema.HashString, []interface{}{"Owner"}),
+			"value_regexes": map[string]interface{}{
+				"CostCenter": "^cc-[0-9]{4}$",
+			},
+		},
+	})
+	if err == nil {
+		t.Errorf("Expected error for value regex of key that is not required")
+	}
+
+	_, err = expandProviderRequiredTags([]interface{}{
+		map[string]interface{}{
+			"keys": schema.NewSet(schema.HashString, []interface{}{"CostCenter"}),
+			"value_regexes": map[string]interface{}{
+				"CostCenter": "^cc-[0-9",
+			},
+		},
+	})
+	if err == nil {
+		t.Errorf("Expected error for invalid value regex")
+	}
+}
+
+// TestRequiredTagsUnenforcedResources lists the taggable resources without a CustomizeDiff.
+// Required tags are checked by verify.SetTagsDiff, so these resources are not enforced and
+// are listed in the provider documentation.
+func TestRequiredTagsUnenforcedResources(t *testing.T) {
+	var got []string
+
+	for typeName, r := range Provider().ResourcesMap {
+		if _, ok := r.Schema["tags_all"]; ok && r.CustomizeDiff == nil {
+			got = append(got, typeName)
+		}
+	}
+
+	sort.Strings(got)
+
+	want := []string{
+		"aws_appstream_fleet",
+		"aws_appstream_stack",
+		"aws_batch_scheduling_policy",
+		"aws_connect_hours_of_operation",
+		"aws_connect_queue",
+		"aws_connect_quick_connect",
+		"aws_connect_routing_profile",
+		"aws_connect_security_profile",
+		"aws_connect_user_hierarchy_group",
+		"aws_gamelift_game_server_group",
+		"aws_macie2_classification_job",
+		"aws_macie2_custom_data_identifier",
+		"aws_macie2_findings_filter",
+		"aws_macie2_member",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected unenforced resources %q, got %q", want, got)
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
@@ -0,0 +1,157 @@
+// Code generated by internal/generate/cloudcontrol/main.go; DO NOT EDIT.
+
+package cloudcontrol
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// ResourceForecastDataset returns the aws_cloudcontrolapi_forecast_dataset resource, which manages AWS::Forecast::Dataset resources using the Cloud Control API.
+func ResourceForecastDataset() *schema.Resource {
+	r := &typedResource{
+		TypeName:  "AWS::Forecast::Dataset",
+		Name:      "Forecast Dataset",
+		Updatable: false,
+		Attributes: map[string]*attribute{
+			"arn": {
+				Property: "Arn",
+			},
+			"data_frequency": {
+				Property: "DataFrequency",
+			},
+			"dataset_name": {
+				Property: "DatasetName",
+			},
+			"dataset_type": {
+				Property: "DatasetType",
+			},
+			"domain": {
+				Property: "Domain",
+			},
+			"encryption_config": {
+				Property: "EncryptionConfig",
+				Object:   true,
+				Attributes: map[string]*attribute{
+					"kms_key_arn": {
+						Property: "KmsKeyArn",
+					},
+					"role_arn": {
+						Property: "RoleArn",
+					},
+				},
+			},
+			"schema": {
+				Property: "Schema",
+				Object:   true,
+				Attributes: map[string]*attribute{
+					"attributes": {
+						Property: "Attributes",
+						Attributes: map[string]*attribute{
+							"attribute_name": {
+								Property: "AttributeName",
+							},
+							"attribute_type": {
+								Property: "AttributeType",
+							},
+						},
+					},
+				},
+			},
+			"tags": {
+				Property: "Tags",
+				Tags:     true,
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_frequency": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"dataset_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"dataset_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"TARGET_TIME_SERIES", "RELATED_TIME_SERIES", "ITEM_METADATA"}, false),
+			},
+			"domain": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"RETAIL", "CUSTOM", "INVENTORY_PLANNING", "EC2_CAPACITY", "WORK_FORCE", "WEB_TRAFFIC", "METRICS"}, false),
+			},
+			"encryption_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(0, 256),
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(0, 256),
+						},
+					},
+				},
+			},
+			"schema": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attributes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 100,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"attribute_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice([]string{"string", "integer", "float", "timestamp", "geolocation"}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchemaForceNew(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+
+	return r.Resource()
+}
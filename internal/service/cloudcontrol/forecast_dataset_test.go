@@ -0,0 +1,96 @@
+package cloudcontrol_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfcloudcontrol "github.com/hashicorp/terraform-provider-aws/internal/service/cloudcontrol"
+)
+
+func TestAccCloudControlForecastDataset_basic(t *testing.T) {
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_cloudcontrolapi_forecast_dataset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudcontrolapi.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckTypedResourceDestroy("aws_cloudcontrolapi_forecast_dataset", "AWS::Forecast::Dataset"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccForecastDatasetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "forecast", regexp.MustCompile(`dataset/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "dataset_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "dataset_type", "TARGET_TIME_SERIES"),
+					resource.TestCheckResourceAttr(resourceName, "domain", "CUSTOM"),
+					resource.TestCheckResourceAttr(resourceName, "schema.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schema.0.attributes.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "schema.0.attributes.0.attribute_name", "item_id"),
+					resource.TestCheckResourceAttr(resourceName, "schema.0.attributes.0.attribute_type", "string"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudControlForecastDataset_disappears(t *testing.T) {
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_cloudcontrolapi_forecast_dataset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudcontrolapi.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckTypedResourceDestroy("aws_cloudcontrolapi_forecast_dataset", "AWS::Forecast::Dataset"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccForecastDatasetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceDisappears(acctest.Provider, tfcloudcontrol.ResourceForecastDataset(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccForecastDatasetConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudcontrolapi_forecast_dataset" "test" {
+  dataset_name   = %[1]q
+  dataset_type   = "TARGET_TIME_SERIES"
+  data_frequency = "D"
+  domain         = "CUSTOM"
+
+  schema {
+    attributes {
+      attribute_name = "item_id"
+      attribute_type = "string"
+    }
+
+    attributes {
+      attribute_name = "timestamp"
+      attribute_type = "timestamp"
+    }
+
+    attributes {
+      attribute_name = "target_value"
+      attribute_type = "float"
+    }
+  }
+}
+`, rName)
+}
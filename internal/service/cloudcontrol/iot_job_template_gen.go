@@ -0,0 +1,297 @@
+// Code generated by internal/generate/cloudcontrol/main.go; DO NOT EDIT.
+
+package cloudcontrol
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// ResourceIoTJobTemplate returns the aws_cloudcontrolapi_iot_job_template resource, which manages AWS::IoT::JobTemplate resources using the Cloud Control API.
+func ResourceIoTJobTemplate() *schema.Resource {
+	r := &typedResource{
+		TypeName:  "AWS::IoT::JobTemplate",
+		Name:      "IoT Job Template",
+		Updatable: false,
+		Attributes: map[string]*attribute{
+			"abort_config": {
+				Property: "AbortConfig",
+				Object:   true,
+				Attributes: map[string]*attribute{
+					"criteria_list": {
+						Property: "CriteriaList",
+						Attributes: map[string]*attribute{
+							"action": {
+								Property: "Action",
+							},
+							"failure_type": {
+								Property: "FailureType",
+							},
+							"min_number_of_executed_things": {
+								Property: "MinNumberOfExecutedThings",
+							},
+							"threshold_percentage": {
+								Property: "ThresholdPercentage",
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Property: "Arn",
+			},
+			"description": {
+				Property: "Description",
+			},
+			"document": {
+				Property: "Document",
+			},
+			"document_source": {
+				Property: "DocumentSource",
+			},
+			"job_arn": {
+				Property:  "JobArn",
+				WriteOnly: true,
+			},
+			"job_executions_rollout_config": {
+				Property: "JobExecutionsRolloutConfig",
+				Object:   true,
+				Attributes: map[string]*attribute{
+					"exponential_rollout_rate": {
+						Property: "ExponentialRolloutRate",
+						Object:   true,
+						Attributes: map[string]*attribute{
+							"base_rate_per_minute": {
+								Property: "BaseRatePerMinute",
+							},
+							"increment_factor": {
+								Property: "IncrementFactor",
+							},
+							"rate_increase_criteria": {
+								Property: "RateIncreaseCriteria",
+								Object:   true,
+								Attributes: map[string]*attribute{
+									"number_of_notified_things": {
+										Property: "NumberOfNotifiedThings",
+									},
+									"number_of_succeeded_things": {
+										Property: "NumberOfSucceededThings",
+									},
+								},
+							},
+						},
+					},
+					"maximum_per_minute": {
+						Property: "MaximumPerMinute",
+					},
+				},
+			},
+			"job_template_id": {
+				Property: "JobTemplateId",
+			},
+			"presigned_url_config": {
+				Property: "PresignedUrlConfig",
+				Object:   true,
+				Attributes: map[string]*attribute{
+					"expires_in_sec": {
+						Property: "ExpiresInSec",
+					},
+					"role_arn": {
+						Property: "RoleArn",
+					},
+				},
+			},
+			"tags": {
+				Property:  "Tags",
+				Tags:      true,
+				WriteOnly: true,
+			},
+			"timeout_config": {
+				Property: "TimeoutConfig",
+				Object:   true,
+				Attributes: map[string]*attribute{
+					"in_progress_timeout_in_minutes": {
+						Property: "InProgressTimeoutInMinutes",
+					},
+				},
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"abort_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"criteria_list": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"action": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"CANCEL"}, false),
+									},
+									"failure_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"FAILED", "REJECTED", "TIMED_OUT", "ALL"}, false),
+									},
+									"min_number_of_executed_things": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"threshold_percentage": {
+										Type:     schema.TypeFloat,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2028),
+			},
+			"document": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 32768),
+			},
+			"document_source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1350),
+			},
+			"job_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"job_executions_rollout_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"exponential_rollout_rate": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"base_rate_per_minute": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"increment_factor": {
+										Type:     schema.TypeFloat,
+										Required: true,
+									},
+									"rate_increase_criteria": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"number_of_notified_things": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"number_of_succeeded_things": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"maximum_per_minute": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"job_template_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"presigned_url_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"expires_in_sec": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(60, 3600),
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(20, 2048),
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchemaForceNew(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"timeout_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"in_progress_timeout_in_minutes": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 10080),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return r.Resource()
+}
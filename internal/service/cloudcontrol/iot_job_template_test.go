@@ -0,0 +1,81 @@
+package cloudcontrol_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfcloudcontrol "github.com/hashicorp/terraform-provider-aws/internal/service/cloudcontrol"
+)
+
+func TestAccCloudControlIoTJobTemplate_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudcontrolapi_iot_job_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudcontrolapi.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckTypedResourceDestroy("aws_cloudcontrolapi_iot_job_template", "AWS::IoT::JobTemplate"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIoTJobTemplateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "iot", regexp.MustCompile(`jobtemplate/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "id", rName),
+					resource.TestCheckResourceAttr(resourceName, "job_template_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "timeout_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "timeout_config.0.in_progress_timeout_in_minutes", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudControlIoTJobTemplate_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudcontrolapi_iot_job_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudcontrolapi.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckTypedResourceDestroy("aws_cloudcontrolapi_iot_job_template", "AWS::IoT::JobTemplate"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIoTJobTemplateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceDisappears(acctest.Provider, tfcloudcontrol.ResourceIoTJobTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccIoTJobTemplateConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudcontrolapi_iot_job_template" "test" {
+  description     = %[1]q
+  job_template_id = %[1]q
+
+  document = jsonencode({
+    operation = "test"
+  })
+
+  timeout_config {
+    in_progress_timeout_in_minutes = 10
+  }
+}
+`, rName)
+}
@@ -0,0 +1,64 @@
+// Code generated by internal/generate/cloudcontrol/main.go; DO NOT EDIT.
+
+package cloudcontrol
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// ResourceLogsLogGroup returns the aws_cloudcontrolapi_logs_log_group resource, which manages AWS::Logs::LogGroup resources using the Cloud Control API.
+func ResourceLogsLogGroup() *schema.Resource {
+	r := &typedResource{
+		TypeName:  "AWS::Logs::LogGroup",
+		Name:      "Logs Log Group",
+		Updatable: true,
+		Attributes: map[string]*attribute{
+			"arn": {
+				Property: "Arn",
+			},
+			"kms_key_id": {
+				Property: "KmsKeyId",
+			},
+			"log_group_name": {
+				Property: "LogGroupName",
+			},
+			"retention_in_days": {
+				Property: "RetentionInDays",
+			},
+			"tags": {
+				Property: "Tags",
+				Tags:     true,
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"log_group_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"retention_in_days": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+
+	return r.Resource()
+}
@@ -492,6 +492,32 @@ func testAccCheckResourceDestroy(s *terraform.State) error {
 	return nil
 }
 
+func testAccCheckTypedResourceDestroy(resourceType, typeName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudControlConn
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			_, err := tfcloudcontrol.FindResourceByID(context.TODO(), conn, rs.Primary.ID, typeName, "", "")
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Cloud Control API %s %s still exists", typeName, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
 func testAccResourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudcontrolapi_resource" "test" {
@@ -0,0 +1,17 @@
+// Code generated by internal/generate/cloudcontrol/main.go; DO NOT EDIT.
+
+package cloudcontrol
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// GeneratedResources returns the resources generated from the CloudFormation resource schemas
+// of the types listed in resource_types.txt, keyed by Terraform resource type name.
+func GeneratedResources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"aws_cloudcontrolapi_forecast_dataset": ResourceForecastDataset(),
+		"aws_cloudcontrolapi_iot_job_template": ResourceIoTJobTemplate(),
+		"aws_cloudcontrolapi_logs_log_group":   ResourceLogsLogGroup(),
+	}
+}
@@ -0,0 +1,593 @@
+package cloudcontrol
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/mattbaird/jsonpatch"
+)
+
+// attribute maps a Terraform attribute to a CloudFormation resource property.
+type attribute struct {
+	Property   string                // CloudFormation property name
+	Object     bool                  // Whether the property is an object, represented as a block with a single element
+	JSON       bool                  // Whether the property value is represented as a JSON string
+	WriteOnly  bool                  // Whether the property is not returned by the Cloud Control API
+	Tags       bool                  // Whether the property is the resource tags, an array of Key and Value objects represented by the "tags" and "tags_all" maps
+	Attributes map[string]*attribute // Nested block attributes
+}
+
+// typedResource is a Terraform resource with attributes generated from a CloudFormation resource schema,
+// whose lifecycle is managed via the Cloud Control API.
+type typedResource struct {
+	TypeName   string
+	Name       string // Human friendly name used in error messages, e.g. "Forecast Dataset"
+	Attributes map[string]*attribute
+	Schema     map[string]*schema.Schema
+	Updatable  bool // Whether the resource type has an update handler
+}
+
+func (r *typedResource) Resource() *schema.Resource {
+	v := &schema.Resource{
+		CreateContext: r.create,
+		ReadContext:   r.read,
+		DeleteContext: r.delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Hour),
+			Delete: schema.DefaultTimeout(2 * time.Hour),
+		},
+
+		Schema: r.Schema,
+	}
+
+	if r.Updatable {
+		v.UpdateContext = r.update
+		v.Timeouts.Update = schema.DefaultTimeout(2 * time.Hour)
+	}
+
+	if _, ok := r.Schema["tags_all"]; ok {
+		v.CustomizeDiff = verify.SetTagsDiff
+	}
+
+	return v
+}
+
+func (r *typedResource) create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudControlConn
+
+	desiredState, err := r.desiredState(func(k string) interface{} { return d.Get(k) }, d.GetRawConfig())
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating %s: %w", r.Name, err))
+	}
+
+	input := &cloudcontrolapi.CreateResourceInput{
+		ClientToken:  aws.String(resource.UniqueId()),
+		DesiredState: aws.String(desiredState),
+		TypeName:     aws.String(r.TypeName),
+	}
+
+	output, err := conn.CreateResourceWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating %s: %w", r.Name, err))
+	}
+
+	if output == nil || output.ProgressEvent == nil {
+		return diag.FromErr(fmt.Errorf("error creating %s: empty result", r.Name))
+	}
+
+	// Always try to capture the identifier before returning errors
+	d.SetId(aws.StringValue(output.ProgressEvent.Identifier))
+
+	output.ProgressEvent, err = waitProgressEventOperationStatusSuccess(ctx, conn, aws.StringValue(output.ProgressEvent.RequestToken), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for %s (%s) create: %w", r.Name, d.Id(), err))
+	}
+
+	// Some resources do not set the identifier until after creation
+	if d.Id() == "" {
+		d.SetId(aws.StringValue(output.ProgressEvent.Identifier))
+	}
+
+	return r.read(ctx, d, meta)
+}
+
+func (r *typedResource) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudControlConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	resourceDescription, err := FindResourceByID(ctx, conn, d.Id(), r.TypeName, "", "")
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] %s (%s) not found, removing from state", r.Name, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading %s (%s): %w", r.Name, d.Id(), err))
+	}
+
+	var properties map[string]interface{}
+
+	if err := json.Unmarshal([]byte(aws.StringValue(resourceDescription.Properties)), &properties); err != nil {
+		return diag.FromErr(fmt.Errorf("error reading %s (%s): parsing properties: %w", r.Name, d.Id(), err))
+	}
+
+	for k, v := range flattenProperties(r.Schema, r.Attributes, properties) {
+		if r.Attributes[k].WriteOnly {
+			if _, ok := properties[r.Attributes[k].Property]; !ok {
+				continue
+			}
+		}
+
+		if r.Attributes[k].Tags {
+			tags := tftags.New(v).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+			//lintignore:AWSR002
+			if err := d.Set(k, tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+				return diag.FromErr(fmt.Errorf("error setting %s: %w", k, err))
+			}
+
+			if err := d.Set("tags_all", tags.Map()); err != nil {
+				return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
+			}
+
+			continue
+		}
+
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting %s: %w", k, err))
+		}
+	}
+
+	return nil
+}
+
+func (r *typedResource) update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudControlConn
+
+	// Zero values set in the configuration are included in both desired states
+	// so that changing a value to its zero value replaces the value.
+	oldDesiredState, err := r.desiredState(func(k string) interface{} {
+		o, _ := d.GetChange(k)
+		return o
+	}, d.GetRawConfig())
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating %s (%s): %w", r.Name, d.Id(), err))
+	}
+
+	newDesiredState, err := r.desiredState(func(k string) interface{} { return d.Get(k) }, d.GetRawConfig())
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating %s (%s): %w", r.Name, d.Id(), err))
+	}
+
+	patchDocument, err := propertiesPatchDocument(oldDesiredState, newDesiredState)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating %s (%s): creating JSON Patch: %w", r.Name, d.Id(), err))
+	}
+
+	input := &cloudcontrolapi.UpdateResourceInput{
+		ClientToken:   aws.String(resource.UniqueId()),
+		Identifier:    aws.String(d.Id()),
+		PatchDocument: aws.String(patchDocument),
+		TypeName:      aws.String(r.TypeName),
+	}
+
+	output, err := conn.UpdateResourceWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating %s (%s): %w", r.Name, d.Id(), err))
+	}
+
+	if output == nil || output.ProgressEvent == nil {
+		return diag.FromErr(fmt.Errorf("error updating %s (%s): empty result", r.Name, d.Id()))
+	}
+
+	if _, err := waitProgressEventOperationStatusSuccess(ctx, conn, aws.StringValue(output.ProgressEvent.RequestToken), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for %s (%s) update: %w", r.Name, d.Id(), err))
+	}
+
+	return r.read(ctx, d, meta)
+}
+
+func (r *typedResource) delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudControlConn
+
+	log.Printf("[DEBUG] Deleting %s: %s", r.Name, d.Id())
+	output, err := conn.DeleteResourceWithContext(ctx, &cloudcontrolapi.DeleteResourceInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Identifier:  aws.String(d.Id()),
+		TypeName:    aws.String(r.TypeName),
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting %s (%s): %w", r.Name, d.Id(), err))
+	}
+
+	if output == nil || output.ProgressEvent == nil {
+		return diag.FromErr(fmt.Errorf("error deleting %s (%s): empty result", r.Name, d.Id()))
+	}
+
+	progressEvent, err := waitProgressEventOperationStatusSuccess(ctx, conn, aws.StringValue(output.ProgressEvent.RequestToken), d.Timeout(schema.TimeoutDelete))
+
+	if progressEvent != nil && aws.StringValue(progressEvent.ErrorCode) == cloudcontrolapi.HandlerErrorCodeNotFound {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for %s (%s) delete: %w", r.Name, d.Id(), err))
+	}
+
+	return nil
+}
+
+// desiredState returns the Cloud Control API desired state JSON document for the attribute values returned by get.
+// Zero values are included only for attributes set in the raw configuration.
+// Resource tags are taken from "tags_all", the resource tags merged with the provider default tags.
+func (r *typedResource) desiredState(get func(string) interface{}, rawConfig cty.Value) (string, error) {
+	tfMap := make(map[string]interface{}, len(r.Attributes))
+
+	for k, a := range r.Attributes {
+		if a.Tags {
+			tfMap[k] = get("tags_all")
+			continue
+		}
+
+		tfMap[k] = get(k)
+	}
+
+	properties, err := expandProperties(r.Schema, r.Attributes, tfMap, rawConfig)
+
+	if err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(properties)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// propertiesPatchDocument returns a JSON Patch document that adds, replaces or removes whole top-level properties.
+// Changed array properties, such as resource tags, are replaced rather than patched element by element.
+func propertiesPatchDocument(old, new string) (string, error) {
+	var oldProperties, newProperties map[string]interface{}
+
+	if err := json.Unmarshal([]byte(old), &oldProperties); err != nil {
+		return "", err
+	}
+
+	if err := json.Unmarshal([]byte(new), &newProperties); err != nil {
+		return "", err
+	}
+
+	var patch []jsonpatch.JsonPatchOperation
+
+	for k, v := range newProperties {
+		oldV, ok := oldProperties[k]
+
+		switch {
+		case !ok:
+			patch = append(patch, jsonpatch.NewPatch("add", "/"+k, v))
+		case !reflect.DeepEqual(oldV, v):
+			patch = append(patch, jsonpatch.NewPatch("replace", "/"+k, v))
+		}
+	}
+
+	for k := range oldProperties {
+		if _, ok := newProperties[k]; !ok {
+			patch = append(patch, jsonpatch.NewPatch("remove", "/"+k, nil))
+		}
+	}
+
+	sort.Sort(jsonpatch.ByPath(patch))
+
+	b, err := json.Marshal(patch)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// expandProperties returns the CloudFormation properties for Terraform attribute values.
+// Read-only attributes are omitted, as are zero values unless set in the raw configuration of the attributes' object.
+func expandProperties(s map[string]*schema.Schema, attributes map[string]*attribute, tfMap map[string]interface{}, rawConfig cty.Value) (map[string]interface{}, error) {
+	properties := make(map[string]interface{})
+
+	for k, a := range attributes {
+		if s := s[k]; s.Computed && !s.Optional {
+			continue
+		}
+
+		v, err := expandProperty(s[k], a, tfMap[k], rawConfigAttr(rawConfig, k))
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+
+		if v != nil {
+			properties[a.Property] = v
+		}
+	}
+
+	return properties, nil
+}
+
+func expandProperty(s *schema.Schema, a *attribute, v interface{}, rawConfig cty.Value) (interface{}, error) {
+	configured := rawConfig.IsKnown() && !rawConfig.IsNull()
+
+	switch s.Type {
+	case schema.TypeBool:
+		if v, ok := v.(bool); ok && (v || configured) {
+			return v, nil
+		}
+	case schema.TypeFloat:
+		if v, ok := v.(float64); ok && (v != 0 || configured) {
+			return v, nil
+		}
+	case schema.TypeInt:
+		if v, ok := v.(int); ok && (v != 0 || configured) {
+			return v, nil
+		}
+	case schema.TypeString:
+		v, ok := v.(string)
+
+		if !ok || (v == "" && (!configured || a.JSON)) {
+			return nil, nil
+		}
+
+		if !a.JSON {
+			return v, nil
+		}
+
+		var value interface{}
+
+		if err := json.Unmarshal([]byte(v), &value); err != nil {
+			return nil, err
+		}
+
+		return value, nil
+	case schema.TypeMap:
+		v, ok := v.(map[string]interface{})
+
+		if !ok || len(v) == 0 {
+			return nil, nil
+		}
+
+		if a.Tags {
+			return expandTags(v), nil
+		}
+
+		return v, nil
+	case schema.TypeList, schema.TypeSet:
+		var tfList []interface{}
+
+		switch v := v.(type) {
+		case []interface{}:
+			tfList = v
+		case *schema.Set:
+			tfList = v.List()
+		}
+
+		if len(tfList) == 0 {
+			return nil, nil
+		}
+
+		elem, ok := s.Elem.(*schema.Resource)
+
+		if !ok {
+			return tfList, nil
+		}
+
+		var values []interface{}
+
+		for i, tfMapRaw := range tfList {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			// Set elements are not ordered as in the raw configuration.
+			var elemRawConfig cty.Value
+
+			if s.Type == schema.TypeList {
+				elemRawConfig = rawConfigIndex(rawConfig, i)
+			}
+
+			properties, err := expandProperties(elem.Schema, a.Attributes, tfMap, elemRawConfig)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if a.Object {
+				return properties, nil
+			}
+
+			values = append(values, properties)
+		}
+
+		return values, nil
+	}
+
+	return nil, nil
+}
+
+// rawConfigAttr returns the raw configuration value of the object's attribute, if any.
+func rawConfigAttr(rawConfig cty.Value, k string) cty.Value {
+	if !rawConfig.IsKnown() || rawConfig.IsNull() || !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute(k) {
+		return cty.NilVal
+	}
+
+	return rawConfig.GetAttr(k)
+}
+
+// rawConfigIndex returns the raw configuration value of the list's element, if any.
+func rawConfigIndex(rawConfig cty.Value, i int) cty.Value {
+	if !rawConfig.IsKnown() || rawConfig.IsNull() || !(rawConfig.Type().IsListType() || rawConfig.Type().IsTupleType()) || rawConfig.LengthInt() <= i {
+		return cty.NilVal
+	}
+
+	return rawConfig.Index(cty.NumberIntVal(int64(i)))
+}
+
+// flattenProperties returns the Terraform attribute values for CloudFormation properties.
+func flattenProperties(s map[string]*schema.Schema, attributes map[string]*attribute, properties map[string]interface{}) map[string]interface{} {
+	tfMap := make(map[string]interface{}, len(attributes))
+
+	for k, a := range attributes {
+		tfMap[k] = flattenProperty(s[k], a, properties[a.Property])
+	}
+
+	return tfMap
+}
+
+func flattenProperty(s *schema.Schema, a *attribute, v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+
+	if a.Tags {
+		return flattenTags(v)
+	}
+
+	switch s.Type {
+	case schema.TypeInt:
+		if v, ok := v.(float64); ok {
+			return int(v)
+		}
+	case schema.TypeString:
+		if !a.JSON {
+			return v
+		}
+
+		b, err := json.Marshal(v)
+
+		if err != nil {
+			return nil
+		}
+
+		return string(b)
+	case schema.TypeList, schema.TypeSet:
+		elem, ok := s.Elem.(*schema.Resource)
+
+		if ok && a.Object {
+			properties, ok := v.(map[string]interface{})
+
+			if !ok {
+				return nil
+			}
+
+			return []interface{}{flattenProperties(elem.Schema, a.Attributes, properties)}
+		}
+
+		values, ok := v.([]interface{})
+
+		if !ok {
+			return nil
+		}
+
+		var tfList []interface{}
+
+		for _, v := range values {
+			switch v := v.(type) {
+			case map[string]interface{}:
+				if elem != nil {
+					tfList = append(tfList, flattenProperties(elem.Schema, a.Attributes, v))
+				}
+			case float64:
+				if elem, ok := s.Elem.(*schema.Schema); ok && elem.Type == schema.TypeInt {
+					tfList = append(tfList, int(v))
+				} else {
+					tfList = append(tfList, v)
+				}
+			default:
+				tfList = append(tfList, v)
+			}
+		}
+
+		return tfList
+	}
+
+	return v
+}
+
+// expandTags returns the CloudFormation array of Key and Value objects for a map of tags, sorted by key.
+func expandTags(tfMap map[string]interface{}) []interface{} {
+	keys := make([]string, 0, len(tfMap))
+
+	for k := range tfMap {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	values := make([]interface{}, 0, len(keys))
+
+	for _, k := range keys {
+		values = append(values, map[string]interface{}{
+			"Key":   k,
+			"Value": tfMap[k],
+		})
+	}
+
+	return values
+}
+
+// flattenTags returns the map of tags for a CloudFormation array of Key and Value objects.
+func flattenTags(v interface{}) map[string]interface{} {
+	values, ok := v.([]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{}, len(values))
+
+	for _, v := range values {
+		tag, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if k, ok := tag["Key"].(string); ok {
+			tfMap[k] = tag["Value"]
+		}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,327 @@
+package cloudcontrol
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func testTypedResource() *typedResource {
+	return &typedResource{
+		TypeName: "Test::Service::Resource",
+		Name:     "Test Resource",
+		Attributes: map[string]*attribute{
+			"arn": {
+				Property: "Arn",
+			},
+			"config": {
+				Property: "Config",
+				Object:   true,
+				Attributes: map[string]*attribute{
+					"enabled": {
+						Property: "Enabled",
+					},
+					"size": {
+						Property: "Size",
+					},
+				},
+			},
+			"document": {
+				Property: "Document",
+				JSON:     true,
+			},
+			"name": {
+				Property: "Name",
+			},
+			"ports": {
+				Property: "Ports",
+			},
+			"tags": {
+				Property: "Tags",
+				Tags:     true,
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"document": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ports": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func TestTypedResourceDesiredState(t *testing.T) {
+	r := testTypedResource()
+
+	testCases := []struct {
+		Name      string
+		TFMap     map[string]interface{}
+		RawConfig cty.Value
+		Expected  string
+	}{
+		{
+			Name: "required only",
+			TFMap: map[string]interface{}{
+				"arn":  "arn:aws:test:us-west-2:123456789012:resource/test",
+				"name": "test",
+			},
+			Expected: `{"Name":"test"}`,
+		},
+		{
+			Name: "all attributes",
+			TFMap: map[string]interface{}{
+				"config": []interface{}{
+					map[string]interface{}{
+						"enabled": true,
+						"size":    10,
+					},
+				},
+				"document": `{"Version": "1"}`,
+				"name":     "test",
+				"ports":    []interface{}{80, 443},
+				"tags": map[string]interface{}{
+					"Name": "test",
+				},
+				"tags_all": map[string]interface{}{
+					"Name":  "test",
+					"Owner": "default",
+				},
+			},
+			Expected: `{"Config":{"Enabled":true,"Size":10},"Document":{"Version":"1"},"Name":"test","Ports":[80,443],"Tags":[{"Key":"Name","Value":"test"},{"Key":"Owner","Value":"default"}]}`,
+		},
+		{
+			Name: "zero values",
+			TFMap: map[string]interface{}{
+				"config": []interface{}{
+					map[string]interface{}{
+						"enabled": false,
+						"size":    0,
+					},
+				},
+				"document": "",
+				"name":     "test",
+				"ports":    []interface{}{},
+			},
+			Expected: `{"Config":{},"Name":"test"}`,
+		},
+		{
+			Name: "configured zero values",
+			TFMap: map[string]interface{}{
+				"config": []interface{}{
+					map[string]interface{}{
+						"enabled": false,
+						"size":    0,
+					},
+				},
+				"document": "",
+				"name":     "test",
+			},
+			RawConfig: cty.ObjectVal(map[string]cty.Value{
+				"config": cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						"enabled": cty.False,
+						"size":    cty.NumberIntVal(0),
+					}),
+				}),
+				"document": cty.NullVal(cty.String),
+				"name":     cty.StringVal("test"),
+			}),
+			Expected: `{"Config":{"Enabled":false,"Size":0},"Name":"test"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := r.desiredState(func(k string) interface{} { return testCase.TFMap[k] }, testCase.RawConfig)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestTypedResourceDesiredStateInvalidJSON(t *testing.T) {
+	r := testTypedResource()
+	tfMap := map[string]interface{}{
+		"document": "{",
+		"name":     "test",
+	}
+
+	if _, err := r.desiredState(func(k string) interface{} { return tfMap[k] }, cty.NilVal); err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
+
+func TestTypedResourceUpdatePatchDocument(t *testing.T) {
+	r := testTypedResource()
+	oldTFMap := map[string]interface{}{
+		"document": `{"Version": "1"}`,
+		"name":     "test",
+		"ports":    []interface{}{80},
+		"tags_all": map[string]interface{}{
+			"Name":  "test",
+			"Owner": "default",
+		},
+	}
+	newTFMap := map[string]interface{}{
+		"config": []interface{}{
+			map[string]interface{}{
+				"size": 10,
+			},
+		},
+		"name":  "test",
+		"ports": []interface{}{80, 443},
+		"tags_all": map[string]interface{}{
+			"Name": "updated",
+		},
+	}
+
+	oldDesiredState, err := r.desiredState(func(k string) interface{} { return oldTFMap[k] }, cty.NilVal)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	newDesiredState, err := r.desiredState(func(k string) interface{} { return newTFMap[k] }, cty.NilVal)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := propertiesPatchDocument(oldDesiredState, newDesiredState)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `[{"op":"add","path":"/Config","value":{"Size":10}},{"op":"remove","path":"/Document"},{"op":"replace","path":"/Ports","value":[80,443]},{"op":"replace","path":"/Tags","value":[{"Key":"Name","Value":"updated"}]}]`
+
+	if got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
+
+func TestFlattenProperties(t *testing.T) {
+	r := testTypedResource()
+
+	testCases := []struct {
+		Name       string
+		Properties map[string]interface{}
+		Expected   map[string]interface{}
+	}{
+		{
+			Name: "required only",
+			Properties: map[string]interface{}{
+				"Arn":  "arn:aws:test:us-west-2:123456789012:resource/test",
+				"Name": "test",
+			},
+			Expected: map[string]interface{}{
+				"arn":      "arn:aws:test:us-west-2:123456789012:resource/test",
+				"config":   nil,
+				"document": nil,
+				"name":     "test",
+				"ports":    nil,
+				"tags":     nil,
+			},
+		},
+		{
+			Name: "all properties",
+			Properties: map[string]interface{}{
+				"Arn": "arn:aws:test:us-west-2:123456789012:resource/test",
+				"Config": map[string]interface{}{
+					"Enabled": true,
+					"Size":    float64(10),
+				},
+				"Document": map[string]interface{}{
+					"Version": "1",
+				},
+				"Name":  "test",
+				"Ports": []interface{}{float64(80), float64(443)},
+				"Tags": []interface{}{
+					map[string]interface{}{
+						"Key":   "Name",
+						"Value": "test",
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"arn": "arn:aws:test:us-west-2:123456789012:resource/test",
+				"config": []interface{}{
+					map[string]interface{}{
+						"enabled": true,
+						"size":    10,
+					},
+				},
+				"document": `{"Version":"1"}`,
+				"name":     "test",
+				"ports":    []interface{}{80, 443},
+				"tags": map[string]interface{}{
+					"Name": "test",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := flattenProperties(r.Schema, r.Attributes, testCase.Properties)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestTypedResourceInternalValidate(t *testing.T) {
+	for typeName, r := range GeneratedResources() {
+		if err := r.InternalValidate(nil, true); err != nil {
+			t.Errorf("%s: %s", typeName, err)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package ecs
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+// ARNOrName returns the ARN if the configured value is an ARN in any partition,
+// otherwise the part of the ARN following the first "/", e.g. the cluster name,
+// IAM role name or task definition family and revision.
+// Attributes that accept either form are read back in the format they were configured.
+//
+// Expects the following ARNs:
+// arn:aws:ecs:us-west-2:0123456789:task-definition/mongodb:3
+// arn:aws:iam::0123456789:role/EcsService
+// arn:aws:ecs:us-west-2:0123456789:cluster/radek-cluster
+func ARNOrName(configured, v string) string {
+	if arn.IsARN(configured) {
+		return v
+	}
+
+	return strings.Split(v, "/")[1]
+}
@@ -0,0 +1,55 @@
+package ecs_test
+
+import (
+	"testing"
+
+	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+)
+
+func TestARNOrName(t *testing.T) {
+	testCases := []struct {
+		TestName   string
+		Configured string
+		Value      string
+		Expected   string
+	}{
+		{
+			TestName:   "configured name",
+			Configured: "test",
+			Value:      "arn:aws:ecs:us-west-2:123456789012:cluster/test", //lintignore:AWSAT003,AWSAT005
+			Expected:   "test",
+		},
+		{
+			TestName:   "configured family and revision",
+			Configured: "test:3",
+			Value:      "arn:aws:ecs:us-west-2:123456789012:task-definition/test:3", //lintignore:AWSAT003,AWSAT005
+			Expected:   "test:3",
+		},
+		{
+			TestName:   "configured ARN",
+			Configured: "arn:aws:ecs:us-west-2:123456789012:cluster/test", //lintignore:AWSAT003,AWSAT005
+			Value:      "arn:aws:ecs:us-west-2:123456789012:cluster/test", //lintignore:AWSAT003,AWSAT005
+			Expected:   "arn:aws:ecs:us-west-2:123456789012:cluster/test", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			TestName:   "configured ARN other partition",
+			Configured: "arn:aws-us-gov:iam::123456789012:role/test", //lintignore:AWSAT005
+			Value:      "arn:aws-us-gov:iam::123456789012:role/test", //lintignore:AWSAT005
+			Expected:   "arn:aws-us-gov:iam::123456789012:role/test", //lintignore:AWSAT005
+		},
+		{
+			TestName:   "configured ARN other service",
+			Configured: "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+			Value:      "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+			Expected:   "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := tfecs.ARNOrName(testCase.Configured, testCase.Value); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
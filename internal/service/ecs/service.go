@@ -650,12 +650,7 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
 	// hence TaskDefinition will not be set by aws sdk
 	if service.TaskDefinition != nil {
 		// Save task definition in the same format
-		if strings.HasPrefix(d.Get("task_definition").(string), "arn:"+meta.(*conns.AWSClient).Partition+":ecs:") {
-			d.Set("task_definition", service.TaskDefinition)
-		} else {
-			taskDefinition := buildFamilyAndRevisionFromARN(aws.StringValue(service.TaskDefinition))
-			d.Set("task_definition", taskDefinition)
-		}
+		d.Set("task_definition", ARNOrName(d.Get("task_definition").(string), aws.StringValue(service.TaskDefinition)))
 	}
 
 	d.Set("scheduling_strategy", service.SchedulingStrategy)
@@ -668,21 +663,11 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("enable_execute_command", service.EnableExecuteCommand)
 
 	// Save cluster in the same format
-	if strings.HasPrefix(d.Get("cluster").(string), "arn:"+meta.(*conns.AWSClient).Partition+":ecs:") {
-		d.Set("cluster", service.ClusterArn)
-	} else {
-		clusterARN := getNameFromARN(aws.StringValue(service.ClusterArn))
-		d.Set("cluster", clusterARN)
-	}
+	d.Set("cluster", ARNOrName(d.Get("cluster").(string), aws.StringValue(service.ClusterArn)))
 
 	// Save IAM role in the same format
 	if service.RoleArn != nil {
-		if strings.HasPrefix(d.Get("iam_role").(string), "arn:"+meta.(*conns.AWSClient).Partition+":iam:") {
-			d.Set("iam_role", service.RoleArn)
-		} else {
-			roleARN := getNameFromARN(aws.StringValue(service.RoleArn))
-			d.Set("iam_role", roleARN)
-		}
+		d.Set("iam_role", ARNOrName(d.Get("iam_role").(string), aws.StringValue(service.RoleArn)))
 	}
 
 	if service.DeploymentConfiguration != nil {
@@ -1272,14 +1257,3 @@ func retryServiceCreate(conn *ecs.ECS, input ecs.CreateServiceInput) (*ecs.Creat
 
 	return output, err
 }
-
-func buildFamilyAndRevisionFromARN(arn string) string {
-	return strings.Split(arn, "/")[1]
-}
-
-// Expects the following ARNs:
-// arn:aws:iam::0123456789:role/EcsService
-// arn:aws:ecs:us-west-2:0123456789:cluster/radek-cluster
-func getNameFromARN(arn string) string {
-	return strings.Split(arn, "/")[1]
-}
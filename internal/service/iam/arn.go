@@ -1,21 +1,32 @@
 package iam
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 const (
 	ARNSeparator = "/"
 	ARNService   = "iam"
 
+	GroupResourcePrefix           = "group"
 	InstanceProfileResourcePrefix = "instance-profile"
+	RoleResourcePrefix            = "role"
+	UserResourcePrefix            = "user"
 )
 
 // InstanceProfileARNToName converts Amazon Resource Name (ARN) to Name.
 func InstanceProfileARNToName(inputARN string) (string, error) {
+	return ARNToName(inputARN, InstanceProfileResourcePrefix)
+}
+
+// ARNToName converts Amazon Resource Name (ARN) of a resource with the specified resource prefix to Name.
+func ARNToName(inputARN string, resourcePrefix string) (string, error) {
 	parsedARN, err := arn.Parse(inputARN)
 
 	if err != nil {
@@ -32,9 +43,17 @@ func InstanceProfileARNToName(inputARN string) (string, error) {
 		return "", fmt.Errorf("expected at least %d resource parts in ARN (%s), got: %d", expected, inputARN, actual)
 	}
 
-	if actual, expected := resourceParts[0], InstanceProfileResourcePrefix; actual != expected {
+	if actual, expected := resourceParts[0], resourcePrefix; actual != expected {
 		return "", fmt.Errorf("expected resource prefix %s in ARN (%s), got: %s", expected, inputARN, actual)
 	}
 
 	return resourceParts[len(resourceParts)-1], nil
 }
+
+// importARNToName returns a function converting the Amazon Resource Name (ARN) of an imported resource
+// with the specified resource prefix to Name.
+func importARNToName(resourcePrefix string) verify.ImportARNFunc {
+	return func(_ context.Context, _ *schema.ResourceData, _ interface{}, arn arn.ARN) (string, error) {
+		return ARNToName(arn.String(), resourcePrefix)
+	}
+}
@@ -73,3 +73,60 @@ func TestInstanceProfileARNToName(t *testing.T) {
 		})
 	}
 }
+
+func TestARNToName(t *testing.T) {
+	testCases := []struct {
+		TestName       string
+		InputARN       string
+		ResourcePrefix string
+		ExpectedError  *regexp.Regexp
+		ExpectedName   string
+	}{
+		{
+			TestName:       "invalid ARN resource prefix",
+			InputARN:       "arn:aws:iam::123456789012:user/name", //lintignore:AWSAT005
+			ResourcePrefix: tfiam.RoleResourcePrefix,
+			ExpectedError:  regexp.MustCompile(`expected resource prefix role`),
+		},
+		{
+			TestName:       "valid group ARN",
+			InputARN:       "arn:aws:iam::123456789012:group/name", //lintignore:AWSAT005
+			ResourcePrefix: tfiam.GroupResourcePrefix,
+			ExpectedName:   "name",
+		},
+		{
+			TestName:       "valid role ARN with path",
+			InputARN:       "arn:aws:iam::123456789012:role/path/name", //lintignore:AWSAT005
+			ResourcePrefix: tfiam.RoleResourcePrefix,
+			ExpectedName:   "name",
+		},
+		{
+			TestName:       "valid user ARN with path",
+			InputARN:       "arn:aws:iam::123456789012:user/path/to/name", //lintignore:AWSAT005
+			ResourcePrefix: tfiam.UserResourcePrefix,
+			ExpectedName:   "name",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := tfiam.ARNToName(testCase.InputARN, testCase.ResourcePrefix)
+
+			if err == nil && testCase.ExpectedError != nil {
+				t.Fatalf("expected error %s, got no error", testCase.ExpectedError.String())
+			}
+
+			if err != nil && testCase.ExpectedError == nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil && !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error %s, got: %s", testCase.ExpectedError.String(), err)
+			}
+
+			if got != testCase.ExpectedName {
+				t.Errorf("got %s, expected %s", got, testCase.ExpectedName)
+			}
+		})
+	}
+}
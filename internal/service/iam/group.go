@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func ResourceGroup() *schema.Resource {
@@ -22,7 +23,7 @@ func ResourceGroup() *schema.Resource {
 		Update: resourceGroupUpdate,
 		Delete: resourceGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: verify.ImportByARN(ARNService, importARNToName(GroupResourcePrefix)),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -41,6 +41,12 @@ func TestAccIAMGroup_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: acctest.AttrImportStateIdFunc(resourceName, "arn"),
+			},
 			{
 				Config: testAccGroupConfig_2(groupName2),
 				Check: resource.ComposeTestCheckFunc(
@@ -28,7 +28,7 @@ func ResourceInstanceProfile() *schema.Resource {
 		Update: resourceInstanceProfileUpdate,
 		Delete: resourceInstanceProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: verify.ImportByARN(ARNService, importARNToName(InstanceProfileResourcePrefix)),
 		},
 
 		Schema: map[string]*schema.Schema{
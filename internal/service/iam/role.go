@@ -1,6 +1,7 @@
 package iam
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -36,7 +37,7 @@ func ResourceRole() *schema.Resource {
 		Update: resourceRoleUpdate,
 		Delete: resourceRoleDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceRoleImport,
+			StateContext: resourceRoleImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -165,8 +166,11 @@ func ResourceRole() *schema.Resource {
 	}
 }
 
-func resourceRoleImport(
-	d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceRoleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := verify.ImportARN(ctx, d, meta, ARNService, importARNToName(RoleResourcePrefix)); err != nil {
+		return nil, err
+	}
+
 	d.Set("force_detach_policies", false)
 	return []*schema.ResourceData{d}, nil
 }
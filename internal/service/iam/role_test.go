@@ -42,6 +42,12 @@ func TestAccIAMRole_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: acctest.AttrImportStateIdFunc(resourceName, "arn"),
+			},
 		},
 	})
 }
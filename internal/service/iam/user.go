@@ -27,7 +27,7 @@ func ResourceUser() *schema.Resource {
 		Update: resourceUserUpdate,
 		Delete: resourceUserDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: verify.ImportByARN(ARNService, importARNToName(UserResourcePrefix)),
 		},
 
 		Schema: map[string]*schema.Schema{
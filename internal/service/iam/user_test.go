@@ -46,6 +46,14 @@ func TestAccIAMUser_basic(t *testing.T) {
 				ImportStateVerifyIgnore: []string{
 					"force_destroy"},
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"force_destroy"},
+				ImportStateIdFunc: acctest.AttrImportStateIdFunc(resourceName, "arn"),
+			},
 			{
 				Config: testAccUserConfig_basic(name2, path2),
 				Check: resource.ComposeTestCheckFunc(
@@ -0,0 +1,117 @@
+package inspector2
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDelegatedAdminAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDelegatedAdminAccountCreate,
+		ReadWithoutTimeout:   resourceDelegatedAdminAccountRead,
+		DeleteWithoutTimeout: resourceDelegatedAdminAccountDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"relationship_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDelegatedAdminAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	accountID := d.Get("account_id").(string)
+	in := &inspector2.EnableDelegatedAdminAccountInput{
+		ClientToken:             aws.String(resource.UniqueId()),
+		DelegatedAdminAccountId: aws.String(accountID),
+	}
+
+	_, err := conn.EnableDelegatedAdminAccount(ctx, in)
+
+	if err != nil {
+		return diag.Errorf("enabling Inspector V2 Delegated Admin Account (%s): %s", accountID, err)
+	}
+
+	d.SetId(accountID)
+
+	if _, err := waitDelegatedAdminAccountEnabled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Inspector V2 Delegated Admin Account (%s) enable: %s", d.Id(), err)
+	}
+
+	return resourceDelegatedAdminAccountRead(ctx, d, meta)
+}
+
+func resourceDelegatedAdminAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	out, err := FindDelegatedAdminAccountByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Inspector V2 Delegated Admin Account (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Inspector V2 Delegated Admin Account (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account_id", out.AccountId)
+	d.Set("relationship_status", out.Status)
+
+	return nil
+}
+
+func resourceDelegatedAdminAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	log.Printf("[INFO] Disabling Inspector V2 Delegated Admin Account: %s", d.Id())
+	_, err := conn.DisableDelegatedAdminAccount(ctx, &inspector2.DisableDelegatedAdminAccountInput{
+		DelegatedAdminAccountId: aws.String(d.Id()),
+	})
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("disabling Inspector V2 Delegated Admin Account (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDelegatedAdminAccountDisabled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Inspector V2 Delegated Admin Account (%s) disable: %s", d.Id(), err)
+	}
+
+	return nil
+}
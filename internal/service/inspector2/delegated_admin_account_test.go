@@ -0,0 +1,130 @@
+package inspector2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfinspector2 "github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccDelegatedAdminAccount_basic(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_inspector2_delegated_admin_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheck(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, names.Inspector2EndpointID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckDelegatedAdminAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDelegatedAdminAccountConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDelegatedAdminAccountExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", "data.aws_caller_identity.member", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", "ENABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDelegatedAdminAccount_disappears(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_inspector2_delegated_admin_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheck(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, names.Inspector2EndpointID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckDelegatedAdminAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDelegatedAdminAccountConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDelegatedAdminAccountExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfinspector2.ResourceDelegatedAdminAccount(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDelegatedAdminAccountDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_inspector2_delegated_admin_account" {
+			continue
+		}
+
+		_, err := tfinspector2.FindDelegatedAdminAccountByID(context.TODO(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Inspector V2 Delegated Admin Account %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDelegatedAdminAccountExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Inspector V2 Delegated Admin Account ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn
+
+		_, err := tfinspector2.FindDelegatedAdminAccountByID(context.TODO(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccDelegatedAdminAccountConfig_basic() string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), `
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_inspector2_delegated_admin_account" "test" {
+  account_id = data.aws_caller_identity.member.account_id
+}
+`)
+}
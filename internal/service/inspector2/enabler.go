@@ -0,0 +1,250 @@
+package inspector2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEnabler() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEnablerCreate,
+		ReadWithoutTimeout:   resourceEnablerRead,
+		UpdateWithoutTimeout: resourceEnablerUpdate,
+		DeleteWithoutTimeout: resourceEnablerDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidAccountID,
+				},
+			},
+			"resource_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(resourceScanTypeValues(types.ResourceScanType("").Values()...), false),
+				},
+			},
+		},
+	}
+}
+
+func resourceEnablerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	accountIDs := aws.ToStringSlice(flex.ExpandStringSet(d.Get("account_ids").(*schema.Set)))
+	resourceTypes := expandResourceScanTypes(d.Get("resource_types").(*schema.Set).List())
+	id := EnablerCreateResourceID(accountIDs)
+
+	if err := enableResourceTypes(ctx, conn, accountIDs, resourceTypes, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("creating Inspector V2 Enabler (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceEnablerRead(ctx, d, meta)
+}
+
+func resourceEnablerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	accountIDs, err := EnablerParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	out, err := FindAccountStatuses(ctx, conn, accountIDs)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Inspector V2 Enabler (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Inspector V2 Enabler (%s): %s", d.Id(), err)
+	}
+
+	// Only resource types that are enabled in every account are reported.
+	var resourceTypes []string
+
+	for _, resourceType := range types.ResourceScanType("").Values() {
+		enabled := true
+
+		for _, account := range out {
+			state := resourceScanTypeState(account.ResourceState, resourceType)
+
+			if state == nil || (state.Status != types.StatusEnabled && state.Status != types.StatusEnabling) {
+				enabled = false
+				break
+			}
+		}
+
+		if enabled {
+			resourceTypes = append(resourceTypes, string(resourceType))
+		}
+	}
+
+	if !d.IsNewResource() && len(resourceTypes) == 0 {
+		log.Printf("[WARN] Inspector V2 Enabler (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_ids", accountIDs)
+	d.Set("resource_types", resourceTypes)
+
+	return nil
+}
+
+func resourceEnablerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	if d.HasChange("resource_types") {
+		accountIDs := aws.ToStringSlice(flex.ExpandStringSet(d.Get("account_ids").(*schema.Set)))
+		o, n := d.GetChange("resource_types")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if add := expandResourceScanTypes(ns.Difference(os).List()); len(add) > 0 {
+			if err := enableResourceTypes(ctx, conn, accountIDs, add, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.Errorf("updating Inspector V2 Enabler (%s): %s", d.Id(), err)
+			}
+		}
+
+		if del := expandResourceScanTypes(os.Difference(ns).List()); len(del) > 0 {
+			if err := disableResourceTypes(ctx, conn, accountIDs, del, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.Errorf("updating Inspector V2 Enabler (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceEnablerRead(ctx, d, meta)
+}
+
+func resourceEnablerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	accountIDs := aws.ToStringSlice(flex.ExpandStringSet(d.Get("account_ids").(*schema.Set)))
+	resourceTypes := expandResourceScanTypes(d.Get("resource_types").(*schema.Set).List())
+
+	log.Printf("[INFO] Deleting Inspector V2 Enabler: %s", d.Id())
+	if err := disableResourceTypes(ctx, conn, accountIDs, resourceTypes, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("deleting Inspector V2 Enabler (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// enableResourceTypes enables scanning of the specified resource types and waits for every account to report them as enabled.
+// Enablement is asynchronous, so the statuses returned by Enable are not final.
+func enableResourceTypes(ctx context.Context, conn *inspector2.Client, accountIDs []string, resourceTypes []types.ResourceScanType, timeout time.Duration) error {
+	in := &inspector2.EnableInput{
+		AccountIds:    accountIDs,
+		ClientToken:   aws.String(resource.UniqueId()),
+		ResourceTypes: resourceTypes,
+	}
+
+	out, err := conn.Enable(ctx, in)
+
+	if err != nil {
+		return fmt.Errorf("enabling: %w", err)
+	}
+
+	if err := failedAccountsError(out.FailedAccounts); err != nil {
+		return fmt.Errorf("enabling: %w", err)
+	}
+
+	if _, err := waitEnablerEnabled(ctx, conn, accountIDs, resourceTypes, timeout); err != nil {
+		return fmt.Errorf("waiting for enable: %w", err)
+	}
+
+	return nil
+}
+
+// disableResourceTypes disables scanning of the specified resource types and waits for every account to report them as disabled.
+func disableResourceTypes(ctx context.Context, conn *inspector2.Client, accountIDs []string, resourceTypes []types.ResourceScanType, timeout time.Duration) error {
+	in := &inspector2.DisableInput{
+		AccountIds:    accountIDs,
+		ResourceTypes: resourceTypes,
+	}
+
+	out, err := conn.Disable(ctx, in)
+
+	if err != nil {
+		return fmt.Errorf("disabling: %w", err)
+	}
+
+	if err := failedAccountsError(out.FailedAccounts); err != nil {
+		return fmt.Errorf("disabling: %w", err)
+	}
+
+	if _, err := waitEnablerDisabled(ctx, conn, accountIDs, resourceTypes, timeout); err != nil {
+		return fmt.Errorf("waiting for disable: %w", err)
+	}
+
+	return nil
+}
+
+func failedAccountsError(apiObjects []types.FailedAccount) error {
+	var errs *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		errs = multierror.Append(errs, fmt.Errorf("account (%s): %s: %s", aws.ToString(apiObject.AccountId), apiObject.ErrorCode, aws.ToString(apiObject.ErrorMessage)))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func expandResourceScanTypes(tfList []interface{}) []types.ResourceScanType {
+	var apiObjects []types.ResourceScanType
+
+	for _, v := range tfList {
+		apiObjects = append(apiObjects, types.ResourceScanType(v.(string)))
+	}
+
+	return apiObjects
+}
+
+func resourceScanTypeValues(input ...types.ResourceScanType) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
@@ -0,0 +1,171 @@
+package inspector2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfinspector2 "github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccEnabler_basic(t *testing.T) {
+	resourceName := "aws_inspector2_enabler.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, names.Inspector2EndpointID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckEnablerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnablerConfig_basic(`"EC2", "ECR"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnablerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "account_ids.*", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "resource_types.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "resource_types.*", "EC2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "resource_types.*", "ECR"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccEnabler_update(t *testing.T) {
+	resourceName := "aws_inspector2_enabler.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, names.Inspector2EndpointID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckEnablerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnablerConfig_basic(`"EC2"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnablerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "resource_types.*", "EC2"),
+				),
+			},
+			{
+				Config: testAccEnablerConfig_basic(`"ECR", "LAMBDA"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnablerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_types.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "resource_types.*", "ECR"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "resource_types.*", "LAMBDA"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEnabler_disappears(t *testing.T) {
+	resourceName := "aws_inspector2_enabler.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, names.Inspector2EndpointID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckEnablerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnablerConfig_basic(`"EC2", "ECR"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnablerExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfinspector2.ResourceEnabler(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEnablerDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_inspector2_enabler" {
+			continue
+		}
+
+		accountIDs, err := tfinspector2.EnablerParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		out, err := tfinspector2.FindAccountStatuses(context.TODO(), conn, accountIDs)
+
+		if err != nil {
+			return err
+		}
+
+		for _, account := range out {
+			if account.State != nil && account.State.Status != types.StatusDisabled {
+				return fmt.Errorf("Inspector V2 Enabler %s still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckEnablerExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Inspector V2 Enabler ID is set")
+		}
+
+		accountIDs, err := tfinspector2.EnablerParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn
+
+		out, err := tfinspector2.FindAccountStatuses(context.TODO(), conn, accountIDs)
+
+		if err != nil {
+			return err
+		}
+
+		for _, account := range out {
+			if account.State == nil || account.State.Status != types.StatusEnabled {
+				return fmt.Errorf("Inspector V2 Enabler %s not enabled", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccEnablerConfig_basic(resourceTypes string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_enabler" "test" {
+  account_ids    = [data.aws_caller_identity.current.account_id]
+  resource_types = [%[1]s]
+}
+`, resourceTypes)
+}
@@ -0,0 +1,121 @@
+package inspector2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindAccountStatuses(ctx context.Context, conn *inspector2.Client, accountIDs []string) ([]types.AccountState, error) {
+	in := &inspector2.BatchGetAccountStatusInput{
+		AccountIds: accountIDs,
+	}
+
+	out, err := conn.BatchGetAccountStatus(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var errs *multierror.Error
+
+	for _, v := range out.FailedAccounts {
+		errs = multierror.Append(errs, fmt.Errorf("account (%s): %s: %s", aws.ToString(v.AccountId), v.ErrorCode, aws.ToString(v.ErrorMessage)))
+	}
+
+	if err := errs.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+
+	if out == nil || len(out.Accounts) == 0 {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.Accounts, nil
+}
+
+func FindDelegatedAdminAccountByID(ctx context.Context, conn *inspector2.Client, accountID string) (*types.DelegatedAdminAccount, error) {
+	in := &inspector2.ListDelegatedAdminAccountsInput{}
+
+	for {
+		out, err := conn.ListDelegatedAdminAccounts(ctx, in)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range out.DelegatedAdminAccounts {
+			if aws.ToString(v.AccountId) == accountID {
+				v := v
+
+				return &v, nil
+			}
+		}
+
+		if aws.ToString(out.NextToken) == "" {
+			break
+		}
+
+		in.NextToken = out.NextToken
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: in,
+	}
+}
+
+func FindMemberByAccountID(ctx context.Context, conn *inspector2.Client, accountID string) (*types.Member, error) {
+	in := &inspector2.GetMemberInput{
+		AccountId: aws.String(accountID),
+	}
+
+	out, err := conn.GetMember(ctx, in)
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Member == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	if status := out.Member.RelationshipStatus; status == types.RelationshipStatusRemoved {
+		return nil, &resource.NotFoundError{
+			Message:     string(status),
+			LastRequest: in,
+		}
+	}
+
+	return out.Member, nil
+}
+
+func FindOrganizationConfiguration(ctx context.Context, conn *inspector2.Client) (*inspector2.DescribeOrganizationConfigurationOutput, error) {
+	in := &inspector2.DescribeOrganizationConfigurationInput{}
+
+	out, err := conn.DescribeOrganizationConfiguration(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.AutoEnable == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,30 @@
+package inspector2
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const enablerResourceIDSeparator = ","
+
+func EnablerCreateResourceID(accountIDs []string) string {
+	parts := make([]string, len(accountIDs))
+	copy(parts, accountIDs)
+	sort.Strings(parts)
+	id := strings.Join(parts, enablerResourceIDSeparator)
+
+	return id
+}
+
+func EnablerParseResourceID(id string) ([]string, error) {
+	parts := strings.Split(id, enablerResourceIDSeparator)
+
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("unexpected format for ID (%[1]s), expected ACCOUNTID[%[2]sACCOUNTID]...", id, enablerResourceIDSeparator)
+		}
+	}
+
+	return parts, nil
+}
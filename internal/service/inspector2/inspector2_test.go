@@ -0,0 +1,63 @@
+package inspector2_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccInspector2_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"DelegatedAdminAccount": {
+			"basic":      testAccDelegatedAdminAccount_basic,
+			"disappears": testAccDelegatedAdminAccount_disappears,
+		},
+		"Enabler": {
+			"basic":      testAccEnabler_basic,
+			"update":     testAccEnabler_update,
+			"disappears": testAccEnabler_disappears,
+		},
+		"MemberAssociation": {
+			"basic":      testAccMemberAssociation_basic,
+			"disappears": testAccMemberAssociation_disappears,
+		},
+		"OrganizationConfiguration": {
+			"basic":  testAccOrganizationConfiguration_basic,
+			"lambda": testAccOrganizationConfiguration_lambda,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
+
+func testAccPreCheck(t *testing.T) {
+	acctest.PreCheckPartitionHasService(names.Inspector2EndpointID, t)
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn
+
+	input := &inspector2.ListDelegatedAdminAccountsInput{}
+
+	_, err := conn.ListDelegatedAdminAccounts(context.TODO(), input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
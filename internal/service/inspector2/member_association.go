@@ -0,0 +1,129 @@
+package inspector2
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceMemberAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMemberAssociationCreate,
+		ReadWithoutTimeout:   resourceMemberAssociationRead,
+		DeleteWithoutTimeout: resourceMemberAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"delegated_admin_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"relationship_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMemberAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	accountID := d.Get("account_id").(string)
+	in := &inspector2.AssociateMemberInput{
+		AccountId: aws.String(accountID),
+	}
+
+	_, err := conn.AssociateMember(ctx, in)
+
+	if err != nil {
+		return diag.Errorf("associating Inspector V2 Member (%s): %s", accountID, err)
+	}
+
+	d.SetId(accountID)
+
+	if _, err := waitMemberAssociated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Inspector V2 Member (%s) association: %s", d.Id(), err)
+	}
+
+	return resourceMemberAssociationRead(ctx, d, meta)
+}
+
+func resourceMemberAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	out, err := FindMemberByAccountID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Inspector V2 Member Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Inspector V2 Member Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account_id", out.AccountId)
+	d.Set("delegated_admin_account_id", out.DelegatedAdminAccountId)
+	d.Set("relationship_status", out.RelationshipStatus)
+	if v := out.UpdatedAt; v != nil {
+		d.Set("updated_at", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set("updated_at", nil)
+	}
+
+	return nil
+}
+
+func resourceMemberAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	log.Printf("[INFO] Disassociating Inspector V2 Member: %s", d.Id())
+	_, err := conn.DisassociateMember(ctx, &inspector2.DisassociateMemberInput{
+		AccountId: aws.String(d.Id()),
+	})
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("disassociating Inspector V2 Member (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitMemberDisassociated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Inspector V2 Member (%s) disassociation: %s", d.Id(), err)
+	}
+
+	return nil
+}
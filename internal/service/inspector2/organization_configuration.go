@@ -0,0 +1,196 @@
+package inspector2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceOrganizationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOrganizationConfigurationCreate,
+		ReadWithoutTimeout:   resourceOrganizationConfigurationRead,
+		UpdateWithoutTimeout: resourceOrganizationConfigurationUpdate,
+		DeleteWithoutTimeout: resourceOrganizationConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"auto_enable": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ec2": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"ecr": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"lambda": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"max_account_limit_reached": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOrganizationConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+
+	return resourceOrganizationConfigurationUpdate(ctx, d, meta)
+}
+
+func resourceOrganizationConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	out, err := FindOrganizationConfiguration(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Inspector V2 Organization Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Inspector V2 Organization Configuration (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("auto_enable", []interface{}{flattenAutoEnable(out.AutoEnable)}); err != nil {
+		return diag.Errorf("setting auto_enable: %s", err)
+	}
+
+	d.Set("max_account_limit_reached", out.MaxAccountLimitReached)
+
+	return nil
+}
+
+func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	autoEnable := expandAutoEnable(d.Get("auto_enable").([]interface{})[0].(map[string]interface{}))
+
+	if err := updateOrganizationConfiguration(ctx, conn, autoEnable, timeout); err != nil {
+		return diag.Errorf("updating Inspector V2 Organization Configuration (%s): %s", d.Id(), err)
+	}
+
+	return resourceOrganizationConfigurationRead(ctx, d, meta)
+}
+
+func resourceOrganizationConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	autoEnable := &types.AutoEnable{
+		Ec2:    aws.Bool(false),
+		Ecr:    aws.Bool(false),
+		Lambda: aws.Bool(false),
+	}
+
+	log.Printf("[INFO] Deleting Inspector V2 Organization Configuration: %s", d.Id())
+	if err := updateOrganizationConfiguration(ctx, conn, autoEnable, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("deleting Inspector V2 Organization Configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// updateOrganizationConfiguration updates the organization's auto-enable settings and waits for them to be reported.
+func updateOrganizationConfiguration(ctx context.Context, conn *inspector2.Client, autoEnable *types.AutoEnable, timeout time.Duration) error {
+	in := &inspector2.UpdateOrganizationConfigurationInput{
+		AutoEnable: autoEnable,
+	}
+
+	_, err := conn.UpdateOrganizationConfiguration(ctx, in)
+
+	if err != nil {
+		return err
+	}
+
+	err = tfresource.WaitUntilContext(ctx, timeout, func() (bool, error) {
+		out, err := FindOrganizationConfiguration(ctx, conn)
+
+		if err != nil {
+			return false, err
+		}
+
+		return aws.ToBool(out.AutoEnable.Ec2) == aws.ToBool(autoEnable.Ec2) &&
+			aws.ToBool(out.AutoEnable.Ecr) == aws.ToBool(autoEnable.Ecr) &&
+			aws.ToBool(out.AutoEnable.Lambda) == aws.ToBool(autoEnable.Lambda), nil
+	}, tfresource.WaitOpts{
+		ContinuousTargetOccurence: 2,
+		MinTimeout:                1 * time.Second,
+	})
+
+	if err != nil {
+		return fmt.Errorf("waiting for update: %w", err)
+	}
+
+	return nil
+}
+
+func expandAutoEnable(tfMap map[string]interface{}) *types.AutoEnable {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.AutoEnable{}
+
+	if v, ok := tfMap["ec2"].(bool); ok {
+		apiObject.Ec2 = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["ecr"].(bool); ok {
+		apiObject.Ecr = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["lambda"].(bool); ok {
+		apiObject.Lambda = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenAutoEnable(apiObject *types.AutoEnable) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"ec2":    aws.ToBool(apiObject.Ec2),
+		"ecr":    aws.ToBool(apiObject.Ecr),
+		"lambda": aws.ToBool(apiObject.Lambda),
+	}
+}
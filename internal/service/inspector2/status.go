@@ -0,0 +1,100 @@
+package inspector2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// enablerStatusInProgress is returned while the specified resource types have not all reached the same status.
+	enablerStatusInProgress = "IN_PROGRESS"
+)
+
+func statusDelegatedAdminAccount(ctx context.Context, conn *inspector2.Client, accountID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDelegatedAdminAccountByID(ctx, conn, accountID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+// statusEnabler returns the common scan status of the specified resource types across the specified accounts.
+// enablerStatusInProgress is returned if the statuses differ.
+func statusEnabler(ctx context.Context, conn *inspector2.Client, accountIDs []string, resourceTypes []types.ResourceScanType) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAccountStatuses(ctx, conn, accountIDs)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		var status types.Status
+
+		for _, account := range output {
+			for _, resourceType := range resourceTypes {
+				state := resourceScanTypeState(account.ResourceState, resourceType)
+
+				if state == nil {
+					return output, enablerStatusInProgress, nil
+				}
+
+				if status == "" {
+					status = state.Status
+				} else if state.Status != status {
+					status = enablerStatusInProgress
+				}
+			}
+		}
+
+		return output, string(status), nil
+	}
+}
+
+func statusMember(ctx context.Context, conn *inspector2.Client, accountID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindMemberByAccountID(ctx, conn, accountID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.RelationshipStatus), nil
+	}
+}
+
+func resourceScanTypeState(apiObject *types.ResourceState, resourceType types.ResourceScanType) *types.State {
+	if apiObject == nil {
+		return nil
+	}
+
+	switch resourceType {
+	case types.ResourceScanTypeEc2:
+		return apiObject.Ec2
+	case types.ResourceScanTypeEcr:
+		return apiObject.Ecr
+	case types.ResourceScanTypeLambda:
+		return apiObject.Lambda
+	}
+
+	return nil
+}
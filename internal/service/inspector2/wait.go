@@ -0,0 +1,146 @@
+package inspector2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitDelegatedAdminAccountEnabled(ctx context.Context, conn *inspector2.Client, accountID string, timeout time.Duration) (*types.DelegatedAdminAccount, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:        []string{},
+		Target:         []string{string(types.DelegatedAdminStatusEnabled)},
+		Refresh:        statusDelegatedAdminAccount(ctx, conn, accountID),
+		Timeout:        timeout,
+		NotFoundChecks: 20,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DelegatedAdminAccount); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDelegatedAdminAccountDisabled(ctx context.Context, conn *inspector2.Client, accountID string, timeout time.Duration) (*types.DelegatedAdminAccount, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{string(types.DelegatedAdminStatusEnabled), string(types.DelegatedAdminStatusDisableInProgress)},
+		Target:  []string{},
+		Refresh: statusDelegatedAdminAccount(ctx, conn, accountID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DelegatedAdminAccount); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEnablerEnabled(ctx context.Context, conn *inspector2.Client, accountIDs []string, resourceTypes []types.ResourceScanType, timeout time.Duration) ([]types.AccountState, error) {
+	stateConf := &resource.StateChangeConf{
+		// The previous status may be reported for a short time after Enable returns.
+		Pending:                   []string{string(types.StatusEnabling), string(types.StatusDisabled), enablerStatusInProgress},
+		Target:                    []string{string(types.StatusEnabled)},
+		Refresh:                   statusEnabler(ctx, conn, accountIDs, resourceTypes),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.([]types.AccountState); ok {
+		tfresource.SetLastError(err, enablerStateError(output, resourceTypes))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEnablerDisabled(ctx context.Context, conn *inspector2.Client, accountIDs []string, resourceTypes []types.ResourceScanType, timeout time.Duration) ([]types.AccountState, error) {
+	stateConf := &resource.StateChangeConf{
+		// The previous status may be reported for a short time after Disable returns.
+		Pending:                   []string{string(types.StatusDisabling), string(types.StatusEnabled), enablerStatusInProgress},
+		Target:                    []string{string(types.StatusDisabled)},
+		Refresh:                   statusEnabler(ctx, conn, accountIDs, resourceTypes),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.([]types.AccountState); ok {
+		tfresource.SetLastError(err, enablerStateError(output, resourceTypes))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitMemberAssociated(ctx context.Context, conn *inspector2.Client, accountID string, timeout time.Duration) (*types.Member, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:        []string{string(types.RelationshipStatusCreated)},
+		Target:         []string{string(types.RelationshipStatusEnabled)},
+		Refresh:        statusMember(ctx, conn, accountID),
+		Timeout:        timeout,
+		NotFoundChecks: 20,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Member); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitMemberDisassociated(ctx context.Context, conn *inspector2.Client, accountID string, timeout time.Duration) (*types.Member, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{string(types.RelationshipStatusCreated), string(types.RelationshipStatusEnabled)},
+		Target:  []string{},
+		Refresh: statusMember(ctx, conn, accountID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Member); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// enablerStateError returns the errors reported for the specified resource types.
+func enablerStateError(apiObjects []types.AccountState, resourceTypes []types.ResourceScanType) error {
+	var errs *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		for _, resourceType := range resourceTypes {
+			state := resourceScanTypeState(apiObject.ResourceState, resourceType)
+
+			if state == nil || aws.ToString(state.ErrorMessage) == "" {
+				continue
+			}
+
+			errs = multierror.Append(errs, fmt.Errorf("account (%s) %s: %s: %w", aws.ToString(apiObject.AccountId), resourceType, state.ErrorCode, errors.New(aws.ToString(state.ErrorMessage))))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -213,7 +214,7 @@ func resourceGrantRead(d *schema.ResourceData, meta interface{}) error {
 
 	// The grant sometimes contains principals that identified by their unique id: "AROAJYCVIVUZIMTXXXXX"
 	// instead of "arn:aws:...", in this case don't update the state file
-	if strings.HasPrefix(*grant.GranteePrincipal, "arn:aws") {
+	if arn.IsARN(aws.StringValue(grant.GranteePrincipal)) {
 		d.Set("grantee_principal", grant.GranteePrincipal)
 	} else {
 		log.Printf(
@@ -222,7 +223,7 @@ func resourceGrantRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if grant.RetiringPrincipal != nil {
-		if strings.HasPrefix(*grant.RetiringPrincipal, "arn:aws") {
+		if arn.IsARN(aws.StringValue(grant.RetiringPrincipal)) {
 			d.Set("retiring_principal", grant.RetiringPrincipal)
 		} else {
 			log.Printf(
@@ -520,7 +521,7 @@ func flattenGrantConstraints(constraint *kms.GrantConstraints) *schema.Set {
 }
 
 func decodeGrantID(id string) (string, string, error) {
-	if strings.HasPrefix(id, "arn:aws") {
+	if arn.IsARN(id) {
 		arnParts := strings.Split(id, "/")
 		if len(arnParts) != 2 {
 			return "", "", fmt.Errorf("unexpected format of ARN (%q), expected KeyID:GrantID", id)
@@ -7,6 +7,7 @@ import (
 	"log"
 	"os"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -42,7 +43,11 @@ func ResourceFunction() *schema.Resource {
 		},
 
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if err := verify.ImportARN(ctx, d, meta, lambda.ServiceName, resourceFunctionImportARN); err != nil {
+					return nil, err
+				}
+
 				d.Set("function_name", d.Id())
 				return []*schema.ResourceData{d}, nil
 			},
@@ -408,6 +413,21 @@ func hasConfigChanges(d verify.ResourceDiffer) bool {
 
 // resourceAwsLambdaFunction maps to:
 // CreateFunction in the API / SDK
+func resourceFunctionImportARN(_ context.Context, _ *schema.ResourceData, _ interface{}, functionARN arn.ARN) (string, error) {
+	// arn:${Partition}:lambda:${Region}:${Account}:function:${FunctionName}
+	parts := strings.Split(functionARN.Resource, ":")
+
+	if len(parts) == 3 && parts[0] == "function" {
+		return "", fmt.Errorf("qualified function ARNs are not supported, remove the qualifier (%s)", parts[2])
+	}
+
+	if len(parts) != 2 || parts[0] != "function" || parts[1] == "" {
+		return "", fmt.Errorf("expected resource function:${FunctionName}, got: %s", functionARN.Resource)
+	}
+
+	return parts[1], nil
+}
+
 func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).LambdaConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -72,6 +72,13 @@ func TestAccLambdaFunction_basic(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"filename", "publish"},
 			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filename", "publish"},
+				ImportStateIdFunc:       acctest.AttrImportStateIdFunc(resourceName, "arn"),
+			},
 		},
 	})
 }
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -189,20 +190,14 @@ func resourcePermissionRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("qualifier", qualifier)
 
 	// Save Lambda function name in the same format
-	if strings.HasPrefix(functionName, "arn:"+meta.(*conns.AWSClient).Partition+":lambda:") {
-		// Strip qualifier off
-		trimmedArn := strings.TrimSuffix(statement.Resource, ":"+qualifier)
-		d.Set("function_name", trimmedArn)
-	} else {
-		functionName, err := GetFunctionNameFromARN(statement.Resource)
-
-		if err != nil {
-			return err
-		}
+	functionName, err = GetFunctionNameInConfiguredFormat(functionName, statement.Resource, qualifier)
 
-		d.Set("function_name", functionName)
+	if err != nil {
+		return err
 	}
 
+	d.Set("function_name", functionName)
+
 	d.Set("action", statement.Action)
 	// Check if the principal is a cross-account IAM role
 	if v, ok := statement.Principal.(map[string]interface{}); ok {
@@ -365,6 +360,17 @@ func GetFunctionNameFromARN(arn string) (string, error) {
 	return matches[5], nil
 }
 
+// GetFunctionNameInConfiguredFormat returns the unqualified function ARN from the policy statement resource
+// if the configured function name is an ARN in any partition, otherwise the function name.
+func GetFunctionNameInConfiguredFormat(configured, resource, qualifier string) (string, error) {
+	if arn.IsARN(configured) {
+		// Strip qualifier off
+		return strings.TrimSuffix(resource, ":"+qualifier), nil
+	}
+
+	return GetFunctionNameFromARN(resource)
+}
+
 func resourcePermissionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	idParts := strings.Split(d.Id(), "/")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
@@ -200,6 +200,49 @@ func TestPermissionGetFunctionNameFromGovCloudARN(t *testing.T) {
 	}
 }
 
+func TestPermissionGetFunctionNameInConfiguredFormat(t *testing.T) {
+	testCases := []struct {
+		TestName   string
+		Configured string
+		Resource   string
+		Qualifier  string
+		Expected   string
+	}{
+		{
+			TestName:   "configured name",
+			Configured: "lambda_function_name",
+			Resource:   "arn:aws:lambda:us-west-2:187636751137:function:lambda_function_name:12", // lintignore:AWSAT003,AWSAT005 // unit test
+			Qualifier:  "12",
+			Expected:   "lambda_function_name",
+		},
+		{
+			TestName:   "configured ARN",
+			Configured: "arn:aws:lambda:us-west-2:187636751137:function:lambda_function_name",    // lintignore:AWSAT003,AWSAT005 // unit test
+			Resource:   "arn:aws:lambda:us-west-2:187636751137:function:lambda_function_name:12", // lintignore:AWSAT003,AWSAT005 // unit test
+			Qualifier:  "12",
+			Expected:   "arn:aws:lambda:us-west-2:187636751137:function:lambda_function_name", // lintignore:AWSAT003,AWSAT005 // unit test
+		},
+		{
+			TestName:   "configured GovCloud ARN",
+			Configured: "arn:aws-us-gov:lambda:us-gov-west-1:187636751137:function:lambda_function_name", // lintignore:AWSAT003,AWSAT005 // unit test
+			Resource:   "arn:aws-us-gov:lambda:us-gov-west-1:187636751137:function:lambda_function_name", // lintignore:AWSAT003,AWSAT005 // unit test
+			Expected:   "arn:aws-us-gov:lambda:us-gov-west-1:187636751137:function:lambda_function_name", // lintignore:AWSAT003,AWSAT005 // unit test
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			fn, err := tflambda.GetFunctionNameInConfiguredFormat(testCase.Configured, testCase.Resource, testCase.Qualifier)
+			if err != nil {
+				t.Fatalf("Expected no error: %s", err)
+			}
+			if fn != testCase.Expected {
+				t.Fatalf("Expected Lambda function name to match (%q != %q)", fn, testCase.Expected)
+			}
+		})
+	}
+}
+
 func TestAccLambdaPermission_basic(t *testing.T) {
 	var statement tflambda.PolicyStatement
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -0,0 +1,263 @@
+package lexv2models
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// BotVersionDraft is the working version of a bot, the only version that can be modified.
+	BotVersionDraft = "DRAFT"
+)
+
+func ResourceBotLocale() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBotLocaleCreate,
+		ReadWithoutTimeout:   resourceBotLocaleRead,
+		UpdateWithoutTimeout: resourceBotLocaleUpdate,
+		DeleteWithoutTimeout: resourceBotLocaleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bot_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  BotVersionDraft,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"locale_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"n_lu_intent_confidence_threshold": {
+				Type:         schema.TypeFloat,
+				Required:     true,
+				ValidateFunc: validation.FloatBetween(0, 1),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"voice_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"engine": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      lexmodelsv2.VoiceEngineStandard,
+							ValidateFunc: validation.StringInSlice(lexmodelsv2.VoiceEngine_Values(), false),
+						},
+						"voice_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBotLocaleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	botID := d.Get("bot_id").(string)
+	botVersion := d.Get("bot_version").(string)
+	localeID := d.Get("locale_id").(string)
+	id := BotLocaleCreateResourceID(botID, botVersion, localeID)
+	input := &lexmodelsv2.CreateBotLocaleInput{
+		BotId:                        aws.String(botID),
+		BotVersion:                   aws.String(botVersion),
+		LocaleId:                     aws.String(localeID),
+		NluIntentConfidenceThreshold: aws.Float64(d.Get("n_lu_intent_confidence_threshold").(float64)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("voice_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.VoiceSettings = expandVoiceSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Lex V2 Models Bot Locale: %s", input)
+	_, err := conn.CreateBotLocaleWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Lex V2 Models Bot Locale (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitBotLocaleCreated(ctx, conn, botID, botVersion, localeID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Lex V2 Models Bot Locale (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceBotLocaleRead(ctx, d, meta)
+}
+
+func resourceBotLocaleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	botID, botVersion, localeID, err := BotLocaleParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindBotLocaleByThreePartKey(ctx, conn, botID, botVersion, localeID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Models Bot Locale (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Lex V2 Models Bot Locale (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bot_id", output.BotId)
+	d.Set("bot_version", output.BotVersion)
+	d.Set("description", output.Description)
+	d.Set("locale_id", output.LocaleId)
+	d.Set("n_lu_intent_confidence_threshold", output.NluIntentConfidenceThreshold)
+	d.Set("name", output.LocaleName)
+	if output.VoiceSettings != nil {
+		if err := d.Set("voice_settings", []interface{}{flattenVoiceSettings(output.VoiceSettings)}); err != nil {
+			return diag.Errorf("setting voice_settings: %s", err)
+		}
+	} else {
+		d.Set("voice_settings", nil)
+	}
+
+	return nil
+}
+
+func resourceBotLocaleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	botID, botVersion, localeID, err := BotLocaleParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &lexmodelsv2.UpdateBotLocaleInput{
+		BotId:                        aws.String(botID),
+		BotVersion:                   aws.String(botVersion),
+		Description:                  aws.String(d.Get("description").(string)),
+		LocaleId:                     aws.String(localeID),
+		NluIntentConfidenceThreshold: aws.Float64(d.Get("n_lu_intent_confidence_threshold").(float64)),
+	}
+
+	if v, ok := d.GetOk("voice_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.VoiceSettings = expandVoiceSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating Lex V2 Models Bot Locale: %s", input)
+	_, err = conn.UpdateBotLocaleWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating Lex V2 Models Bot Locale (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitBotLocaleUpdated(ctx, conn, botID, botVersion, localeID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("waiting for Lex V2 Models Bot Locale (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceBotLocaleRead(ctx, d, meta)
+}
+
+func resourceBotLocaleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	botID, botVersion, localeID, err := BotLocaleParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Lex V2 Models Bot Locale: %s", d.Id())
+	_, err = conn.DeleteBotLocaleWithContext(ctx, &lexmodelsv2.DeleteBotLocaleInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		LocaleId:   aws.String(localeID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Lex V2 Models Bot Locale (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitBotLocaleDeleted(ctx, conn, botID, botVersion, localeID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Lex V2 Models Bot Locale (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandVoiceSettings(tfMap map[string]interface{}) *lexmodelsv2.VoiceSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lexmodelsv2.VoiceSettings{}
+
+	if v, ok := tfMap["engine"].(string); ok && v != "" {
+		apiObject.Engine = aws.String(v)
+	}
+
+	if v, ok := tfMap["voice_id"].(string); ok && v != "" {
+		apiObject.VoiceId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenVoiceSettings(apiObject *lexmodelsv2.VoiceSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"engine":   aws.StringValue(apiObject.Engine),
+		"voice_id": aws.StringValue(apiObject.VoiceId),
+	}
+}
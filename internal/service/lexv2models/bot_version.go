@@ -0,0 +1,263 @@
+package lexv2models
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceBotVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBotVersionCreate,
+		ReadWithoutTimeout:   resourceBotVersionRead,
+		DeleteWithoutTimeout: resourceBotVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBotVersionImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bot_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"locale_specification": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"locale_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"source_bot_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  BotVersionDraft,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBotVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	botID := d.Get("bot_id").(string)
+	localeSpecification := expandBotVersionLocaleSpecification(d.Get("locale_specification").(*schema.Set).List())
+
+	// A version can only be created from DRAFT locales that have been built.
+	for localeID, v := range localeSpecification {
+		if aws.StringValue(v.SourceBotVersion) != BotVersionDraft {
+			continue
+		}
+
+		if err := buildBotLocale(ctx, conn, botID, BotVersionDraft, localeID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("creating Lex V2 Models Bot Version (%s): %s", botID, err)
+		}
+	}
+
+	input := &lexmodelsv2.CreateBotVersionInput{
+		BotId:                         aws.String(botID),
+		BotVersionLocaleSpecification: localeSpecification,
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Lex V2 Models Bot Version: %s", input)
+	output, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return conn.CreateBotVersionWithContext(ctx, input)
+	}, lexmodelsv2.ErrCodeConflictException, lexmodelsv2.ErrCodePreconditionFailedException)
+
+	if err != nil {
+		return diag.Errorf("creating Lex V2 Models Bot Version (%s): %s", botID, err)
+	}
+
+	botVersion := aws.StringValue(output.(*lexmodelsv2.CreateBotVersionOutput).BotVersion)
+	d.SetId(BotVersionCreateResourceID(botID, botVersion))
+
+	if _, err := waitBotVersionCreated(ctx, conn, botID, botVersion, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Lex V2 Models Bot Version (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceBotVersionRead(ctx, d, meta)
+}
+
+func resourceBotVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	botID, botVersion, err := BotVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindBotVersionByTwoPartKey(ctx, conn, botID, botVersion)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Models Bot Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Lex V2 Models Bot Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bot_id", output.BotId)
+	d.Set("bot_version", output.BotVersion)
+	d.Set("description", output.Description)
+
+	return nil
+}
+
+func resourceBotVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	botID, botVersion, err := BotVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Lex V2 Models Bot Version: %s", d.Id())
+	_, err = conn.DeleteBotVersionWithContext(ctx, &lexmodelsv2.DeleteBotVersionInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Lex V2 Models Bot Version (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitBotVersionDeleted(ctx, conn, botID, botVersion, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Lex V2 Models Bot Version (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceBotVersionImport sets locale_specification, which DescribeBotVersion does not return, from the version's locales.
+func resourceBotVersionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	botID, botVersion, err := BotVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	var tfList []interface{}
+
+	input := &lexmodelsv2.ListBotLocalesInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+	}
+
+	err = conn.ListBotLocalesPagesWithContext(ctx, input, func(page *lexmodelsv2.ListBotLocalesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.BotLocaleSummaries {
+			tfList = append(tfList, map[string]interface{}{
+				"locale_id":          aws.StringValue(v.LocaleId),
+				"source_bot_version": BotVersionDraft,
+			})
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("listing Lex V2 Models Bot Version (%s) locales: %w", d.Id(), err)
+	}
+
+	d.Set("locale_specification", tfList)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// buildBotLocale builds a bot locale and waits for the build to finish.
+func buildBotLocale(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion, localeID string, timeout time.Duration) error {
+	input := &lexmodelsv2.BuildBotLocaleInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		LocaleId:   aws.String(localeID),
+	}
+
+	log.Printf("[DEBUG] Building Lex V2 Models Bot Locale: %s", input)
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, timeout, func() (interface{}, error) {
+		return conn.BuildBotLocaleWithContext(ctx, input)
+	}, lexmodelsv2.ErrCodeConflictException, lexmodelsv2.ErrCodePreconditionFailedException)
+
+	if err != nil {
+		return fmt.Errorf("building locale (%s): %w", localeID, err)
+	}
+
+	if _, err := waitBotLocaleBuilt(ctx, conn, botID, botVersion, localeID, timeout); err != nil {
+		return fmt.Errorf("waiting for locale (%s) build: %w", localeID, err)
+	}
+
+	return nil
+}
+
+func expandBotVersionLocaleSpecification(tfList []interface{}) map[string]*lexmodelsv2.BotVersionLocaleDetails {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*lexmodelsv2.BotVersionLocaleDetails)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects[tfMap["locale_id"].(string)] = &lexmodelsv2.BotVersionLocaleDetails{
+			SourceBotVersion: aws.String(tfMap["source_bot_version"].(string)),
+		}
+	}
+
+	return apiObjects
+}
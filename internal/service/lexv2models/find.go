@@ -0,0 +1,174 @@
+package lexv2models
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindBotByID(ctx context.Context, conn *lexmodelsv2.LexModelsV2, id string) (*lexmodelsv2.DescribeBotOutput, error) {
+	input := &lexmodelsv2.DescribeBotInput{
+		BotId: aws.String(id),
+	}
+
+	output, err := conn.DescribeBotWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindBotLocaleByThreePartKey(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion, localeID string) (*lexmodelsv2.DescribeBotLocaleOutput, error) {
+	input := &lexmodelsv2.DescribeBotLocaleInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		LocaleId:   aws.String(localeID),
+	}
+
+	output, err := conn.DescribeBotLocaleWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindBotVersionByTwoPartKey(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion string) (*lexmodelsv2.DescribeBotVersionOutput, error) {
+	input := &lexmodelsv2.DescribeBotVersionInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+	}
+
+	output, err := conn.DescribeBotVersionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindIntentByFourPartKey(ctx context.Context, conn *lexmodelsv2.LexModelsV2, intentID, botID, botVersion, localeID string) (*lexmodelsv2.DescribeIntentOutput, error) {
+	input := &lexmodelsv2.DescribeIntentInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		IntentId:   aws.String(intentID),
+		LocaleId:   aws.String(localeID),
+	}
+
+	output, err := conn.DescribeIntentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindSlotByFivePartKey(ctx context.Context, conn *lexmodelsv2.LexModelsV2, slotID, botID, botVersion, localeID, intentID string) (*lexmodelsv2.DescribeSlotOutput, error) {
+	input := &lexmodelsv2.DescribeSlotInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		IntentId:   aws.String(intentID),
+		LocaleId:   aws.String(localeID),
+		SlotId:     aws.String(slotID),
+	}
+
+	output, err := conn.DescribeSlotWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindSlotTypeByFourPartKey(ctx context.Context, conn *lexmodelsv2.LexModelsV2, slotTypeID, botID, botVersion, localeID string) (*lexmodelsv2.DescribeSlotTypeOutput, error) {
+	input := &lexmodelsv2.DescribeSlotTypeInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		LocaleId:   aws.String(localeID),
+		SlotTypeId: aws.String(slotTypeID),
+	}
+
+	output, err := conn.DescribeSlotTypeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
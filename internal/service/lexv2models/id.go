@@ -0,0 +1,101 @@
+package lexv2models
+
+import (
+	"fmt"
+	"strings"
+)
+
+const botLocaleResourceIDSeparator = ","
+
+func BotLocaleCreateResourceID(botID, botVersion, localeID string) string {
+	parts := []string{botID, botVersion, localeID}
+	id := strings.Join(parts, botLocaleResourceIDSeparator)
+
+	return id
+}
+
+func BotLocaleParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, botLocaleResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BOTID%[2]sBOTVERSION%[2]sLOCALEID", id, botLocaleResourceIDSeparator)
+}
+
+const botVersionResourceIDSeparator = ","
+
+func BotVersionCreateResourceID(botID, botVersion string) string {
+	parts := []string{botID, botVersion}
+	id := strings.Join(parts, botVersionResourceIDSeparator)
+
+	return id
+}
+
+func BotVersionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, botVersionResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BOTID%[2]sBOTVERSION", id, botVersionResourceIDSeparator)
+}
+
+const intentResourceIDSeparator = ","
+
+func IntentCreateResourceID(intentID, botID, botVersion, localeID string) string {
+	parts := []string{intentID, botID, botVersion, localeID}
+	id := strings.Join(parts, intentResourceIDSeparator)
+
+	return id
+}
+
+func IntentParseResourceID(id string) (string, string, string, string, error) {
+	parts := strings.Split(id, intentResourceIDSeparator)
+
+	if len(parts) == 4 && parts[0] != "" && parts[1] != "" && parts[2] != "" && parts[3] != "" {
+		return parts[0], parts[1], parts[2], parts[3], nil
+	}
+
+	return "", "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected INTENTID%[2]sBOTID%[2]sBOTVERSION%[2]sLOCALEID", id, intentResourceIDSeparator)
+}
+
+const slotResourceIDSeparator = ","
+
+func SlotCreateResourceID(slotID, botID, botVersion, localeID, intentID string) string {
+	parts := []string{slotID, botID, botVersion, localeID, intentID}
+	id := strings.Join(parts, slotResourceIDSeparator)
+
+	return id
+}
+
+func SlotParseResourceID(id string) (string, string, string, string, string, error) {
+	parts := strings.Split(id, slotResourceIDSeparator)
+
+	if len(parts) == 5 && parts[0] != "" && parts[1] != "" && parts[2] != "" && parts[3] != "" && parts[4] != "" {
+		return parts[0], parts[1], parts[2], parts[3], parts[4], nil
+	}
+
+	return "", "", "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected SLOTID%[2]sBOTID%[2]sBOTVERSION%[2]sLOCALEID%[2]sINTENTID", id, slotResourceIDSeparator)
+}
+
+const slotTypeResourceIDSeparator = ","
+
+func SlotTypeCreateResourceID(slotTypeID, botID, botVersion, localeID string) string {
+	parts := []string{slotTypeID, botID, botVersion, localeID}
+	id := strings.Join(parts, slotTypeResourceIDSeparator)
+
+	return id
+}
+
+func SlotTypeParseResourceID(id string) (string, string, string, string, error) {
+	parts := strings.Split(id, slotTypeResourceIDSeparator)
+
+	if len(parts) == 4 && parts[0] != "" && parts[1] != "" && parts[2] != "" && parts[3] != "" {
+		return parts[0], parts[1], parts[2], parts[3], nil
+	}
+
+	return "", "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected SLOTTYPEID%[2]sBOTID%[2]sBOTVERSION%[2]sLOCALEID", id, slotTypeResourceIDSeparator)
+}
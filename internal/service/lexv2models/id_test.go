@@ -0,0 +1,146 @@
+package lexv2models_test
+
+import (
+	"testing"
+
+	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
+)
+
+func TestBotLocaleParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName           string
+		Input              string
+		ExpectedBotID      string
+		ExpectedBotVersion string
+		ExpectedLocaleID   string
+		Error              bool
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Error:    true,
+		},
+		{
+			TestName: "Invalid ID",
+			Input:    "ABCDEFGHIJ,DRAFT,",
+			Error:    true,
+		},
+		{
+			TestName: "Invalid ID separator",
+			Input:    "ABCDEFGHIJ/DRAFT/en_US",
+			Error:    true,
+		},
+		{
+			TestName: "Invalid ID with more than 2 separators",
+			Input:    "ABCDEFGHIJ,DRAFT,en_US,extra",
+			Error:    true,
+		},
+		{
+			TestName:           "Valid ID",
+			Input:              "ABCDEFGHIJ,DRAFT,en_US",
+			ExpectedBotID:      "ABCDEFGHIJ",
+			ExpectedBotVersion: "DRAFT",
+			ExpectedLocaleID:   "en_US",
+			Error:              false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotBotID, gotBotVersion, gotLocaleID, err := tflexv2models.BotLocaleParseResourceID(testCase.Input)
+
+			if err != nil && !testCase.Error {
+				t.Errorf("got error (%s), expected no error", err)
+			}
+
+			if err == nil && testCase.Error {
+				t.Errorf("got (BotID: %s, BotVersion: %s, LocaleID: %s) and no error, expected error", gotBotID, gotBotVersion, gotLocaleID)
+			}
+
+			if gotBotID != testCase.ExpectedBotID {
+				t.Errorf("got %s, expected %s", gotBotID, testCase.ExpectedBotID)
+			}
+
+			if gotBotVersion != testCase.ExpectedBotVersion {
+				t.Errorf("got %s, expected %s", gotBotVersion, testCase.ExpectedBotVersion)
+			}
+
+			if gotLocaleID != testCase.ExpectedLocaleID {
+				t.Errorf("got %s, expected %s", gotLocaleID, testCase.ExpectedLocaleID)
+			}
+		})
+	}
+}
+
+func TestSlotParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName           string
+		Input              string
+		ExpectedSlotID     string
+		ExpectedBotID      string
+		ExpectedBotVersion string
+		ExpectedLocaleID   string
+		ExpectedIntentID   string
+		Error              bool
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Error:    true,
+		},
+		{
+			TestName: "Invalid ID",
+			Input:    "SLOTID1234,ABCDEFGHIJ,DRAFT,en_US",
+			Error:    true,
+		},
+		{
+			TestName: "Invalid ID with empty part",
+			Input:    "SLOTID1234,ABCDEFGHIJ,,en_US,INTENTID12",
+			Error:    true,
+		},
+		{
+			TestName:           "Valid ID",
+			Input:              "SLOTID1234,ABCDEFGHIJ,DRAFT,en_US,INTENTID12",
+			ExpectedSlotID:     "SLOTID1234",
+			ExpectedBotID:      "ABCDEFGHIJ",
+			ExpectedBotVersion: "DRAFT",
+			ExpectedLocaleID:   "en_US",
+			ExpectedIntentID:   "INTENTID12",
+			Error:              false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotSlotID, gotBotID, gotBotVersion, gotLocaleID, gotIntentID, err := tflexv2models.SlotParseResourceID(testCase.Input)
+
+			if err != nil && !testCase.Error {
+				t.Errorf("got error (%s), expected no error", err)
+			}
+
+			if err == nil && testCase.Error {
+				t.Errorf("got (SlotID: %s, BotID: %s, BotVersion: %s, LocaleID: %s, IntentID: %s) and no error, expected error", gotSlotID, gotBotID, gotBotVersion, gotLocaleID, gotIntentID)
+			}
+
+			if gotSlotID != testCase.ExpectedSlotID {
+				t.Errorf("got %s, expected %s", gotSlotID, testCase.ExpectedSlotID)
+			}
+
+			if gotBotID != testCase.ExpectedBotID {
+				t.Errorf("got %s, expected %s", gotBotID, testCase.ExpectedBotID)
+			}
+
+			if gotBotVersion != testCase.ExpectedBotVersion {
+				t.Errorf("got %s, expected %s", gotBotVersion, testCase.ExpectedBotVersion)
+			}
+
+			if gotLocaleID != testCase.ExpectedLocaleID {
+				t.Errorf("got %s, expected %s", gotLocaleID, testCase.ExpectedLocaleID)
+			}
+
+			if gotIntentID != testCase.ExpectedIntentID {
+				t.Errorf("got %s, expected %s", gotIntentID, testCase.ExpectedIntentID)
+			}
+		})
+	}
+}
@@ -0,0 +1,694 @@
+package lexv2models
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceIntent() *schema.Resource {
+	promptSpecification := promptSpecificationSchema()
+	promptSpecification.Optional = false
+	promptSpecification.Required = true
+
+	declinationResponse := responseSpecificationSchema()
+	declinationResponse.Optional = false
+	declinationResponse.Required = true
+
+	closingResponse := responseSpecificationSchema()
+	closingResponse.Optional = false
+	closingResponse.Required = true
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIntentCreate,
+		ReadWithoutTimeout:   resourceIntentRead,
+		UpdateWithoutTimeout: resourceIntentUpdate,
+		DeleteWithoutTimeout: resourceIntentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bot_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  BotVersionDraft,
+			},
+			"closing_setting": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"active": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"closing_response": closingResponse,
+					},
+				},
+			},
+			"confirmation_setting": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"active": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"declination_response": declinationResponse,
+						"prompt_specification": promptSpecification,
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"dialog_code_hook": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"fulfillment_code_hook": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"input_context": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+					},
+				},
+			},
+			"intent_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kendra_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kendra_index": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"query_filter_string": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 5000),
+						},
+						"query_filter_string_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"locale_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"output_context": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+						"time_to_live_in_seconds": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(5, 86400),
+						},
+						"turns_to_live": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 20),
+						},
+					},
+				},
+			},
+			"parent_intent_signature": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sample_utterance": sampleUtteranceSchema(),
+			"slot_priority": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"slot_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIntentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	name := d.Get("name").(string)
+	input := &lexmodelsv2.CreateIntentInput{
+		BotId:      aws.String(d.Get("bot_id").(string)),
+		BotVersion: aws.String(d.Get("bot_version").(string)),
+		IntentName: aws.String(name),
+		LocaleId:   aws.String(d.Get("locale_id").(string)),
+	}
+
+	if v, ok := d.GetOk("closing_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.IntentClosingSetting = expandIntentClosingSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("confirmation_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.IntentConfirmationSetting = expandIntentConfirmationSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("dialog_code_hook"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DialogCodeHook = &lexmodelsv2.DialogCodeHookSettings{
+			Enabled: aws.Bool(v.([]interface{})[0].(map[string]interface{})["enabled"].(bool)),
+		}
+	}
+
+	if v, ok := d.GetOk("fulfillment_code_hook"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.FulfillmentCodeHook = &lexmodelsv2.FulfillmentCodeHookSettings{
+			Enabled: aws.Bool(v.([]interface{})[0].(map[string]interface{})["enabled"].(bool)),
+		}
+	}
+
+	if v, ok := d.GetOk("input_context"); ok && len(v.([]interface{})) > 0 {
+		input.InputContexts = expandInputContexts(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("kendra_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.KendraConfiguration = expandKendraConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("output_context"); ok && len(v.([]interface{})) > 0 {
+		input.OutputContexts = expandOutputContexts(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("parent_intent_signature"); ok {
+		input.ParentIntentSignature = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sample_utterance"); ok && len(v.([]interface{})) > 0 {
+		input.SampleUtterances = expandSampleUtterances(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Lex V2 Models Intent: %s", input)
+	output, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return conn.CreateIntentWithContext(ctx, input)
+	}, lexmodelsv2.ErrCodeConflictException, lexmodelsv2.ErrCodePreconditionFailedException)
+
+	if err != nil {
+		return diag.Errorf("creating Lex V2 Models Intent (%s): %s", name, err)
+	}
+
+	intent := output.(*lexmodelsv2.CreateIntentOutput)
+	d.SetId(IntentCreateResourceID(aws.StringValue(intent.IntentId), aws.StringValue(intent.BotId), aws.StringValue(intent.BotVersion), aws.StringValue(intent.LocaleId)))
+
+	return resourceIntentRead(ctx, d, meta)
+}
+
+func resourceIntentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	intentID, botID, botVersion, localeID, err := IntentParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindIntentByFourPartKey(ctx, conn, intentID, botID, botVersion, localeID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Models Intent (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Lex V2 Models Intent (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bot_id", output.BotId)
+	d.Set("bot_version", output.BotVersion)
+	if output.IntentClosingSetting != nil {
+		if err := d.Set("closing_setting", []interface{}{flattenIntentClosingSetting(output.IntentClosingSetting)}); err != nil {
+			return diag.Errorf("setting closing_setting: %s", err)
+		}
+	} else {
+		d.Set("closing_setting", nil)
+	}
+	if output.IntentConfirmationSetting != nil {
+		if err := d.Set("confirmation_setting", []interface{}{flattenIntentConfirmationSetting(output.IntentConfirmationSetting)}); err != nil {
+			return diag.Errorf("setting confirmation_setting: %s", err)
+		}
+	} else {
+		d.Set("confirmation_setting", nil)
+	}
+	d.Set("description", output.Description)
+	if output.DialogCodeHook != nil {
+		if err := d.Set("dialog_code_hook", []interface{}{map[string]interface{}{"enabled": aws.BoolValue(output.DialogCodeHook.Enabled)}}); err != nil {
+			return diag.Errorf("setting dialog_code_hook: %s", err)
+		}
+	} else {
+		d.Set("dialog_code_hook", nil)
+	}
+	if output.FulfillmentCodeHook != nil {
+		if err := d.Set("fulfillment_code_hook", []interface{}{map[string]interface{}{"enabled": aws.BoolValue(output.FulfillmentCodeHook.Enabled)}}); err != nil {
+			return diag.Errorf("setting fulfillment_code_hook: %s", err)
+		}
+	} else {
+		d.Set("fulfillment_code_hook", nil)
+	}
+	if err := d.Set("input_context", flattenInputContexts(output.InputContexts)); err != nil {
+		return diag.Errorf("setting input_context: %s", err)
+	}
+	d.Set("intent_id", output.IntentId)
+	if output.KendraConfiguration != nil {
+		if err := d.Set("kendra_configuration", []interface{}{flattenKendraConfiguration(output.KendraConfiguration)}); err != nil {
+			return diag.Errorf("setting kendra_configuration: %s", err)
+		}
+	} else {
+		d.Set("kendra_configuration", nil)
+	}
+	d.Set("locale_id", output.LocaleId)
+	d.Set("name", output.IntentName)
+	if err := d.Set("output_context", flattenOutputContexts(output.OutputContexts)); err != nil {
+		return diag.Errorf("setting output_context: %s", err)
+	}
+	d.Set("parent_intent_signature", output.ParentIntentSignature)
+	if err := d.Set("sample_utterance", flattenSampleUtterances(output.SampleUtterances)); err != nil {
+		return diag.Errorf("setting sample_utterance: %s", err)
+	}
+	if err := d.Set("slot_priority", flattenSlotPriorities(output.SlotPriorities)); err != nil {
+		return diag.Errorf("setting slot_priority: %s", err)
+	}
+
+	return nil
+}
+
+func resourceIntentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	intentID, botID, botVersion, localeID, err := IntentParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// UpdateIntent replaces the intent's configuration, so every argument is sent.
+	input := &lexmodelsv2.UpdateIntentInput{
+		BotId:       aws.String(botID),
+		BotVersion:  aws.String(botVersion),
+		Description: aws.String(d.Get("description").(string)),
+		IntentId:    aws.String(intentID),
+		IntentName:  aws.String(d.Get("name").(string)),
+		LocaleId:    aws.String(localeID),
+	}
+
+	if v, ok := d.GetOk("closing_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.IntentClosingSetting = expandIntentClosingSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("confirmation_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.IntentConfirmationSetting = expandIntentConfirmationSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("dialog_code_hook"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DialogCodeHook = &lexmodelsv2.DialogCodeHookSettings{
+			Enabled: aws.Bool(v.([]interface{})[0].(map[string]interface{})["enabled"].(bool)),
+		}
+	}
+
+	if v, ok := d.GetOk("fulfillment_code_hook"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.FulfillmentCodeHook = &lexmodelsv2.FulfillmentCodeHookSettings{
+			Enabled: aws.Bool(v.([]interface{})[0].(map[string]interface{})["enabled"].(bool)),
+		}
+	}
+
+	if v, ok := d.GetOk("input_context"); ok && len(v.([]interface{})) > 0 {
+		input.InputContexts = expandInputContexts(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("kendra_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.KendraConfiguration = expandKendraConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("output_context"); ok && len(v.([]interface{})) > 0 {
+		input.OutputContexts = expandOutputContexts(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("parent_intent_signature"); ok {
+		input.ParentIntentSignature = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sample_utterance"); ok && len(v.([]interface{})) > 0 {
+		input.SampleUtterances = expandSampleUtterances(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("slot_priority"); ok && len(v.([]interface{})) > 0 {
+		input.SlotPriorities = expandSlotPriorities(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating Lex V2 Models Intent: %s", input)
+	_, err = tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+		return conn.UpdateIntentWithContext(ctx, input)
+	}, lexmodelsv2.ErrCodeConflictException, lexmodelsv2.ErrCodePreconditionFailedException)
+
+	if err != nil {
+		return diag.Errorf("updating Lex V2 Models Intent (%s): %s", d.Id(), err)
+	}
+
+	return resourceIntentRead(ctx, d, meta)
+}
+
+func resourceIntentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	intentID, botID, botVersion, localeID, err := IntentParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Lex V2 Models Intent: %s", d.Id())
+	_, err = tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteIntentWithContext(ctx, &lexmodelsv2.DeleteIntentInput{
+			BotId:      aws.String(botID),
+			BotVersion: aws.String(botVersion),
+			IntentId:   aws.String(intentID),
+			LocaleId:   aws.String(localeID),
+		})
+	}, lexmodelsv2.ErrCodeConflictException, lexmodelsv2.ErrCodePreconditionFailedException)
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Lex V2 Models Intent (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIntentClosingSetting(tfMap map[string]interface{}) *lexmodelsv2.IntentClosingSetting {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lexmodelsv2.IntentClosingSetting{}
+
+	if v, ok := tfMap["active"].(bool); ok {
+		apiObject.Active = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["closing_response"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ClosingResponse = expandResponseSpecification(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func flattenIntentClosingSetting(apiObject *lexmodelsv2.IntentClosingSetting) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"active": aws.BoolValue(apiObject.Active),
+	}
+
+	if v := apiObject.ClosingResponse; v != nil {
+		tfMap["closing_response"] = []interface{}{flattenResponseSpecification(v)}
+	}
+
+	return tfMap
+}
+
+func expandIntentConfirmationSetting(tfMap map[string]interface{}) *lexmodelsv2.IntentConfirmationSetting {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lexmodelsv2.IntentConfirmationSetting{}
+
+	if v, ok := tfMap["active"].(bool); ok {
+		apiObject.Active = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["declination_response"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DeclinationResponse = expandResponseSpecification(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["prompt_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PromptSpecification = expandPromptSpecification(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func flattenIntentConfirmationSetting(apiObject *lexmodelsv2.IntentConfirmationSetting) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"active": aws.BoolValue(apiObject.Active),
+	}
+
+	if v := apiObject.DeclinationResponse; v != nil {
+		tfMap["declination_response"] = []interface{}{flattenResponseSpecification(v)}
+	}
+
+	if v := apiObject.PromptSpecification; v != nil {
+		tfMap["prompt_specification"] = []interface{}{flattenPromptSpecification(v)}
+	}
+
+	return tfMap
+}
+
+func expandInputContexts(tfList []interface{}) []*lexmodelsv2.InputContext {
+	var apiObjects []*lexmodelsv2.InputContext
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &lexmodelsv2.InputContext{
+			Name: aws.String(tfMap["name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenInputContexts(apiObjects []*lexmodelsv2.InputContext) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name": aws.StringValue(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
+
+func expandKendraConfiguration(tfMap map[string]interface{}) *lexmodelsv2.KendraConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lexmodelsv2.KendraConfiguration{}
+
+	if v, ok := tfMap["kendra_index"].(string); ok && v != "" {
+		apiObject.KendraIndex = aws.String(v)
+	}
+
+	if v, ok := tfMap["query_filter_string"].(string); ok && v != "" {
+		apiObject.QueryFilterString = aws.String(v)
+	}
+
+	if v, ok := tfMap["query_filter_string_enabled"].(bool); ok {
+		apiObject.QueryFilterStringEnabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenKendraConfiguration(apiObject *lexmodelsv2.KendraConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"kendra_index":                aws.StringValue(apiObject.KendraIndex),
+		"query_filter_string":         aws.StringValue(apiObject.QueryFilterString),
+		"query_filter_string_enabled": aws.BoolValue(apiObject.QueryFilterStringEnabled),
+	}
+}
+
+func expandOutputContexts(tfList []interface{}) []*lexmodelsv2.OutputContext {
+	var apiObjects []*lexmodelsv2.OutputContext
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &lexmodelsv2.OutputContext{
+			Name:                aws.String(tfMap["name"].(string)),
+			TimeToLiveInSeconds: aws.Int64(int64(tfMap["time_to_live_in_seconds"].(int))),
+			TurnsToLive:         aws.Int64(int64(tfMap["turns_to_live"].(int))),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenOutputContexts(apiObjects []*lexmodelsv2.OutputContext) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":                    aws.StringValue(apiObject.Name),
+			"time_to_live_in_seconds": aws.Int64Value(apiObject.TimeToLiveInSeconds),
+			"turns_to_live":           aws.Int64Value(apiObject.TurnsToLive),
+		})
+	}
+
+	return tfList
+}
+
+func expandSlotPriorities(tfList []interface{}) []*lexmodelsv2.SlotPriority {
+	var apiObjects []*lexmodelsv2.SlotPriority
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &lexmodelsv2.SlotPriority{
+			Priority: aws.Int64(int64(tfMap["priority"].(int))),
+			SlotId:   aws.String(tfMap["slot_id"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenSlotPriorities(apiObjects []*lexmodelsv2.SlotPriority) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"priority": aws.Int64Value(apiObject.Priority),
+			"slot_id":  aws.StringValue(apiObject.SlotId),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,252 @@
+package lexv2models_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLexV2ModelsIntent_basic(t *testing.T) {
+	var intent lexmodelsv2.DescribeIntentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_intent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckIntentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntentExists(resourceName, &intent),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_id", "aws_lexv2models_bot.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "bot_version", "DRAFT"),
+					resource.TestCheckResourceAttrSet(resourceName, "intent_id"),
+					resource.TestCheckResourceAttr(resourceName, "locale_id", "en_US"),
+					resource.TestCheckResourceAttr(resourceName, "name", "OrderFlowers"),
+					resource.TestCheckResourceAttr(resourceName, "sample_utterance.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sample_utterance.0.utterance", "I would like to order flowers"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsIntent_disappears(t *testing.T) {
+	var intent lexmodelsv2.DescribeIntentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_intent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckIntentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntentExists(resourceName, &intent),
+					acctest.CheckResourceDisappears(acctest.Provider, tflexv2models.ResourceIntent(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsIntent_settings(t *testing.T) {
+	var intent lexmodelsv2.DescribeIntentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_intent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckIntentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntentConfig_settings(rName, "Okay, your order is placed.", 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntentExists(resourceName, &intent),
+					resource.TestCheckResourceAttr(resourceName, "closing_setting.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "closing_setting.0.active", "true"),
+					resource.TestCheckResourceAttr(resourceName, "closing_setting.0.closing_response.0.message_group.0.message.0.plain_text_message.0.value", "Okay, your order is placed."),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_setting.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_setting.0.prompt_specification.0.max_retries", "2"),
+					resource.TestCheckResourceAttr(resourceName, "dialog_code_hook.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dialog_code_hook.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "output_context.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output_context.0.name", "OrderPlaced"),
+					resource.TestCheckResourceAttr(resourceName, "output_context.0.time_to_live_in_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "output_context.0.turns_to_live", "5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIntentConfig_settings(rName, "Your flowers are on their way.", 120),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntentExists(resourceName, &intent),
+					resource.TestCheckResourceAttr(resourceName, "closing_setting.0.closing_response.0.message_group.0.message.0.plain_text_message.0.value", "Your flowers are on their way."),
+					resource.TestCheckResourceAttr(resourceName, "output_context.0.time_to_live_in_seconds", "120"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIntentDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lexv2models_intent" {
+			continue
+		}
+
+		intentID, botID, botVersion, localeID, err := tflexv2models.IntentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tflexv2models.FindIntentByFourPartKey(context.TODO(), conn, intentID, botID, botVersion, localeID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex V2 Models Intent %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckIntentExists(n string, v *lexmodelsv2.DescribeIntentOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex V2 Models Intent ID is set")
+		}
+
+		intentID, botID, botVersion, localeID, err := tflexv2models.IntentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn
+
+		output, err := tflexv2models.FindIntentByFourPartKey(context.TODO(), conn, intentID, botID, botVersion, localeID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccIntentConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccBotLocaleConfig_basic(rName), `
+resource "aws_lexv2models_intent" "test" {
+  bot_id    = aws_lexv2models_bot.test.id
+  locale_id = aws_lexv2models_bot_locale.test.locale_id
+  name      = "OrderFlowers"
+
+  sample_utterance {
+    utterance = "I would like to order flowers"
+  }
+}
+`)
+}
+
+func testAccIntentConfig_settings(rName, closingMessage string, timeToLive int) string {
+	return acctest.ConfigCompose(testAccBotLocaleConfig_basic(rName), fmt.Sprintf(`
+resource "aws_lexv2models_intent" "test" {
+  bot_id    = aws_lexv2models_bot.test.id
+  locale_id = aws_lexv2models_bot_locale.test.locale_id
+  name      = "OrderFlowers"
+
+  sample_utterance {
+    utterance = "I would like to order flowers"
+  }
+
+  closing_setting {
+    closing_response {
+      message_group {
+        message {
+          plain_text_message {
+            value = %[1]q
+          }
+        }
+      }
+    }
+  }
+
+  confirmation_setting {
+    prompt_specification {
+      max_retries = 2
+
+      message_group {
+        message {
+          plain_text_message {
+            value = "Should I place your order?"
+          }
+        }
+      }
+    }
+
+    declination_response {
+      message_group {
+        message {
+          plain_text_message {
+            value = "Okay, I won't place your order."
+          }
+        }
+      }
+    }
+  }
+
+  dialog_code_hook {
+    enabled = false
+  }
+
+  output_context {
+    name                    = "OrderPlaced"
+    time_to_live_in_seconds = %[2]d
+    turns_to_live           = 5
+  }
+}
+`, closingMessage, timeToLive))
+}
@@ -0,0 +1,464 @@
+package lexv2models
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceSlot() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSlotCreate,
+		ReadWithoutTimeout:   resourceSlotRead,
+		UpdateWithoutTimeout: resourceSlotUpdate,
+		DeleteWithoutTimeout: resourceSlotDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bot_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  BotVersionDraft,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"intent_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"locale_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"multiple_values_setting": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_multiple_values": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"obfuscation_setting": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"obfuscation_setting_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(lexmodelsv2.ObfuscationSettingType_Values(), false),
+						},
+					},
+				},
+			},
+			"slot_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"slot_type_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"value_elicitation_setting": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_value_specification": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"default_value_list": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 10,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"default_value": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 202),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"prompt_specification": promptSpecificationSchema(),
+						"sample_utterance":     sampleUtteranceSchema(),
+						"slot_constraint": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(lexmodelsv2.SlotConstraint_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSlotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	name := d.Get("name").(string)
+	input := &lexmodelsv2.CreateSlotInput{
+		BotId:      aws.String(d.Get("bot_id").(string)),
+		BotVersion: aws.String(d.Get("bot_version").(string)),
+		IntentId:   aws.String(d.Get("intent_id").(string)),
+		LocaleId:   aws.String(d.Get("locale_id").(string)),
+		SlotName:   aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("multiple_values_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MultipleValuesSetting = expandMultipleValuesSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("obfuscation_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ObfuscationSetting = expandObfuscationSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("slot_type_id"); ok {
+		input.SlotTypeId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("value_elicitation_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ValueElicitationSetting = expandSlotValueElicitationSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Lex V2 Models Slot: %s", input)
+	output, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return conn.CreateSlotWithContext(ctx, input)
+	}, lexmodelsv2.ErrCodeConflictException, lexmodelsv2.ErrCodePreconditionFailedException)
+
+	if err != nil {
+		return diag.Errorf("creating Lex V2 Models Slot (%s): %s", name, err)
+	}
+
+	slot := output.(*lexmodelsv2.CreateSlotOutput)
+	d.SetId(SlotCreateResourceID(aws.StringValue(slot.SlotId), aws.StringValue(slot.BotId), aws.StringValue(slot.BotVersion), aws.StringValue(slot.LocaleId), aws.StringValue(slot.IntentId)))
+
+	return resourceSlotRead(ctx, d, meta)
+}
+
+func resourceSlotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	slotID, botID, botVersion, localeID, intentID, err := SlotParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindSlotByFivePartKey(ctx, conn, slotID, botID, botVersion, localeID, intentID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Models Slot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Lex V2 Models Slot (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bot_id", output.BotId)
+	d.Set("bot_version", output.BotVersion)
+	d.Set("description", output.Description)
+	d.Set("intent_id", output.IntentId)
+	d.Set("locale_id", output.LocaleId)
+	if output.MultipleValuesSetting != nil {
+		if err := d.Set("multiple_values_setting", []interface{}{flattenMultipleValuesSetting(output.MultipleValuesSetting)}); err != nil {
+			return diag.Errorf("setting multiple_values_setting: %s", err)
+		}
+	} else {
+		d.Set("multiple_values_setting", nil)
+	}
+	d.Set("name", output.SlotName)
+	if output.ObfuscationSetting != nil {
+		if err := d.Set("obfuscation_setting", []interface{}{flattenObfuscationSetting(output.ObfuscationSetting)}); err != nil {
+			return diag.Errorf("setting obfuscation_setting: %s", err)
+		}
+	} else {
+		d.Set("obfuscation_setting", nil)
+	}
+	d.Set("slot_id", output.SlotId)
+	d.Set("slot_type_id", output.SlotTypeId)
+	if output.ValueElicitationSetting != nil {
+		if err := d.Set("value_elicitation_setting", []interface{}{flattenSlotValueElicitationSetting(output.ValueElicitationSetting)}); err != nil {
+			return diag.Errorf("setting value_elicitation_setting: %s", err)
+		}
+	} else {
+		d.Set("value_elicitation_setting", nil)
+	}
+
+	return nil
+}
+
+func resourceSlotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	slotID, botID, botVersion, localeID, intentID, err := SlotParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// UpdateSlot replaces the slot's configuration, so every argument is sent.
+	input := &lexmodelsv2.UpdateSlotInput{
+		BotId:       aws.String(botID),
+		BotVersion:  aws.String(botVersion),
+		Description: aws.String(d.Get("description").(string)),
+		IntentId:    aws.String(intentID),
+		LocaleId:    aws.String(localeID),
+		SlotId:      aws.String(slotID),
+		SlotName:    aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("multiple_values_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MultipleValuesSetting = expandMultipleValuesSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("obfuscation_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ObfuscationSetting = expandObfuscationSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("slot_type_id"); ok {
+		input.SlotTypeId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("value_elicitation_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ValueElicitationSetting = expandSlotValueElicitationSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating Lex V2 Models Slot: %s", input)
+	_, err = tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+		return conn.UpdateSlotWithContext(ctx, input)
+	}, lexmodelsv2.ErrCodeConflictException, lexmodelsv2.ErrCodePreconditionFailedException)
+
+	if err != nil {
+		return diag.Errorf("updating Lex V2 Models Slot (%s): %s", d.Id(), err)
+	}
+
+	return resourceSlotRead(ctx, d, meta)
+}
+
+func resourceSlotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	slotID, botID, botVersion, localeID, intentID, err := SlotParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Lex V2 Models Slot: %s", d.Id())
+	_, err = tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteSlotWithContext(ctx, &lexmodelsv2.DeleteSlotInput{
+			BotId:      aws.String(botID),
+			BotVersion: aws.String(botVersion),
+			IntentId:   aws.String(intentID),
+			LocaleId:   aws.String(localeID),
+			SlotId:     aws.String(slotID),
+		})
+	}, lexmodelsv2.ErrCodeConflictException, lexmodelsv2.ErrCodePreconditionFailedException)
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Lex V2 Models Slot (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMultipleValuesSetting(tfMap map[string]interface{}) *lexmodelsv2.MultipleValuesSetting {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lexmodelsv2.MultipleValuesSetting{}
+
+	if v, ok := tfMap["allow_multiple_values"].(bool); ok {
+		apiObject.AllowMultipleValues = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenMultipleValuesSetting(apiObject *lexmodelsv2.MultipleValuesSetting) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"allow_multiple_values": aws.BoolValue(apiObject.AllowMultipleValues),
+	}
+}
+
+func expandObfuscationSetting(tfMap map[string]interface{}) *lexmodelsv2.ObfuscationSetting {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lexmodelsv2.ObfuscationSetting{}
+
+	if v, ok := tfMap["obfuscation_setting_type"].(string); ok && v != "" {
+		apiObject.ObfuscationSettingType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenObfuscationSetting(apiObject *lexmodelsv2.ObfuscationSetting) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"obfuscation_setting_type": aws.StringValue(apiObject.ObfuscationSettingType),
+	}
+}
+
+func expandSlotValueElicitationSetting(tfMap map[string]interface{}) *lexmodelsv2.SlotValueElicitationSetting {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lexmodelsv2.SlotValueElicitationSetting{}
+
+	if v, ok := tfMap["default_value_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DefaultValueSpecification = expandSlotDefaultValueSpecification(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["prompt_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PromptSpecification = expandPromptSpecification(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["sample_utterance"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SampleUtterances = expandSampleUtterances(v)
+	}
+
+	if v, ok := tfMap["slot_constraint"].(string); ok && v != "" {
+		apiObject.SlotConstraint = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSlotValueElicitationSetting(apiObject *lexmodelsv2.SlotValueElicitationSetting) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"sample_utterance": flattenSampleUtterances(apiObject.SampleUtterances),
+		"slot_constraint":  aws.StringValue(apiObject.SlotConstraint),
+	}
+
+	if v := apiObject.DefaultValueSpecification; v != nil {
+		tfMap["default_value_specification"] = []interface{}{flattenSlotDefaultValueSpecification(v)}
+	}
+
+	if v := apiObject.PromptSpecification; v != nil {
+		tfMap["prompt_specification"] = []interface{}{flattenPromptSpecification(v)}
+	}
+
+	return tfMap
+}
+
+func expandSlotDefaultValueSpecification(tfMap map[string]interface{}) *lexmodelsv2.SlotDefaultValueSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lexmodelsv2.SlotDefaultValueSpecification{}
+
+	if v, ok := tfMap["default_value_list"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.DefaultValueList = append(apiObject.DefaultValueList, &lexmodelsv2.SlotDefaultValue{
+				DefaultValue: aws.String(tfMap["default_value"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenSlotDefaultValueSpecification(apiObject *lexmodelsv2.SlotDefaultValueSpecification) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, v := range apiObject.DefaultValueList {
+		if v == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"default_value": aws.StringValue(v.DefaultValue),
+		})
+	}
+
+	return map[string]interface{}{
+		"default_value_list": tfList,
+	}
+}
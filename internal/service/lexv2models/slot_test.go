@@ -0,0 +1,182 @@
+package lexv2models_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLexV2ModelsSlot_basic(t *testing.T) {
+	var slot lexmodelsv2.DescribeSlotOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_slot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckSlotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlotConfig_basic(rName, "Required", "What type of flowers would you like to order?"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlotExists(resourceName, &slot),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_id", "aws_lexv2models_bot.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "bot_version", "DRAFT"),
+					resource.TestCheckResourceAttrPair(resourceName, "intent_id", "aws_lexv2models_intent.test", "intent_id"),
+					resource.TestCheckResourceAttr(resourceName, "locale_id", "en_US"),
+					resource.TestCheckResourceAttr(resourceName, "name", "FlowerType"),
+					resource.TestCheckResourceAttrSet(resourceName, "slot_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "slot_type_id", "aws_lexv2models_slot_type.test", "slot_type_id"),
+					resource.TestCheckResourceAttr(resourceName, "value_elicitation_setting.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "value_elicitation_setting.0.slot_constraint", "Required"),
+					resource.TestCheckResourceAttr(resourceName, "value_elicitation_setting.0.prompt_specification.0.message_group.0.message.0.plain_text_message.0.value", "What type of flowers would you like to order?"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSlotConfig_basic(rName, "Optional", "Which flowers?"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlotExists(resourceName, &slot),
+					resource.TestCheckResourceAttr(resourceName, "value_elicitation_setting.0.slot_constraint", "Optional"),
+					resource.TestCheckResourceAttr(resourceName, "value_elicitation_setting.0.prompt_specification.0.message_group.0.message.0.plain_text_message.0.value", "Which flowers?"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsSlot_disappears(t *testing.T) {
+	var slot lexmodelsv2.DescribeSlotOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_slot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckSlotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlotConfig_basic(rName, "Required", "What type of flowers would you like to order?"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlotExists(resourceName, &slot),
+					acctest.CheckResourceDisappears(acctest.Provider, tflexv2models.ResourceSlot(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSlotDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lexv2models_slot" {
+			continue
+		}
+
+		slotID, botID, botVersion, localeID, intentID, err := tflexv2models.SlotParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tflexv2models.FindSlotByFivePartKey(context.TODO(), conn, slotID, botID, botVersion, localeID, intentID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex V2 Models Slot %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckSlotExists(n string, v *lexmodelsv2.DescribeSlotOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex V2 Models Slot ID is set")
+		}
+
+		slotID, botID, botVersion, localeID, intentID, err := tflexv2models.SlotParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn
+
+		output, err := tflexv2models.FindSlotByFivePartKey(context.TODO(), conn, slotID, botID, botVersion, localeID, intentID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSlotConfig_basic(rName, slotConstraint, prompt string) string {
+	return acctest.ConfigCompose(testAccSlotTypeConfig_basic(rName, "lilies"), fmt.Sprintf(`
+resource "aws_lexv2models_intent" "test" {
+  bot_id    = aws_lexv2models_bot.test.id
+  locale_id = aws_lexv2models_bot_locale.test.locale_id
+  name      = "OrderFlowers"
+
+  sample_utterance {
+    utterance = "I would like to order flowers"
+  }
+}
+
+resource "aws_lexv2models_slot" "test" {
+  bot_id       = aws_lexv2models_bot.test.id
+  intent_id    = aws_lexv2models_intent.test.intent_id
+  locale_id    = aws_lexv2models_bot_locale.test.locale_id
+  name         = "FlowerType"
+  slot_type_id = aws_lexv2models_slot_type.test.slot_type_id
+
+  value_elicitation_setting {
+    slot_constraint = %[1]q
+
+    prompt_specification {
+      max_retries = 2
+
+      message_group {
+        message {
+          plain_text_message {
+            value = %[2]q
+          }
+        }
+      }
+    }
+  }
+}
+`, slotConstraint, prompt))
+}
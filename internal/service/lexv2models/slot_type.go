@@ -0,0 +1,438 @@
+package lexv2models
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceSlotType() *schema.Resource {
+	sampleValue := sampleValueSchema()
+	sampleValue.MaxItems = 1
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSlotTypeCreate,
+		ReadWithoutTimeout:   resourceSlotTypeRead,
+		UpdateWithoutTimeout: resourceSlotTypeUpdate,
+		DeleteWithoutTimeout: resourceSlotTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bot_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  BotVersionDraft,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"locale_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"parent_slot_type_signature": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"slot_type_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"slot_type_value": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 10000,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sample_value": sampleValue,
+						"synonyms":     sampleValueSchema(),
+					},
+				},
+			},
+			"value_selection_setting": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"advanced_recognition_setting": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"audio_recognition_strategy": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(lexmodelsv2.AudioRecognitionStrategy_Values(), false),
+									},
+								},
+							},
+						},
+						"regex_filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"pattern": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 300),
+									},
+								},
+							},
+						},
+						"resolution_strategy": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(lexmodelsv2.SlotValueResolutionStrategy_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func sampleValueSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 10000,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"value": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 140),
+				},
+			},
+		},
+	}
+}
+
+func resourceSlotTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	name := d.Get("name").(string)
+	input := &lexmodelsv2.CreateSlotTypeInput{
+		BotId:        aws.String(d.Get("bot_id").(string)),
+		BotVersion:   aws.String(d.Get("bot_version").(string)),
+		LocaleId:     aws.String(d.Get("locale_id").(string)),
+		SlotTypeName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parent_slot_type_signature"); ok {
+		input.ParentSlotTypeSignature = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("slot_type_value"); ok && len(v.([]interface{})) > 0 {
+		input.SlotTypeValues = expandSlotTypeValues(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("value_selection_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ValueSelectionSetting = expandSlotValueSelectionSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Lex V2 Models Slot Type: %s", input)
+	output, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return conn.CreateSlotTypeWithContext(ctx, input)
+	}, lexmodelsv2.ErrCodeConflictException, lexmodelsv2.ErrCodePreconditionFailedException)
+
+	if err != nil {
+		return diag.Errorf("creating Lex V2 Models Slot Type (%s): %s", name, err)
+	}
+
+	slotType := output.(*lexmodelsv2.CreateSlotTypeOutput)
+	d.SetId(SlotTypeCreateResourceID(aws.StringValue(slotType.SlotTypeId), aws.StringValue(slotType.BotId), aws.StringValue(slotType.BotVersion), aws.StringValue(slotType.LocaleId)))
+
+	return resourceSlotTypeRead(ctx, d, meta)
+}
+
+func resourceSlotTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	slotTypeID, botID, botVersion, localeID, err := SlotTypeParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindSlotTypeByFourPartKey(ctx, conn, slotTypeID, botID, botVersion, localeID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Models Slot Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Lex V2 Models Slot Type (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bot_id", output.BotId)
+	d.Set("bot_version", output.BotVersion)
+	d.Set("description", output.Description)
+	d.Set("locale_id", output.LocaleId)
+	d.Set("name", output.SlotTypeName)
+	d.Set("parent_slot_type_signature", output.ParentSlotTypeSignature)
+	d.Set("slot_type_id", output.SlotTypeId)
+	if err := d.Set("slot_type_value", flattenSlotTypeValues(output.SlotTypeValues)); err != nil {
+		return diag.Errorf("setting slot_type_value: %s", err)
+	}
+	if output.ValueSelectionSetting != nil {
+		if err := d.Set("value_selection_setting", []interface{}{flattenSlotValueSelectionSetting(output.ValueSelectionSetting)}); err != nil {
+			return diag.Errorf("setting value_selection_setting: %s", err)
+		}
+	} else {
+		d.Set("value_selection_setting", nil)
+	}
+
+	return nil
+}
+
+func resourceSlotTypeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	slotTypeID, botID, botVersion, localeID, err := SlotTypeParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// UpdateSlotType replaces the slot type's configuration, so every argument is sent.
+	input := &lexmodelsv2.UpdateSlotTypeInput{
+		BotId:        aws.String(botID),
+		BotVersion:   aws.String(botVersion),
+		Description:  aws.String(d.Get("description").(string)),
+		LocaleId:     aws.String(localeID),
+		SlotTypeId:   aws.String(slotTypeID),
+		SlotTypeName: aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("parent_slot_type_signature"); ok {
+		input.ParentSlotTypeSignature = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("slot_type_value"); ok && len(v.([]interface{})) > 0 {
+		input.SlotTypeValues = expandSlotTypeValues(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("value_selection_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ValueSelectionSetting = expandSlotValueSelectionSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating Lex V2 Models Slot Type: %s", input)
+	_, err = tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+		return conn.UpdateSlotTypeWithContext(ctx, input)
+	}, lexmodelsv2.ErrCodeConflictException, lexmodelsv2.ErrCodePreconditionFailedException)
+
+	if err != nil {
+		return diag.Errorf("updating Lex V2 Models Slot Type (%s): %s", d.Id(), err)
+	}
+
+	return resourceSlotTypeRead(ctx, d, meta)
+}
+
+func resourceSlotTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	slotTypeID, botID, botVersion, localeID, err := SlotTypeParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Lex V2 Models Slot Type: %s", d.Id())
+	_, err = tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteSlotTypeWithContext(ctx, &lexmodelsv2.DeleteSlotTypeInput{
+			BotId:                  aws.String(botID),
+			BotVersion:             aws.String(botVersion),
+			LocaleId:               aws.String(localeID),
+			SkipResourceInUseCheck: aws.Bool(true),
+			SlotTypeId:             aws.String(slotTypeID),
+		})
+	}, lexmodelsv2.ErrCodeConflictException, lexmodelsv2.ErrCodePreconditionFailedException)
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Lex V2 Models Slot Type (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSampleValues(tfList []interface{}) []*lexmodelsv2.SampleValue {
+	var apiObjects []*lexmodelsv2.SampleValue
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &lexmodelsv2.SampleValue{
+			Value: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenSampleValues(apiObjects []*lexmodelsv2.SampleValue) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"value": aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func expandSlotTypeValues(tfList []interface{}) []*lexmodelsv2.SlotTypeValue {
+	var apiObjects []*lexmodelsv2.SlotTypeValue
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &lexmodelsv2.SlotTypeValue{}
+
+		if v := expandSampleValues(tfMap["sample_value"].([]interface{})); len(v) > 0 {
+			apiObject.SampleValue = v[0]
+		}
+
+		if v := expandSampleValues(tfMap["synonyms"].([]interface{})); len(v) > 0 {
+			apiObject.Synonyms = v
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenSlotTypeValues(apiObjects []*lexmodelsv2.SlotTypeValue) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"synonyms": flattenSampleValues(apiObject.Synonyms),
+		}
+
+		if v := apiObject.SampleValue; v != nil {
+			tfMap["sample_value"] = flattenSampleValues([]*lexmodelsv2.SampleValue{v})
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func expandSlotValueSelectionSetting(tfMap map[string]interface{}) *lexmodelsv2.SlotValueSelectionSetting {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lexmodelsv2.SlotValueSelectionSetting{}
+
+	if v, ok := tfMap["advanced_recognition_setting"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AdvancedRecognitionSetting = &lexmodelsv2.AdvancedRecognitionSetting{}
+
+		if v, ok := v[0].(map[string]interface{})["audio_recognition_strategy"].(string); ok && v != "" {
+			apiObject.AdvancedRecognitionSetting.AudioRecognitionStrategy = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["regex_filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RegexFilter = &lexmodelsv2.SlotValueRegexFilter{
+			Pattern: aws.String(v[0].(map[string]interface{})["pattern"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["resolution_strategy"].(string); ok && v != "" {
+		apiObject.ResolutionStrategy = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSlotValueSelectionSetting(apiObject *lexmodelsv2.SlotValueSelectionSetting) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"resolution_strategy": aws.StringValue(apiObject.ResolutionStrategy),
+	}
+
+	if v := apiObject.AdvancedRecognitionSetting; v != nil {
+		tfMap["advanced_recognition_setting"] = []interface{}{map[string]interface{}{
+			"audio_recognition_strategy": aws.StringValue(v.AudioRecognitionStrategy),
+		}}
+	}
+
+	if v := apiObject.RegexFilter; v != nil {
+		tfMap["regex_filter"] = []interface{}{map[string]interface{}{
+			"pattern": aws.StringValue(v.Pattern),
+		}}
+	}
+
+	return tfMap
+}
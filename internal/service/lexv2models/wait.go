@@ -0,0 +1,173 @@
+package lexv2models
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitBotCreated(ctx context.Context, conn *lexmodelsv2.LexModelsV2, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotStatusCreating},
+		Target:  []string{lexmodelsv2.BotStatusAvailable},
+		Refresh: statusBot(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBotDeleted(ctx context.Context, conn *lexmodelsv2.LexModelsV2, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotStatusDeleting},
+		Target:  []string{},
+		Refresh: statusBot(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBotLocaleCreated(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion, localeID string, timeout time.Duration) (*lexmodelsv2.DescribeBotLocaleOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotLocaleStatusCreating},
+		Target:  []string{lexmodelsv2.BotLocaleStatusBuilt, lexmodelsv2.BotLocaleStatusNotBuilt, lexmodelsv2.BotLocaleStatusReadyExpressTesting},
+		Refresh: statusBotLocale(ctx, conn, botID, botVersion, localeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotLocaleOutput); ok {
+		if status := aws.StringValue(output.BotLocaleStatus); status == lexmodelsv2.BotLocaleStatusFailed {
+			tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.FailureReasons), "; ")))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBotLocaleUpdated(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion, localeID string, timeout time.Duration) (*lexmodelsv2.DescribeBotLocaleOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotLocaleStatusProcessing},
+		Target:  []string{lexmodelsv2.BotLocaleStatusBuilt, lexmodelsv2.BotLocaleStatusNotBuilt, lexmodelsv2.BotLocaleStatusReadyExpressTesting},
+		Refresh: statusBotLocale(ctx, conn, botID, botVersion, localeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotLocaleOutput); ok {
+		if status := aws.StringValue(output.BotLocaleStatus); status == lexmodelsv2.BotLocaleStatusFailed {
+			tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.FailureReasons), "; ")))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitBotLocaleBuilt waits for a bot locale build to finish.
+// A locale that reports NotBuilt immediately after BuildBotLocale has not yet started building.
+func waitBotLocaleBuilt(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion, localeID string, timeout time.Duration) (*lexmodelsv2.DescribeBotLocaleOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotLocaleStatusBuilding, lexmodelsv2.BotLocaleStatusNotBuilt, lexmodelsv2.BotLocaleStatusProcessing},
+		Target:  []string{lexmodelsv2.BotLocaleStatusBuilt, lexmodelsv2.BotLocaleStatusReadyExpressTesting},
+		Refresh: statusBotLocale(ctx, conn, botID, botVersion, localeID),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotLocaleOutput); ok {
+		if status := aws.StringValue(output.BotLocaleStatus); status == lexmodelsv2.BotLocaleStatusFailed {
+			tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.FailureReasons), "; ")))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBotLocaleDeleted(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion, localeID string, timeout time.Duration) (*lexmodelsv2.DescribeBotLocaleOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotLocaleStatusDeleting},
+		Target:  []string{},
+		Refresh: statusBotLocale(ctx, conn, botID, botVersion, localeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotLocaleOutput); ok {
+		if status := aws.StringValue(output.BotLocaleStatus); status == lexmodelsv2.BotLocaleStatusFailed {
+			tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.FailureReasons), "; ")))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBotVersionCreated(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion string, timeout time.Duration) (*lexmodelsv2.DescribeBotVersionOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:        []string{lexmodelsv2.BotStatusCreating, lexmodelsv2.BotStatusVersioning},
+		Target:         []string{lexmodelsv2.BotStatusAvailable},
+		Refresh:        statusBotVersion(ctx, conn, botID, botVersion),
+		Timeout:        timeout,
+		NotFoundChecks: 20,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotVersionOutput); ok {
+		if status := aws.StringValue(output.BotStatus); status == lexmodelsv2.BotStatusFailed {
+			tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.FailureReasons), "; ")))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBotVersionDeleted(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion string, timeout time.Duration) (*lexmodelsv2.DescribeBotVersionOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotStatusDeleting},
+		Target:  []string{},
+		Refresh: statusBotVersion(ctx, conn, botID, botVersion),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotVersionOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
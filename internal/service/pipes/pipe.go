@@ -0,0 +1,455 @@
+package pipes
+
+import (
+	"context"
+	"errors"
+	"log"
+	"reflect"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pipes"
+	"github.com/aws/aws-sdk-go-v2/service/pipes/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePipe() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePipeCreate,
+		ReadWithoutTimeout:   resourcePipeRead,
+		UpdateWithoutTimeout: resourcePipeUpdate,
+		DeleteWithoutTimeout: resourcePipeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"desired_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(types.RequestedPipeStateRunning),
+				ValidateFunc: validation.StringInSlice(requestedPipeStateValues(types.RequestedPipeState("").Values()...), false),
+			},
+			"enrichment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"enrichment_parameters": enrichmentParametersSchema(),
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[\.\-_A-Za-z0-9]+$`), ""),
+				),
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64-resource.UniqueIDSuffixLength),
+					validation.StringMatch(regexp.MustCompile(`^[\.\-_A-Za-z0-9]+$`), ""),
+				),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1600),
+			},
+			"source_parameters": sourceParametersSchema(),
+			"tags":              tftags.TagsSchema(),
+			"tags_all":          tftags.TagsSchemaComputed(),
+			"target": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"target_parameters": targetParametersSchema(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePipeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PipesConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	in := &pipes.CreatePipeInput{
+		DesiredState: types.RequestedPipeState(d.Get("desired_state").(string)),
+		Name:         aws.String(name),
+		RoleArn:      aws.String(d.Get("role_arn").(string)),
+		Source:       aws.String(d.Get("source").(string)),
+		Target:       aws.String(d.Get("target").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("enrichment"); ok {
+		in.Enrichment = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("enrichment_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.EnrichmentParameters = expandEnrichmentParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("source_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.SourceParameters = expandSourceParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("target_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.TargetParameters = expandTargetParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.CreatePipe(ctx, in)
+
+	if err != nil {
+		return diag.Errorf("creating EventBridge Pipes Pipe (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitPipeCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for EventBridge Pipes Pipe (%s) create: %s", d.Id(), err)
+	}
+
+	return resourcePipeRead(ctx, d, meta)
+}
+
+func resourcePipeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PipesConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	out, err := FindPipeByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EventBridge Pipes Pipe (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading EventBridge Pipes Pipe (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("description", out.Description)
+	d.Set("desired_state", out.DesiredState)
+	d.Set("enrichment", out.Enrichment)
+
+	// Removed parameters are empty objects.
+	if v := out.EnrichmentParameters; v != nil && !reflect.ValueOf(*v).IsZero() {
+		if err := d.Set("enrichment_parameters", []interface{}{flattenEnrichmentParameters(v)}); err != nil {
+			return diag.Errorf("setting enrichment_parameters: %s", err)
+		}
+	} else {
+		d.Set("enrichment_parameters", nil)
+	}
+
+	d.Set("name", out.Name)
+	d.Set("name_prefix", create.NamePrefixFromName(aws.ToString(out.Name)))
+	d.Set("role_arn", out.RoleArn)
+	d.Set("source", out.Source)
+
+	if v := out.SourceParameters; v != nil {
+		if err := d.Set("source_parameters", []interface{}{flattenSourceParameters(v)}); err != nil {
+			return diag.Errorf("setting source_parameters: %s", err)
+		}
+	} else {
+		d.Set("source_parameters", nil)
+	}
+
+	d.Set("target", out.Target)
+
+	if v := out.TargetParameters; v != nil && !reflect.ValueOf(*v).IsZero() {
+		if err := d.Set("target_parameters", []interface{}{flattenTargetParameters(v)}); err != nil {
+			return diag.Errorf("setting target_parameters: %s", err)
+		}
+	} else {
+		d.Set("target_parameters", nil)
+	}
+
+	tags := KeyValueTags(out.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePipeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PipesConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		in := &pipes.UpdatePipeInput{
+			Description:  aws.String(d.Get("description").(string)),
+			DesiredState: types.RequestedPipeState(d.Get("desired_state").(string)),
+			Enrichment:   aws.String(d.Get("enrichment").(string)),
+			Name:         aws.String(d.Id()),
+			RoleArn:      aws.String(d.Get("role_arn").(string)),
+			Target:       aws.String(d.Get("target").(string)),
+		}
+
+		// UpdatePipe only changes the parameters that are sent, so removed parameters are sent as empty objects.
+		if d.HasChange("enrichment_parameters") {
+			in.EnrichmentParameters = &types.PipeEnrichmentParameters{}
+
+			if v, ok := d.GetOk("enrichment_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				in.EnrichmentParameters = expandEnrichmentParameters(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("source_parameters") {
+			in.SourceParameters = expandUpdateSourceParameters(map[string]interface{}{})
+
+			if v, ok := d.GetOk("source_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				in.SourceParameters = expandUpdateSourceParameters(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("target_parameters") {
+			in.TargetParameters = &types.PipeTargetParameters{}
+
+			if v, ok := d.GetOk("target_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				in.TargetParameters = expandTargetParameters(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		_, err := conn.UpdatePipe(ctx, in)
+
+		if err != nil {
+			return diag.Errorf("updating EventBridge Pipes Pipe (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitPipeUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for EventBridge Pipes Pipe (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating EventBridge Pipes Pipe (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourcePipeRead(ctx, d, meta)
+}
+
+func resourcePipeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PipesConn
+
+	log.Printf("[INFO] Deleting EventBridge Pipes Pipe: %s", d.Id())
+	_, err := conn.DeletePipe(ctx, &pipes.DeletePipeInput{
+		Name: aws.String(d.Id()),
+	})
+
+	var notFoundException *types.NotFoundException
+	if errors.As(err, &notFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting EventBridge Pipes Pipe (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPipeDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for EventBridge Pipes Pipe (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func assignPublicIPValues(input ...types.AssignPublicIp) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
+
+func batchJobDependencyTypeValues(input ...types.BatchJobDependencyType) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
+
+func batchResourceRequirementTypeValues(input ...types.BatchResourceRequirementType) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
+
+func dynamoDBStreamStartPositionValues(input ...types.DynamoDBStreamStartPosition) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
+
+func kinesisStreamStartPositionValues(input ...types.KinesisStreamStartPosition) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
+
+func launchTypeValues(input ...types.LaunchType) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
+
+func mskStartPositionValues(input ...types.MSKStartPosition) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
+
+func onPartialBatchItemFailureStreamsValues(input ...types.OnPartialBatchItemFailureStreams) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
+
+func pipeTargetInvocationTypeValues(input ...types.PipeTargetInvocationType) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
+
+func placementConstraintTypeValues(input ...types.PlacementConstraintType) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
+
+func placementStrategyTypeValues(input ...types.PlacementStrategyType) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
+
+func propagateTagsValues(input ...types.PropagateTags) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
+
+func requestedPipeStateValues(input ...types.RequestedPipeState) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
+
+func selfManagedKafkaStartPositionValues(input ...types.SelfManagedKafkaStartPosition) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
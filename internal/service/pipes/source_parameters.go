@@ -0,0 +1,1175 @@
+package pipes
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pipes/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var sourceParametersBlocks = []string{
+	"source_parameters.0.activemq_broker_parameters",
+	"source_parameters.0.dynamodb_stream_parameters",
+	"source_parameters.0.kinesis_stream_parameters",
+	"source_parameters.0.managed_streaming_kafka_parameters",
+	"source_parameters.0.rabbitmq_broker_parameters",
+	"source_parameters.0.self_managed_kafka_parameters",
+	"source_parameters.0.sqs_queue_parameters",
+}
+
+func sourceParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"activemq_broker_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					ForceNew:      true,
+					MaxItems:      1,
+					ConflictsWith: sourceParametersConflictsWith("source_parameters.0.activemq_broker_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10000),
+							},
+							"credentials": mqBrokerAccessCredentialsSchema(),
+							"maximum_batching_window_in_seconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(0, 300),
+							},
+							"queue_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringLenBetween(1, 1000),
+							},
+						},
+					},
+				},
+				"dynamodb_stream_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					ForceNew:      true,
+					MaxItems:      1,
+					ConflictsWith: sourceParametersConflictsWith("source_parameters.0.dynamodb_stream_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10000),
+							},
+							"dead_letter_config": deadLetterConfigSchema(),
+							"maximum_batching_window_in_seconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(0, 300),
+							},
+							"maximum_record_age_in_seconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(-1, 604800),
+							},
+							"maximum_retry_attempts": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(-1, 10000),
+							},
+							"on_partial_batch_item_failure": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice(onPartialBatchItemFailureStreamsValues(types.OnPartialBatchItemFailureStreams("").Values()...), false),
+							},
+							"parallelization_factor": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10),
+							},
+							"starting_position": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(dynamoDBStreamStartPositionValues(types.DynamoDBStreamStartPosition("").Values()...), false),
+							},
+						},
+					},
+				},
+				"filter_criteria": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"filter": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 5,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"pattern": {
+											Type:     schema.TypeString,
+											Required: true,
+											ValidateFunc: validation.All(
+												validation.StringLenBetween(1, 4096),
+												validation.StringIsJSON,
+											),
+											DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"kinesis_stream_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					ForceNew:      true,
+					MaxItems:      1,
+					ConflictsWith: sourceParametersConflictsWith("source_parameters.0.kinesis_stream_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10000),
+							},
+							"dead_letter_config": deadLetterConfigSchema(),
+							"maximum_batching_window_in_seconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(0, 300),
+							},
+							"maximum_record_age_in_seconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(-1, 604800),
+							},
+							"maximum_retry_attempts": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(-1, 10000),
+							},
+							"on_partial_batch_item_failure": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice(onPartialBatchItemFailureStreamsValues(types.OnPartialBatchItemFailureStreams("").Values()...), false),
+							},
+							"parallelization_factor": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10),
+							},
+							"starting_position": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(kinesisStreamStartPositionValues(types.KinesisStreamStartPosition("").Values()...), false),
+							},
+							"starting_position_timestamp": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsRFC3339Time,
+							},
+						},
+					},
+				},
+				"managed_streaming_kafka_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					ForceNew:      true,
+					MaxItems:      1,
+					ConflictsWith: sourceParametersConflictsWith("source_parameters.0.managed_streaming_kafka_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10000),
+							},
+							"consumer_group_id": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringLenBetween(1, 200),
+							},
+							"credentials": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"client_certificate_tls_auth": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: verify.ValidARN,
+										},
+										"sasl_scram_512_auth": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+								},
+							},
+							"maximum_batching_window_in_seconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(0, 300),
+							},
+							"starting_position": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(mskStartPositionValues(types.MSKStartPosition("").Values()...), false),
+							},
+							"topic_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringLenBetween(1, 249),
+							},
+						},
+					},
+				},
+				"rabbitmq_broker_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					ForceNew:      true,
+					MaxItems:      1,
+					ConflictsWith: sourceParametersConflictsWith("source_parameters.0.rabbitmq_broker_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10000),
+							},
+							"credentials": mqBrokerAccessCredentialsSchema(),
+							"maximum_batching_window_in_seconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(0, 300),
+							},
+							"queue_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringLenBetween(1, 1000),
+							},
+							"virtual_host": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringLenBetween(1, 200),
+							},
+						},
+					},
+				},
+				"self_managed_kafka_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					ForceNew:      true,
+					MaxItems:      1,
+					ConflictsWith: sourceParametersConflictsWith("source_parameters.0.self_managed_kafka_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"additional_bootstrap_servers": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								ForceNew: true,
+								MaxItems: 2,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringLenBetween(1, 300),
+								},
+							},
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10000),
+							},
+							"consumer_group_id": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringLenBetween(1, 200),
+							},
+							"credentials": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"basic_auth": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: verify.ValidARN,
+										},
+										"client_certificate_tls_auth": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: verify.ValidARN,
+										},
+										"sasl_scram_256_auth": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: verify.ValidARN,
+										},
+										"sasl_scram_512_auth": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+								},
+							},
+							"maximum_batching_window_in_seconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(0, 300),
+							},
+							"server_root_ca_certificate": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+							"starting_position": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(selfManagedKafkaStartPositionValues(types.SelfManagedKafkaStartPosition("").Values()...), false),
+							},
+							"topic_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringLenBetween(1, 249),
+							},
+							"vpc": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"security_groups": {
+											Type:     schema.TypeSet,
+											Optional: true,
+											MaxItems: 5,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"subnets": {
+											Type:     schema.TypeSet,
+											Optional: true,
+											MaxItems: 16,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"sqs_queue_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					Computed:      true,
+					MaxItems:      1,
+					ConflictsWith: sourceParametersConflictsWith("source_parameters.0.sqs_queue_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10000),
+							},
+							"maximum_batching_window_in_seconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(0, 300),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// sourceParametersConflictsWith returns the source parameters blocks that conflict with the specified block.
+func sourceParametersConflictsWith(block string) []string {
+	var conflictsWith []string
+
+	for _, v := range sourceParametersBlocks {
+		if v != block {
+			conflictsWith = append(conflictsWith, v)
+		}
+	}
+
+	return conflictsWith
+}
+
+func deadLetterConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func mqBrokerAccessCredentialsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"basic_auth": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func expandSourceParameters(tfMap map[string]interface{}) *types.PipeSourceParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeSourceParameters{}
+
+	if v, ok := tfMap["activemq_broker_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ActiveMQBrokerParameters = expandSourceActiveMQBrokerParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["dynamodb_stream_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DynamoDBStreamParameters = expandSourceDynamoDBStreamParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["filter_criteria"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.FilterCriteria = expandFilterCriteria(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["kinesis_stream_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KinesisStreamParameters = expandSourceKinesisStreamParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["managed_streaming_kafka_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ManagedStreamingKafkaParameters = expandSourceManagedStreamingKafkaParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["rabbitmq_broker_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RabbitMQBrokerParameters = expandSourceRabbitMQBrokerParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["self_managed_kafka_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SelfManagedKafkaParameters = expandSourceSelfManagedKafkaParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["sqs_queue_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SqsQueueParameters = expandSourceSQSQueueParameters(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+// expandUpdateSourceParameters expands the subset of source parameters that can be changed in place.
+func expandUpdateSourceParameters(tfMap map[string]interface{}) *types.UpdatePipeSourceParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.UpdatePipeSourceParameters{}
+
+	if v, ok := tfMap["activemq_broker_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		in := expandSourceActiveMQBrokerParameters(v[0].(map[string]interface{}))
+		apiObject.ActiveMQBrokerParameters = &types.UpdatePipeSourceActiveMQBrokerParameters{
+			BatchSize:                      in.BatchSize,
+			Credentials:                    in.Credentials,
+			MaximumBatchingWindowInSeconds: in.MaximumBatchingWindowInSeconds,
+		}
+	}
+
+	if v, ok := tfMap["dynamodb_stream_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		in := expandSourceDynamoDBStreamParameters(v[0].(map[string]interface{}))
+		apiObject.DynamoDBStreamParameters = &types.UpdatePipeSourceDynamoDBStreamParameters{
+			BatchSize:                      in.BatchSize,
+			DeadLetterConfig:               in.DeadLetterConfig,
+			MaximumBatchingWindowInSeconds: in.MaximumBatchingWindowInSeconds,
+			MaximumRecordAgeInSeconds:      in.MaximumRecordAgeInSeconds,
+			MaximumRetryAttempts:           in.MaximumRetryAttempts,
+			OnPartialBatchItemFailure:      in.OnPartialBatchItemFailure,
+			ParallelizationFactor:          in.ParallelizationFactor,
+		}
+	}
+
+	// An empty filter criteria object removes all filters.
+	apiObject.FilterCriteria = &types.FilterCriteria{}
+
+	if v, ok := tfMap["filter_criteria"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.FilterCriteria = expandFilterCriteria(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["kinesis_stream_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		in := expandSourceKinesisStreamParameters(v[0].(map[string]interface{}))
+		apiObject.KinesisStreamParameters = &types.UpdatePipeSourceKinesisStreamParameters{
+			BatchSize:                      in.BatchSize,
+			DeadLetterConfig:               in.DeadLetterConfig,
+			MaximumBatchingWindowInSeconds: in.MaximumBatchingWindowInSeconds,
+			MaximumRecordAgeInSeconds:      in.MaximumRecordAgeInSeconds,
+			MaximumRetryAttempts:           in.MaximumRetryAttempts,
+			OnPartialBatchItemFailure:      in.OnPartialBatchItemFailure,
+			ParallelizationFactor:          in.ParallelizationFactor,
+		}
+	}
+
+	if v, ok := tfMap["managed_streaming_kafka_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		in := expandSourceManagedStreamingKafkaParameters(v[0].(map[string]interface{}))
+		apiObject.ManagedStreamingKafkaParameters = &types.UpdatePipeSourceManagedStreamingKafkaParameters{
+			BatchSize:                      in.BatchSize,
+			Credentials:                    in.Credentials,
+			MaximumBatchingWindowInSeconds: in.MaximumBatchingWindowInSeconds,
+		}
+	}
+
+	if v, ok := tfMap["rabbitmq_broker_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		in := expandSourceRabbitMQBrokerParameters(v[0].(map[string]interface{}))
+		apiObject.RabbitMQBrokerParameters = &types.UpdatePipeSourceRabbitMQBrokerParameters{
+			BatchSize:                      in.BatchSize,
+			Credentials:                    in.Credentials,
+			MaximumBatchingWindowInSeconds: in.MaximumBatchingWindowInSeconds,
+		}
+	}
+
+	if v, ok := tfMap["self_managed_kafka_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		in := expandSourceSelfManagedKafkaParameters(v[0].(map[string]interface{}))
+		apiObject.SelfManagedKafkaParameters = &types.UpdatePipeSourceSelfManagedKafkaParameters{
+			BatchSize:                      in.BatchSize,
+			Credentials:                    in.Credentials,
+			MaximumBatchingWindowInSeconds: in.MaximumBatchingWindowInSeconds,
+			ServerRootCaCertificate:        in.ServerRootCaCertificate,
+			Vpc:                            in.Vpc,
+		}
+	}
+
+	if v, ok := tfMap["sqs_queue_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		in := expandSourceSQSQueueParameters(v[0].(map[string]interface{}))
+		apiObject.SqsQueueParameters = &types.UpdatePipeSourceSqsQueueParameters{
+			BatchSize:                      in.BatchSize,
+			MaximumBatchingWindowInSeconds: in.MaximumBatchingWindowInSeconds,
+		}
+	}
+
+	return apiObject
+}
+
+func flattenSourceParameters(apiObject *types.PipeSourceParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ActiveMQBrokerParameters; v != nil {
+		tfMap["activemq_broker_parameters"] = []interface{}{flattenSourceActiveMQBrokerParameters(v)}
+	}
+
+	if v := apiObject.DynamoDBStreamParameters; v != nil {
+		tfMap["dynamodb_stream_parameters"] = []interface{}{flattenSourceDynamoDBStreamParameters(v)}
+	}
+
+	if v := apiObject.FilterCriteria; v != nil && len(v.Filters) > 0 {
+		tfMap["filter_criteria"] = []interface{}{flattenFilterCriteria(v)}
+	}
+
+	if v := apiObject.KinesisStreamParameters; v != nil {
+		tfMap["kinesis_stream_parameters"] = []interface{}{flattenSourceKinesisStreamParameters(v)}
+	}
+
+	if v := apiObject.ManagedStreamingKafkaParameters; v != nil {
+		tfMap["managed_streaming_kafka_parameters"] = []interface{}{flattenSourceManagedStreamingKafkaParameters(v)}
+	}
+
+	if v := apiObject.RabbitMQBrokerParameters; v != nil {
+		tfMap["rabbitmq_broker_parameters"] = []interface{}{flattenSourceRabbitMQBrokerParameters(v)}
+	}
+
+	if v := apiObject.SelfManagedKafkaParameters; v != nil {
+		tfMap["self_managed_kafka_parameters"] = []interface{}{flattenSourceSelfManagedKafkaParameters(v)}
+	}
+
+	if v := apiObject.SqsQueueParameters; v != nil {
+		tfMap["sqs_queue_parameters"] = []interface{}{flattenSourceSQSQueueParameters(v)}
+	}
+
+	return tfMap
+}
+
+func expandFilterCriteria(tfMap map[string]interface{}) *types.FilterCriteria {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.FilterCriteria{}
+
+	if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Filters = append(apiObject.Filters, types.Filter{
+				Pattern: aws.String(tfMap["pattern"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenFilterCriteria(apiObject *types.FilterCriteria) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, v := range apiObject.Filters {
+		tfList = append(tfList, map[string]interface{}{
+			"pattern": aws.ToString(v.Pattern),
+		})
+	}
+
+	return map[string]interface{}{
+		"filter": tfList,
+	}
+}
+
+func expandDeadLetterConfig(tfMap map[string]interface{}) *types.DeadLetterConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.DeadLetterConfig{}
+
+	if v, ok := tfMap["arn"].(string); ok && v != "" {
+		apiObject.Arn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDeadLetterConfig(apiObject *types.DeadLetterConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"arn": aws.ToString(apiObject.Arn),
+	}
+}
+
+func expandMQBrokerAccessCredentials(tfMap map[string]interface{}) types.MQBrokerAccessCredentials {
+	if tfMap == nil {
+		return nil
+	}
+
+	if v, ok := tfMap["basic_auth"].(string); ok && v != "" {
+		return &types.MQBrokerAccessCredentialsMemberBasicAuth{Value: v}
+	}
+
+	return nil
+}
+
+func flattenMQBrokerAccessCredentials(apiObject types.MQBrokerAccessCredentials) map[string]interface{} {
+	if v, ok := apiObject.(*types.MQBrokerAccessCredentialsMemberBasicAuth); ok {
+		return map[string]interface{}{
+			"basic_auth": v.Value,
+		}
+	}
+
+	return nil
+}
+
+func expandSourceActiveMQBrokerParameters(tfMap map[string]interface{}) *types.PipeSourceActiveMQBrokerParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeSourceActiveMQBrokerParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["credentials"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Credentials = expandMQBrokerAccessCredentials(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["queue_name"].(string); ok && v != "" {
+		apiObject.QueueName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSourceActiveMQBrokerParameters(apiObject *types.PipeSourceActiveMQBrokerParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"batch_size":                         aws.ToInt32(apiObject.BatchSize),
+		"maximum_batching_window_in_seconds": aws.ToInt32(apiObject.MaximumBatchingWindowInSeconds),
+		"queue_name":                         aws.ToString(apiObject.QueueName),
+	}
+
+	if v := flattenMQBrokerAccessCredentials(apiObject.Credentials); v != nil {
+		tfMap["credentials"] = []interface{}{v}
+	}
+
+	return tfMap
+}
+
+func expandSourceDynamoDBStreamParameters(tfMap map[string]interface{}) *types.PipeSourceDynamoDBStreamParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeSourceDynamoDBStreamParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["dead_letter_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DeadLetterConfig = expandDeadLetterConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["maximum_record_age_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumRecordAgeInSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["maximum_retry_attempts"].(int); ok && v != 0 {
+		apiObject.MaximumRetryAttempts = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["on_partial_batch_item_failure"].(string); ok && v != "" {
+		apiObject.OnPartialBatchItemFailure = types.OnPartialBatchItemFailureStreams(v)
+	}
+
+	if v, ok := tfMap["parallelization_factor"].(int); ok && v != 0 {
+		apiObject.ParallelizationFactor = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["starting_position"].(string); ok && v != "" {
+		apiObject.StartingPosition = types.DynamoDBStreamStartPosition(v)
+	}
+
+	return apiObject
+}
+
+func flattenSourceDynamoDBStreamParameters(apiObject *types.PipeSourceDynamoDBStreamParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"batch_size":                         aws.ToInt32(apiObject.BatchSize),
+		"maximum_batching_window_in_seconds": aws.ToInt32(apiObject.MaximumBatchingWindowInSeconds),
+		"maximum_record_age_in_seconds":      aws.ToInt32(apiObject.MaximumRecordAgeInSeconds),
+		"maximum_retry_attempts":             aws.ToInt32(apiObject.MaximumRetryAttempts),
+		"on_partial_batch_item_failure":      string(apiObject.OnPartialBatchItemFailure),
+		"parallelization_factor":             aws.ToInt32(apiObject.ParallelizationFactor),
+		"starting_position":                  string(apiObject.StartingPosition),
+	}
+
+	if v := apiObject.DeadLetterConfig; v != nil && v.Arn != nil {
+		tfMap["dead_letter_config"] = []interface{}{flattenDeadLetterConfig(v)}
+	}
+
+	return tfMap
+}
+
+func expandSourceKinesisStreamParameters(tfMap map[string]interface{}) *types.PipeSourceKinesisStreamParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeSourceKinesisStreamParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["dead_letter_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DeadLetterConfig = expandDeadLetterConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["maximum_record_age_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumRecordAgeInSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["maximum_retry_attempts"].(int); ok && v != 0 {
+		apiObject.MaximumRetryAttempts = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["on_partial_batch_item_failure"].(string); ok && v != "" {
+		apiObject.OnPartialBatchItemFailure = types.OnPartialBatchItemFailureStreams(v)
+	}
+
+	if v, ok := tfMap["parallelization_factor"].(int); ok && v != 0 {
+		apiObject.ParallelizationFactor = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["starting_position"].(string); ok && v != "" {
+		apiObject.StartingPosition = types.KinesisStreamStartPosition(v)
+	}
+
+	if v, ok := tfMap["starting_position_timestamp"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.StartingPositionTimestamp = aws.Time(v)
+	}
+
+	return apiObject
+}
+
+func flattenSourceKinesisStreamParameters(apiObject *types.PipeSourceKinesisStreamParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"batch_size":                         aws.ToInt32(apiObject.BatchSize),
+		"maximum_batching_window_in_seconds": aws.ToInt32(apiObject.MaximumBatchingWindowInSeconds),
+		"maximum_record_age_in_seconds":      aws.ToInt32(apiObject.MaximumRecordAgeInSeconds),
+		"maximum_retry_attempts":             aws.ToInt32(apiObject.MaximumRetryAttempts),
+		"on_partial_batch_item_failure":      string(apiObject.OnPartialBatchItemFailure),
+		"parallelization_factor":             aws.ToInt32(apiObject.ParallelizationFactor),
+		"starting_position":                  string(apiObject.StartingPosition),
+	}
+
+	if v := apiObject.DeadLetterConfig; v != nil && v.Arn != nil {
+		tfMap["dead_letter_config"] = []interface{}{flattenDeadLetterConfig(v)}
+	}
+
+	if v := apiObject.StartingPositionTimestamp; v != nil {
+		tfMap["starting_position_timestamp"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
+func expandSourceManagedStreamingKafkaParameters(tfMap map[string]interface{}) *types.PipeSourceManagedStreamingKafkaParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeSourceManagedStreamingKafkaParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["consumer_group_id"].(string); ok && v != "" {
+		apiObject.ConsumerGroupID = aws.String(v)
+	}
+
+	if v, ok := tfMap["credentials"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		if v, ok := tfMap["client_certificate_tls_auth"].(string); ok && v != "" {
+			apiObject.Credentials = &types.MSKAccessCredentialsMemberClientCertificateTlsAuth{Value: v}
+		} else if v, ok := tfMap["sasl_scram_512_auth"].(string); ok && v != "" {
+			apiObject.Credentials = &types.MSKAccessCredentialsMemberSaslScram512Auth{Value: v}
+		}
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["starting_position"].(string); ok && v != "" {
+		apiObject.StartingPosition = types.MSKStartPosition(v)
+	}
+
+	if v, ok := tfMap["topic_name"].(string); ok && v != "" {
+		apiObject.TopicName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSourceManagedStreamingKafkaParameters(apiObject *types.PipeSourceManagedStreamingKafkaParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"batch_size":                         aws.ToInt32(apiObject.BatchSize),
+		"consumer_group_id":                  aws.ToString(apiObject.ConsumerGroupID),
+		"maximum_batching_window_in_seconds": aws.ToInt32(apiObject.MaximumBatchingWindowInSeconds),
+		"starting_position":                  string(apiObject.StartingPosition),
+		"topic_name":                         aws.ToString(apiObject.TopicName),
+	}
+
+	switch v := apiObject.Credentials.(type) {
+	case *types.MSKAccessCredentialsMemberClientCertificateTlsAuth:
+		tfMap["credentials"] = []interface{}{map[string]interface{}{
+			"client_certificate_tls_auth": v.Value,
+		}}
+	case *types.MSKAccessCredentialsMemberSaslScram512Auth:
+		tfMap["credentials"] = []interface{}{map[string]interface{}{
+			"sasl_scram_512_auth": v.Value,
+		}}
+	}
+
+	return tfMap
+}
+
+func expandSourceRabbitMQBrokerParameters(tfMap map[string]interface{}) *types.PipeSourceRabbitMQBrokerParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeSourceRabbitMQBrokerParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["credentials"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Credentials = expandMQBrokerAccessCredentials(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["queue_name"].(string); ok && v != "" {
+		apiObject.QueueName = aws.String(v)
+	}
+
+	if v, ok := tfMap["virtual_host"].(string); ok && v != "" {
+		apiObject.VirtualHost = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSourceRabbitMQBrokerParameters(apiObject *types.PipeSourceRabbitMQBrokerParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"batch_size":                         aws.ToInt32(apiObject.BatchSize),
+		"maximum_batching_window_in_seconds": aws.ToInt32(apiObject.MaximumBatchingWindowInSeconds),
+		"queue_name":                         aws.ToString(apiObject.QueueName),
+		"virtual_host":                       aws.ToString(apiObject.VirtualHost),
+	}
+
+	if v := flattenMQBrokerAccessCredentials(apiObject.Credentials); v != nil {
+		tfMap["credentials"] = []interface{}{v}
+	}
+
+	return tfMap
+}
+
+func expandSourceSelfManagedKafkaParameters(tfMap map[string]interface{}) *types.PipeSourceSelfManagedKafkaParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeSourceSelfManagedKafkaParameters{}
+
+	if v, ok := tfMap["additional_bootstrap_servers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AdditionalBootstrapServers = aws.ToStringSlice(flex.ExpandStringSet(v))
+	}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["consumer_group_id"].(string); ok && v != "" {
+		apiObject.ConsumerGroupID = aws.String(v)
+	}
+
+	if v, ok := tfMap["credentials"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		if v, ok := tfMap["basic_auth"].(string); ok && v != "" {
+			apiObject.Credentials = &types.SelfManagedKafkaAccessConfigurationCredentialsMemberBasicAuth{Value: v}
+		} else if v, ok := tfMap["client_certificate_tls_auth"].(string); ok && v != "" {
+			apiObject.Credentials = &types.SelfManagedKafkaAccessConfigurationCredentialsMemberClientCertificateTlsAuth{Value: v}
+		} else if v, ok := tfMap["sasl_scram_256_auth"].(string); ok && v != "" {
+			apiObject.Credentials = &types.SelfManagedKafkaAccessConfigurationCredentialsMemberSaslScram256Auth{Value: v}
+		} else if v, ok := tfMap["sasl_scram_512_auth"].(string); ok && v != "" {
+			apiObject.Credentials = &types.SelfManagedKafkaAccessConfigurationCredentialsMemberSaslScram512Auth{Value: v}
+		}
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["server_root_ca_certificate"].(string); ok && v != "" {
+		apiObject.ServerRootCaCertificate = aws.String(v)
+	}
+
+	if v, ok := tfMap["starting_position"].(string); ok && v != "" {
+		apiObject.StartingPosition = types.SelfManagedKafkaStartPosition(v)
+	}
+
+	if v, ok := tfMap["topic_name"].(string); ok && v != "" {
+		apiObject.TopicName = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpc"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		vpc := &types.SelfManagedKafkaAccessConfigurationVpc{}
+
+		if v, ok := tfMap["security_groups"].(*schema.Set); ok && v.Len() > 0 {
+			vpc.SecurityGroup = aws.ToStringSlice(flex.ExpandStringSet(v))
+		}
+
+		if v, ok := tfMap["subnets"].(*schema.Set); ok && v.Len() > 0 {
+			vpc.Subnets = aws.ToStringSlice(flex.ExpandStringSet(v))
+		}
+
+		apiObject.Vpc = vpc
+	}
+
+	return apiObject
+}
+
+func flattenSourceSelfManagedKafkaParameters(apiObject *types.PipeSourceSelfManagedKafkaParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"additional_bootstrap_servers":       flex.FlattenStringSet(aws.StringSlice(apiObject.AdditionalBootstrapServers)),
+		"batch_size":                         aws.ToInt32(apiObject.BatchSize),
+		"consumer_group_id":                  aws.ToString(apiObject.ConsumerGroupID),
+		"maximum_batching_window_in_seconds": aws.ToInt32(apiObject.MaximumBatchingWindowInSeconds),
+		"server_root_ca_certificate":         aws.ToString(apiObject.ServerRootCaCertificate),
+		"starting_position":                  string(apiObject.StartingPosition),
+		"topic_name":                         aws.ToString(apiObject.TopicName),
+	}
+
+	switch v := apiObject.Credentials.(type) {
+	case *types.SelfManagedKafkaAccessConfigurationCredentialsMemberBasicAuth:
+		tfMap["credentials"] = []interface{}{map[string]interface{}{
+			"basic_auth": v.Value,
+		}}
+	case *types.SelfManagedKafkaAccessConfigurationCredentialsMemberClientCertificateTlsAuth:
+		tfMap["credentials"] = []interface{}{map[string]interface{}{
+			"client_certificate_tls_auth": v.Value,
+		}}
+	case *types.SelfManagedKafkaAccessConfigurationCredentialsMemberSaslScram256Auth:
+		tfMap["credentials"] = []interface{}{map[string]interface{}{
+			"sasl_scram_256_auth": v.Value,
+		}}
+	case *types.SelfManagedKafkaAccessConfigurationCredentialsMemberSaslScram512Auth:
+		tfMap["credentials"] = []interface{}{map[string]interface{}{
+			"sasl_scram_512_auth": v.Value,
+		}}
+	}
+
+	if v := apiObject.Vpc; v != nil {
+		tfMap["vpc"] = []interface{}{map[string]interface{}{
+			"security_groups": flex.FlattenStringSet(aws.StringSlice(v.SecurityGroup)),
+			"subnets":         flex.FlattenStringSet(aws.StringSlice(v.Subnets)),
+		}}
+	}
+
+	return tfMap
+}
+
+func expandSourceSQSQueueParameters(tfMap map[string]interface{}) *types.PipeSourceSqsQueueParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeSourceSqsQueueParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenSourceSQSQueueParameters(apiObject *types.PipeSourceSqsQueueParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"batch_size":                         aws.ToInt32(apiObject.BatchSize),
+		"maximum_batching_window_in_seconds": aws.ToInt32(apiObject.MaximumBatchingWindowInSeconds),
+	}
+}
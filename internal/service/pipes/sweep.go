@@ -0,0 +1,68 @@
+//go:build sweep
+// +build sweep
+
+package pipes
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pipes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_pipes_pipe", &resource.Sweeper{
+		Name: "aws_pipes_pipe",
+		F:    sweepPipes,
+	})
+}
+
+func sweepPipes(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).PipesConn
+	input := &pipes.ListPipesInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	for {
+		output, err := conn.ListPipes(context.Background(), input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping EventBridge Pipes Pipe sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing EventBridge Pipes Pipes (%s): %w", region, err)
+		}
+
+		for _, v := range output.Pipes {
+			r := ResourcePipe()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EventBridge Pipes Pipes (%s): %w", region, err)
+	}
+
+	return nil
+}
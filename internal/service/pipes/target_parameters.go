@@ -0,0 +1,1143 @@
+package pipes
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pipes/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func targetParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"batch_job_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"array_properties": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"size": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntBetween(2, 10000),
+										},
+									},
+								},
+							},
+							"container_overrides": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"command": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"environment": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"name": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+													"value": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+												},
+											},
+										},
+										"instance_type": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"resource_requirement": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"type": {
+														Type:         schema.TypeString,
+														Required:     true,
+														ValidateFunc: validation.StringInSlice(batchResourceRequirementTypeValues(types.BatchResourceRequirementType("").Values()...), false),
+													},
+													"value": {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"depends_on": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 20,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"job_id": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"type": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice(batchJobDependencyTypeValues(types.BatchJobDependencyType("").Values()...), false),
+										},
+									},
+								},
+							},
+							"job_definition": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"job_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+							"parameters": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"retry_strategy": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"attempts": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntBetween(1, 10),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"cloudwatch_logs_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"log_stream_name": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+							"timestamp": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+						},
+					},
+				},
+				"ecs_task_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"capacity_provider_strategy": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 6,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"base": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntBetween(0, 100000),
+										},
+										"capacity_provider": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 255),
+										},
+										"weight": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntBetween(0, 1000),
+										},
+									},
+								},
+							},
+							"enable_ecs_managed_tags": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"enable_execute_command": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"group": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 255),
+							},
+							"launch_type": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice(launchTypeValues(types.LaunchType("").Values()...), false),
+							},
+							"network_configuration": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"assign_public_ip": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice(assignPublicIPValues(types.AssignPublicIp("").Values()...), false),
+										},
+										"security_groups": {
+											Type:     schema.TypeSet,
+											Optional: true,
+											MaxItems: 5,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"subnets": {
+											Type:     schema.TypeSet,
+											Optional: true,
+											MaxItems: 16,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+									},
+								},
+							},
+							"placement_constraint": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 10,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"expression": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringLenBetween(1, 2000),
+										},
+										"type": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice(placementConstraintTypeValues(types.PlacementConstraintType("").Values()...), false),
+										},
+									},
+								},
+							},
+							"placement_strategy": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 5,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"field": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringLenBetween(1, 255),
+										},
+										"type": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice(placementStrategyTypeValues(types.PlacementStrategyType("").Values()...), false),
+										},
+									},
+								},
+							},
+							"platform_version": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"propagate_tags": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice(propagateTagsValues(types.PropagateTags("").Values()...), false),
+							},
+							"reference_id": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 1024),
+							},
+							"tags": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"task_count": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      1,
+								ValidateFunc: validation.IntBetween(1, 10),
+							},
+							"task_definition_arn": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+				"eventbridge_event_bus_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"detail_type": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+							"endpoint_id": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 50),
+							},
+							"resources": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								MaxItems: 10,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: verify.ValidARN,
+								},
+							},
+							"source": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+							"time": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+						},
+					},
+				},
+				"http_parameters": httpParametersSchema(),
+				"input_template": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 8192),
+				},
+				"kinesis_stream_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"partition_key": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(0, 256),
+							},
+						},
+					},
+				},
+				"lambda_function_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"invocation_type": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(pipeTargetInvocationTypeValues(types.PipeTargetInvocationType("").Values()...), false),
+							},
+						},
+					},
+				},
+				"redshift_data_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"database": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 64),
+							},
+							"db_user": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+							"secret_manager_arn": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+							"sqls": {
+								Type:     schema.TypeList,
+								Required: true,
+								MinItems: 1,
+								MaxItems: 40,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringLenBetween(1, 100000),
+								},
+							},
+							"statement_name": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 500),
+							},
+							"with_event": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+						},
+					},
+				},
+				"sagemaker_pipeline_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"pipeline_parameter": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 200,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"name": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 256),
+										},
+										"value": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 1024),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"sqs_queue_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"message_deduplication_id": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(0, 100),
+							},
+							"message_group_id": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(0, 100),
+							},
+						},
+					},
+				},
+				"step_function_state_machine_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"invocation_type": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(pipeTargetInvocationTypeValues(types.PipeTargetInvocationType("").Values()...), false),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func httpParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"header_parameters": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"path_parameter_values": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"query_string_parameters": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func expandTargetParameters(tfMap map[string]interface{}) *types.PipeTargetParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeTargetParameters{}
+
+	if v, ok := tfMap["batch_job_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.BatchJobParameters = expandTargetBatchJobParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["cloudwatch_logs_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.CloudWatchLogsParameters = &types.PipeTargetCloudWatchLogsParameters{}
+
+		if v, ok := tfMap["log_stream_name"].(string); ok && v != "" {
+			apiObject.CloudWatchLogsParameters.LogStreamName = aws.String(v)
+		}
+
+		if v, ok := tfMap["timestamp"].(string); ok && v != "" {
+			apiObject.CloudWatchLogsParameters.Timestamp = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["ecs_task_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EcsTaskParameters = expandTargetECSTaskParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["eventbridge_event_bus_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EventBridgeEventBusParameters = expandTargetEventBridgeEventBusParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["http_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		in := expandEnrichmentHTTPParameters(v[0].(map[string]interface{}))
+		apiObject.HttpParameters = &types.PipeTargetHttpParameters{
+			HeaderParameters:      in.HeaderParameters,
+			PathParameterValues:   in.PathParameterValues,
+			QueryStringParameters: in.QueryStringParameters,
+		}
+	}
+
+	if v, ok := tfMap["input_template"].(string); ok && v != "" {
+		apiObject.InputTemplate = aws.String(v)
+	}
+
+	if v, ok := tfMap["kinesis_stream_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.KinesisStreamParameters = &types.PipeTargetKinesisStreamParameters{
+			PartitionKey: aws.String(tfMap["partition_key"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["lambda_function_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.LambdaFunctionParameters = &types.PipeTargetLambdaFunctionParameters{
+			InvocationType: types.PipeTargetInvocationType(tfMap["invocation_type"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["redshift_data_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RedshiftDataParameters = expandTargetRedshiftDataParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["sagemaker_pipeline_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.SageMakerPipelineParameters = &types.PipeTargetSageMakerPipelineParameters{}
+
+		if v, ok := tfMap["pipeline_parameter"].([]interface{}); ok {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				apiObject.SageMakerPipelineParameters.PipelineParameterList = append(apiObject.SageMakerPipelineParameters.PipelineParameterList, types.SageMakerPipelineParameter{
+					Name:  aws.String(tfMap["name"].(string)),
+					Value: aws.String(tfMap["value"].(string)),
+				})
+			}
+		}
+	}
+
+	if v, ok := tfMap["sqs_queue_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.SqsQueueParameters = &types.PipeTargetSqsQueueParameters{}
+
+		if v, ok := tfMap["message_deduplication_id"].(string); ok && v != "" {
+			apiObject.SqsQueueParameters.MessageDeduplicationId = aws.String(v)
+		}
+
+		if v, ok := tfMap["message_group_id"].(string); ok && v != "" {
+			apiObject.SqsQueueParameters.MessageGroupId = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["step_function_state_machine_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.StepFunctionStateMachineParameters = &types.PipeTargetStateMachineParameters{
+			InvocationType: types.PipeTargetInvocationType(tfMap["invocation_type"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenTargetParameters(apiObject *types.PipeTargetParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"input_template": aws.ToString(apiObject.InputTemplate),
+	}
+
+	if v := apiObject.BatchJobParameters; v != nil {
+		tfMap["batch_job_parameters"] = []interface{}{flattenTargetBatchJobParameters(v)}
+	}
+
+	if v := apiObject.CloudWatchLogsParameters; v != nil {
+		tfMap["cloudwatch_logs_parameters"] = []interface{}{map[string]interface{}{
+			"log_stream_name": aws.ToString(v.LogStreamName),
+			"timestamp":       aws.ToString(v.Timestamp),
+		}}
+	}
+
+	if v := apiObject.EcsTaskParameters; v != nil {
+		tfMap["ecs_task_parameters"] = []interface{}{flattenTargetECSTaskParameters(v)}
+	}
+
+	if v := apiObject.EventBridgeEventBusParameters; v != nil {
+		tfMap["eventbridge_event_bus_parameters"] = []interface{}{map[string]interface{}{
+			"detail_type": aws.ToString(v.DetailType),
+			"endpoint_id": aws.ToString(v.EndpointId),
+			"resources":   flex.FlattenStringSet(aws.StringSlice(v.Resources)),
+			"source":      aws.ToString(v.Source),
+			"time":        aws.ToString(v.Time),
+		}}
+	}
+
+	if v := apiObject.HttpParameters; v != nil {
+		tfMap["http_parameters"] = []interface{}{flattenEnrichmentHTTPParameters(&types.PipeEnrichmentHttpParameters{
+			HeaderParameters:      v.HeaderParameters,
+			PathParameterValues:   v.PathParameterValues,
+			QueryStringParameters: v.QueryStringParameters,
+		})}
+	}
+
+	if v := apiObject.KinesisStreamParameters; v != nil {
+		tfMap["kinesis_stream_parameters"] = []interface{}{map[string]interface{}{
+			"partition_key": aws.ToString(v.PartitionKey),
+		}}
+	}
+
+	if v := apiObject.LambdaFunctionParameters; v != nil {
+		tfMap["lambda_function_parameters"] = []interface{}{map[string]interface{}{
+			"invocation_type": string(v.InvocationType),
+		}}
+	}
+
+	if v := apiObject.RedshiftDataParameters; v != nil {
+		tfMap["redshift_data_parameters"] = []interface{}{map[string]interface{}{
+			"database":           aws.ToString(v.Database),
+			"db_user":            aws.ToString(v.DbUser),
+			"secret_manager_arn": aws.ToString(v.SecretManagerArn),
+			"sqls":               v.Sqls,
+			"statement_name":     aws.ToString(v.StatementName),
+			"with_event":         v.WithEvent,
+		}}
+	}
+
+	if v := apiObject.SageMakerPipelineParameters; v != nil {
+		var tfList []interface{}
+
+		for _, v := range v.PipelineParameterList {
+			tfList = append(tfList, map[string]interface{}{
+				"name":  aws.ToString(v.Name),
+				"value": aws.ToString(v.Value),
+			})
+		}
+
+		tfMap["sagemaker_pipeline_parameters"] = []interface{}{map[string]interface{}{
+			"pipeline_parameter": tfList,
+		}}
+	}
+
+	if v := apiObject.SqsQueueParameters; v != nil {
+		tfMap["sqs_queue_parameters"] = []interface{}{map[string]interface{}{
+			"message_deduplication_id": aws.ToString(v.MessageDeduplicationId),
+			"message_group_id":         aws.ToString(v.MessageGroupId),
+		}}
+	}
+
+	if v := apiObject.StepFunctionStateMachineParameters; v != nil {
+		tfMap["step_function_state_machine_parameters"] = []interface{}{map[string]interface{}{
+			"invocation_type": string(v.InvocationType),
+		}}
+	}
+
+	return tfMap
+}
+
+func expandTargetBatchJobParameters(tfMap map[string]interface{}) *types.PipeTargetBatchJobParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeTargetBatchJobParameters{
+		JobDefinition: aws.String(tfMap["job_definition"].(string)),
+		JobName:       aws.String(tfMap["job_name"].(string)),
+	}
+
+	if v, ok := tfMap["array_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.ArrayProperties = &types.BatchArrayProperties{}
+
+		if v, ok := tfMap["size"].(int); ok && v != 0 {
+			apiObject.ArrayProperties.Size = int32(v)
+		}
+	}
+
+	if v, ok := tfMap["container_overrides"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		overrides := &types.BatchContainerOverrides{}
+
+		if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+			overrides.Command = aws.ToStringSlice(flex.ExpandStringList(v))
+		}
+
+		if v, ok := tfMap["environment"].([]interface{}); ok {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				overrides.Environment = append(overrides.Environment, types.BatchEnvironmentVariable{
+					Name:  aws.String(tfMap["name"].(string)),
+					Value: aws.String(tfMap["value"].(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["instance_type"].(string); ok && v != "" {
+			overrides.InstanceType = aws.String(v)
+		}
+
+		if v, ok := tfMap["resource_requirement"].([]interface{}); ok {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				overrides.ResourceRequirements = append(overrides.ResourceRequirements, types.BatchResourceRequirement{
+					Type:  types.BatchResourceRequirementType(tfMap["type"].(string)),
+					Value: aws.String(tfMap["value"].(string)),
+				})
+			}
+		}
+
+		apiObject.ContainerOverrides = overrides
+	}
+
+	if v, ok := tfMap["depends_on"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			dependency := types.BatchJobDependency{}
+
+			if v, ok := tfMap["job_id"].(string); ok && v != "" {
+				dependency.JobId = aws.String(v)
+			}
+
+			if v, ok := tfMap["type"].(string); ok && v != "" {
+				dependency.Type = types.BatchJobDependencyType(v)
+			}
+
+			apiObject.DependsOn = append(apiObject.DependsOn, dependency)
+		}
+	}
+
+	if v, ok := tfMap["parameters"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Parameters = aws.ToStringMap(flex.ExpandStringMap(v))
+	}
+
+	if v, ok := tfMap["retry_strategy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.RetryStrategy = &types.BatchRetryStrategy{}
+
+		if v, ok := tfMap["attempts"].(int); ok && v != 0 {
+			apiObject.RetryStrategy.Attempts = int32(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenTargetBatchJobParameters(apiObject *types.PipeTargetBatchJobParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"job_definition": aws.ToString(apiObject.JobDefinition),
+		"job_name":       aws.ToString(apiObject.JobName),
+		"parameters":     apiObject.Parameters,
+	}
+
+	if v := apiObject.ArrayProperties; v != nil {
+		tfMap["array_properties"] = []interface{}{map[string]interface{}{
+			"size": v.Size,
+		}}
+	}
+
+	if v := apiObject.ContainerOverrides; v != nil {
+		var environment, resourceRequirements []interface{}
+
+		for _, v := range v.Environment {
+			environment = append(environment, map[string]interface{}{
+				"name":  aws.ToString(v.Name),
+				"value": aws.ToString(v.Value),
+			})
+		}
+
+		for _, v := range v.ResourceRequirements {
+			resourceRequirements = append(resourceRequirements, map[string]interface{}{
+				"type":  string(v.Type),
+				"value": aws.ToString(v.Value),
+			})
+		}
+
+		tfMap["container_overrides"] = []interface{}{map[string]interface{}{
+			"command":              v.Command,
+			"environment":          environment,
+			"instance_type":        aws.ToString(v.InstanceType),
+			"resource_requirement": resourceRequirements,
+		}}
+	}
+
+	if v := apiObject.DependsOn; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, v := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"job_id": aws.ToString(v.JobId),
+				"type":   string(v.Type),
+			})
+		}
+
+		tfMap["depends_on"] = tfList
+	}
+
+	if v := apiObject.RetryStrategy; v != nil {
+		tfMap["retry_strategy"] = []interface{}{map[string]interface{}{
+			"attempts": v.Attempts,
+		}}
+	}
+
+	return tfMap
+}
+
+func expandTargetECSTaskParameters(tfMap map[string]interface{}) *types.PipeTargetEcsTaskParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeTargetEcsTaskParameters{
+		EnableECSManagedTags: tfMap["enable_ecs_managed_tags"].(bool),
+		EnableExecuteCommand: tfMap["enable_execute_command"].(bool),
+		TaskDefinitionArn:    aws.String(tfMap["task_definition_arn"].(string)),
+	}
+
+	if v, ok := tfMap["capacity_provider_strategy"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.CapacityProviderStrategy = append(apiObject.CapacityProviderStrategy, types.CapacityProviderStrategyItem{
+				Base:             int32(tfMap["base"].(int)),
+				CapacityProvider: aws.String(tfMap["capacity_provider"].(string)),
+				Weight:           int32(tfMap["weight"].(int)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["group"].(string); ok && v != "" {
+		apiObject.Group = aws.String(v)
+	}
+
+	if v, ok := tfMap["launch_type"].(string); ok && v != "" {
+		apiObject.LaunchType = types.LaunchType(v)
+	}
+
+	if v, ok := tfMap["network_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		vpc := &types.AwsVpcConfiguration{}
+
+		if v, ok := tfMap["assign_public_ip"].(string); ok && v != "" {
+			vpc.AssignPublicIp = types.AssignPublicIp(v)
+		}
+
+		if v, ok := tfMap["security_groups"].(*schema.Set); ok && v.Len() > 0 {
+			vpc.SecurityGroups = aws.ToStringSlice(flex.ExpandStringSet(v))
+		}
+
+		if v, ok := tfMap["subnets"].(*schema.Set); ok && v.Len() > 0 {
+			vpc.Subnets = aws.ToStringSlice(flex.ExpandStringSet(v))
+		}
+
+		apiObject.NetworkConfiguration = &types.NetworkConfiguration{
+			AwsvpcConfiguration: vpc,
+		}
+	}
+
+	if v, ok := tfMap["placement_constraint"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			constraint := types.PlacementConstraint{}
+
+			if v, ok := tfMap["expression"].(string); ok && v != "" {
+				constraint.Expression = aws.String(v)
+			}
+
+			if v, ok := tfMap["type"].(string); ok && v != "" {
+				constraint.Type = types.PlacementConstraintType(v)
+			}
+
+			apiObject.PlacementConstraints = append(apiObject.PlacementConstraints, constraint)
+		}
+	}
+
+	if v, ok := tfMap["placement_strategy"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			strategy := types.PlacementStrategy{}
+
+			if v, ok := tfMap["field"].(string); ok && v != "" {
+				strategy.Field = aws.String(v)
+			}
+
+			if v, ok := tfMap["type"].(string); ok && v != "" {
+				strategy.Type = types.PlacementStrategyType(v)
+			}
+
+			apiObject.PlacementStrategy = append(apiObject.PlacementStrategy, strategy)
+		}
+	}
+
+	if v, ok := tfMap["platform_version"].(string); ok && v != "" {
+		apiObject.PlatformVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["propagate_tags"].(string); ok && v != "" {
+		apiObject.PropagateTags = types.PropagateTags(v)
+	}
+
+	if v, ok := tfMap["reference_id"].(string); ok && v != "" {
+		apiObject.ReferenceId = aws.String(v)
+	}
+
+	if v, ok := tfMap["tags"].(map[string]interface{}); ok {
+		for k, v := range aws.ToStringMap(flex.ExpandStringMap(v)) {
+			apiObject.Tags = append(apiObject.Tags, types.Tag{
+				Key:   aws.String(k),
+				Value: aws.String(v),
+			})
+		}
+	}
+
+	if v, ok := tfMap["task_count"].(int); ok && v != 0 {
+		apiObject.TaskCount = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenTargetECSTaskParameters(apiObject *types.PipeTargetEcsTaskParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enable_ecs_managed_tags": apiObject.EnableECSManagedTags,
+		"enable_execute_command":  apiObject.EnableExecuteCommand,
+		"group":                   aws.ToString(apiObject.Group),
+		"launch_type":             string(apiObject.LaunchType),
+		"platform_version":        aws.ToString(apiObject.PlatformVersion),
+		"propagate_tags":          string(apiObject.PropagateTags),
+		"reference_id":            aws.ToString(apiObject.ReferenceId),
+		"task_count":              aws.ToInt32(apiObject.TaskCount),
+		"task_definition_arn":     aws.ToString(apiObject.TaskDefinitionArn),
+	}
+
+	if v := apiObject.CapacityProviderStrategy; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, v := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"base":              v.Base,
+				"capacity_provider": aws.ToString(v.CapacityProvider),
+				"weight":            v.Weight,
+			})
+		}
+
+		tfMap["capacity_provider_strategy"] = tfList
+	}
+
+	if v := apiObject.NetworkConfiguration; v != nil && v.AwsvpcConfiguration != nil {
+		tfMap["network_configuration"] = []interface{}{map[string]interface{}{
+			"assign_public_ip": string(v.AwsvpcConfiguration.AssignPublicIp),
+			"security_groups":  flex.FlattenStringSet(aws.StringSlice(v.AwsvpcConfiguration.SecurityGroups)),
+			"subnets":          flex.FlattenStringSet(aws.StringSlice(v.AwsvpcConfiguration.Subnets)),
+		}}
+	}
+
+	if v := apiObject.PlacementConstraints; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, v := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"expression": aws.ToString(v.Expression),
+				"type":       string(v.Type),
+			})
+		}
+
+		tfMap["placement_constraint"] = tfList
+	}
+
+	if v := apiObject.PlacementStrategy; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, v := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"field": aws.ToString(v.Field),
+				"type":  string(v.Type),
+			})
+		}
+
+		tfMap["placement_strategy"] = tfList
+	}
+
+	if v := apiObject.Tags; len(v) > 0 {
+		tags := make(map[string]interface{}, len(v))
+
+		for _, v := range v {
+			tags[aws.ToString(v.Key)] = aws.ToString(v.Value)
+		}
+
+		tfMap["tags"] = tags
+	}
+
+	return tfMap
+}
+
+func expandTargetEventBridgeEventBusParameters(tfMap map[string]interface{}) *types.PipeTargetEventBridgeEventBusParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeTargetEventBridgeEventBusParameters{}
+
+	if v, ok := tfMap["detail_type"].(string); ok && v != "" {
+		apiObject.DetailType = aws.String(v)
+	}
+
+	if v, ok := tfMap["endpoint_id"].(string); ok && v != "" {
+		apiObject.EndpointId = aws.String(v)
+	}
+
+	if v, ok := tfMap["resources"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Resources = aws.ToStringSlice(flex.ExpandStringSet(v))
+	}
+
+	if v, ok := tfMap["source"].(string); ok && v != "" {
+		apiObject.Source = aws.String(v)
+	}
+
+	if v, ok := tfMap["time"].(string); ok && v != "" {
+		apiObject.Time = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTargetRedshiftDataParameters(tfMap map[string]interface{}) *types.PipeTargetRedshiftDataParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeTargetRedshiftDataParameters{
+		Database:  aws.String(tfMap["database"].(string)),
+		WithEvent: tfMap["with_event"].(bool),
+	}
+
+	if v, ok := tfMap["db_user"].(string); ok && v != "" {
+		apiObject.DbUser = aws.String(v)
+	}
+
+	if v, ok := tfMap["secret_manager_arn"].(string); ok && v != "" {
+		apiObject.SecretManagerArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["sqls"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Sqls = aws.ToStringSlice(flex.ExpandStringList(v))
+	}
+
+	if v, ok := tfMap["statement_name"].(string); ok && v != "" {
+		apiObject.StatementName = aws.String(v)
+	}
+
+	return apiObject
+}
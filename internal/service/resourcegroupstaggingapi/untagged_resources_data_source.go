@@ -0,0 +1,361 @@
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+const (
+	UntaggedResourcesSourceCloudControl = "CLOUD_CONTROL"
+	UntaggedResourcesSourceConfig       = "CONFIG"
+
+	// Maximum number of ARNs in a GetResources ResourceARNList.
+	getResourcesMaxARNs = 100
+)
+
+func UntaggedResourcesSource_Values() []string {
+	return []string{
+		UntaggedResourcesSourceCloudControl,
+		UntaggedResourcesSourceConfig,
+	}
+}
+
+func DataSourceUntaggedResources() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceUntaggedResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}$`), "must be three alphanumeric sections separated by double colons (::)"),
+				},
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"missing_tag_keys": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tftags.TagsSchemaComputed(),
+					},
+				},
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      UntaggedResourcesSourceConfig,
+				ValidateFunc: validation.StringInSlice(UntaggedResourcesSource_Values(), false),
+			},
+			"tag_keys": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// untaggedResource is a resource listed by AWS Config or Cloud Control.
+type untaggedResource struct {
+	arn          string
+	identifier   string
+	resourceType string
+	tags         map[string]string
+}
+
+func dataSourceUntaggedResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient)
+
+	var tagKeys []string
+
+	if v, ok := d.GetOk("tag_keys"); ok && v.(*schema.Set).Len() > 0 {
+		tagKeys = aws.StringValueSlice(flex.ExpandStringSet(v.(*schema.Set)))
+	} else if client.DefaultTagsConfig != nil {
+		tagKeys = client.DefaultTagsConfig.Tags.Keys()
+	}
+
+	if len(tagKeys) == 0 {
+		return diag.Errorf(`"tag_keys" must be configured when the provider "default_tags" configuration block has no tags`)
+	}
+
+	sort.Strings(tagKeys)
+
+	resourceTypes := aws.StringValueSlice(flex.ExpandStringSet(d.Get("resource_types").(*schema.Set)))
+	sort.Strings(resourceTypes)
+
+	var resources []*untaggedResource
+	var err error
+
+	switch source := d.Get("source").(string); source {
+	case UntaggedResourcesSourceCloudControl:
+		resources, err = listCloudControlResources(ctx, client.CloudControlConn, resourceTypes)
+	default:
+		resources, err = listConfigResources(ctx, client.ConfigServiceConn, resourceTypes)
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := findResourcesTags(ctx, client.ResourceGroupsTaggingAPIConn, resources); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var tfList []interface{}
+
+	for _, resource := range resources {
+		var missingTagKeys []string
+
+		for _, key := range tagKeys {
+			if _, ok := resource.tags[key]; !ok {
+				missingTagKeys = append(missingTagKeys, key)
+			}
+		}
+
+		if len(missingTagKeys) == 0 {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"arn":              resource.arn,
+			"identifier":       resource.identifier,
+			"missing_tag_keys": missingTagKeys,
+			"resource_type":    resource.resourceType,
+			"tags":             resource.tags,
+		})
+	}
+
+	d.SetId(client.Region)
+
+	if err := d.Set("resources", tfList); err != nil {
+		return diag.Errorf("error setting resources: %s", err)
+	}
+
+	if err := d.Set("tag_keys", tagKeys); err != nil {
+		return diag.Errorf("error setting tag_keys: %s", err)
+	}
+
+	return nil
+}
+
+// listConfigResources lists the resources of the specified types recorded by AWS Config,
+// using an advanced query.
+func listConfigResources(ctx context.Context, conn *configservice.ConfigService, resourceTypes []string) ([]*untaggedResource, error) {
+	quoted := make([]string, len(resourceTypes))
+
+	for i, resourceType := range resourceTypes {
+		quoted[i] = fmt.Sprintf("'%s'", resourceType)
+	}
+
+	input := &configservice.SelectResourceConfigInput{
+		Expression: aws.String(fmt.Sprintf("SELECT resourceId, resourceType, arn, tags WHERE resourceType IN (%s)", strings.Join(quoted, ", "))),
+	}
+
+	var resources []*untaggedResource
+	var decodeErr error
+
+	err := conn.SelectResourceConfigPagesWithContext(ctx, input, func(page *configservice.SelectResourceConfigOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Results {
+			var result struct {
+				ARN          string `json:"arn"`
+				ResourceID   string `json:"resourceId"`
+				ResourceType string `json:"resourceType"`
+				Tags         []struct {
+					Key   string `json:"key"`
+					Value string `json:"value"`
+				} `json:"tags"`
+			}
+
+			if err := json.Unmarshal([]byte(aws.StringValue(v)), &result); err != nil {
+				decodeErr = fmt.Errorf("error decoding AWS Config query result: %w", err)
+				return false
+			}
+
+			resource := &untaggedResource{
+				arn:          result.ARN,
+				identifier:   result.ResourceID,
+				resourceType: result.ResourceType,
+				tags:         make(map[string]string),
+			}
+
+			for _, tag := range result.Tags {
+				resource.tags[tag.Key] = tag.Value
+			}
+
+			resources = append(resources, resource)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error querying AWS Config resources: %w", err)
+	}
+
+	if decodeErr != nil {
+		return nil, decodeErr
+	}
+
+	return resources, nil
+}
+
+// listCloudControlResources lists the resources of the specified types using Cloud Control.
+// Resource ARNs and tags are only available for resource types whose listed properties include them.
+func listCloudControlResources(ctx context.Context, conn *cloudcontrolapi.CloudControlApi, resourceTypes []string) ([]*untaggedResource, error) {
+	var resources []*untaggedResource
+
+	for _, resourceType := range resourceTypes {
+		input := &cloudcontrolapi.ListResourcesInput{
+			TypeName: aws.String(resourceType),
+		}
+
+		var decodeErr error
+
+		err := conn.ListResourcesPagesWithContext(ctx, input, func(page *cloudcontrolapi.ListResourcesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.ResourceDescriptions {
+				if v == nil {
+					continue
+				}
+
+				resource := &untaggedResource{
+					identifier:   aws.StringValue(v.Identifier),
+					resourceType: resourceType,
+					tags:         make(map[string]string),
+				}
+
+				if v.Properties != nil {
+					var properties struct {
+						ARN  string `json:"Arn"`
+						Tags []struct {
+							Key   string `json:"Key"`
+							Value string `json:"Value"`
+						} `json:"Tags"`
+					}
+
+					if err := json.Unmarshal([]byte(aws.StringValue(v.Properties)), &properties); err != nil {
+						decodeErr = fmt.Errorf("error decoding Cloud Control resource (%s) properties: %w", resource.identifier, err)
+						return false
+					}
+
+					resource.arn = properties.ARN
+
+					for _, tag := range properties.Tags {
+						resource.tags[tag.Key] = tag.Value
+					}
+				}
+
+				resources = append(resources, resource)
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("error listing Cloud Control resources (%s): %w", resourceType, err)
+		}
+
+		if decodeErr != nil {
+			return nil, decodeErr
+		}
+	}
+
+	return resources, nil
+}
+
+// findResourcesTags replaces the tags of resources with ARNs by their current tags from the Resource Groups Tagging API.
+// Resources that have never been tagged are not returned by the Resource Groups Tagging API and keep their listed tags.
+func findResourcesTags(ctx context.Context, conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, resources []*untaggedResource) error {
+	resourcesByARN := make(map[string]*untaggedResource)
+	var arns []string
+
+	for _, resource := range resources {
+		if resource.arn == "" {
+			continue
+		}
+
+		if _, ok := resourcesByARN[resource.arn]; !ok {
+			arns = append(arns, resource.arn)
+		}
+
+		resourcesByARN[resource.arn] = resource
+	}
+
+	for len(arns) > 0 {
+		n := len(arns)
+
+		if n > getResourcesMaxARNs {
+			n = getResourcesMaxARNs
+		}
+
+		input := &resourcegroupstaggingapi.GetResourcesInput{
+			ResourceARNList: aws.StringSlice(arns[:n]),
+		}
+
+		err := conn.GetResourcesPagesWithContext(ctx, input, func(page *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.ResourceTagMappingList {
+				if resource, ok := resourcesByARN[aws.StringValue(v.ResourceARN)]; ok {
+					resource.tags = KeyValueTags(v.Tags).Map()
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return fmt.Errorf("error getting Resource Groups Tags API Resources: %w", err)
+		}
+
+		arns = arns[n:]
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package resourcegroupstaggingapi_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccResourceGroupsTaggingAPIUntaggedResourcesDataSource_cloudControl(t *testing.T) {
+	dataSourceName := "data.aws_resourcegroupstaggingapi_untagged_resources.test"
+	resourceName := "aws_vpc.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, resourcegroupstaggingapi.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUntaggedResourcesDataSourceConfig_cloudControl(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tag_keys.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "resources.*.identifier", resourceName, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "resources.*", map[string]string{
+						"resource_type":      "AWS::EC2::VPC",
+						"missing_tag_keys.#": "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccUntaggedResourcesDataSourceConfig_cloudControl(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_resourcegroupstaggingapi_untagged_resources" "test" {
+  resource_types = ["AWS::EC2::VPC"]
+  source         = "CLOUD_CONTROL"
+  tag_keys       = [%[1]q]
+
+  depends_on = [aws_vpc.test]
+}
+`, rName)
+}
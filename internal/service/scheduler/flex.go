@@ -0,0 +1,609 @@
+package scheduler
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func expandFlexibleTimeWindow(tfMap map[string]interface{}) *types.FlexibleTimeWindow {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.FlexibleTimeWindow{}
+
+	if v, ok := tfMap["maximum_window_in_minutes"].(int); ok && v != 0 {
+		apiObject.MaximumWindowInMinutes = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["mode"].(string); ok && v != "" {
+		apiObject.Mode = types.FlexibleTimeWindowMode(v)
+	}
+
+	return apiObject
+}
+
+func flattenFlexibleTimeWindow(apiObject *types.FlexibleTimeWindow) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"mode": string(apiObject.Mode),
+	}
+
+	if v := apiObject.MaximumWindowInMinutes; v != nil {
+		tfMap["maximum_window_in_minutes"] = aws.ToInt32(v)
+	}
+
+	return tfMap
+}
+
+func expandTarget(tfMap map[string]interface{}) *types.Target {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.Target{}
+
+	if v, ok := tfMap["arn"].(string); ok && v != "" {
+		apiObject.Arn = aws.String(v)
+	}
+
+	if v, ok := tfMap["dead_letter_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DeadLetterConfig = expandDeadLetterConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["ecs_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EcsParameters = expandECSParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["eventbridge_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EventBridgeParameters = expandEventBridgeParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["input"].(string); ok && v != "" {
+		apiObject.Input = aws.String(v)
+	}
+
+	if v, ok := tfMap["kinesis_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KinesisParameters = expandKinesisParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["retry_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RetryPolicy = expandRetryPolicy(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["sagemaker_pipeline_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SageMakerPipelineParameters = expandSageMakerPipelineParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["sqs_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SqsParameters = expandSQSParameters(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func flattenTarget(apiObject *types.Target) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"arn":      aws.ToString(apiObject.Arn),
+		"input":    aws.ToString(apiObject.Input),
+		"role_arn": aws.ToString(apiObject.RoleArn),
+	}
+
+	if v := apiObject.DeadLetterConfig; v != nil {
+		tfMap["dead_letter_config"] = []interface{}{flattenDeadLetterConfig(v)}
+	}
+
+	if v := apiObject.EcsParameters; v != nil {
+		tfMap["ecs_parameters"] = []interface{}{flattenECSParameters(v)}
+	}
+
+	if v := apiObject.EventBridgeParameters; v != nil {
+		tfMap["eventbridge_parameters"] = []interface{}{flattenEventBridgeParameters(v)}
+	}
+
+	if v := apiObject.KinesisParameters; v != nil {
+		tfMap["kinesis_parameters"] = []interface{}{flattenKinesisParameters(v)}
+	}
+
+	if v := apiObject.RetryPolicy; v != nil {
+		tfMap["retry_policy"] = []interface{}{flattenRetryPolicy(v)}
+	}
+
+	if v := apiObject.SageMakerPipelineParameters; v != nil {
+		tfMap["sagemaker_pipeline_parameters"] = []interface{}{flattenSageMakerPipelineParameters(v)}
+	}
+
+	if v := apiObject.SqsParameters; v != nil {
+		tfMap["sqs_parameters"] = []interface{}{flattenSQSParameters(v)}
+	}
+
+	return tfMap
+}
+
+func expandDeadLetterConfig(tfMap map[string]interface{}) *types.DeadLetterConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.DeadLetterConfig{}
+
+	if v, ok := tfMap["arn"].(string); ok && v != "" {
+		apiObject.Arn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDeadLetterConfig(apiObject *types.DeadLetterConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"arn": aws.ToString(apiObject.Arn),
+	}
+}
+
+func expandECSParameters(tfMap map[string]interface{}) *types.EcsParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.EcsParameters{}
+
+	if v, ok := tfMap["capacity_provider_strategy"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CapacityProviderStrategy = expandCapacityProviderStrategyItems(v.List())
+	}
+
+	if v, ok := tfMap["enable_ecs_managed_tags"].(bool); ok {
+		apiObject.EnableECSManagedTags = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["enable_execute_command"].(bool); ok {
+		apiObject.EnableExecuteCommand = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["group"].(string); ok && v != "" {
+		apiObject.Group = aws.String(v)
+	}
+
+	if v, ok := tfMap["launch_type"].(string); ok && v != "" {
+		apiObject.LaunchType = types.LaunchType(v)
+	}
+
+	if v, ok := tfMap["network_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NetworkConfiguration = expandNetworkConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["placement_constraint"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.PlacementConstraints = expandPlacementConstraints(v.List())
+	}
+
+	if v, ok := tfMap["placement_strategy"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.PlacementStrategy = expandPlacementStrategies(v.List())
+	}
+
+	if v, ok := tfMap["platform_version"].(string); ok && v != "" {
+		apiObject.PlatformVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["propagate_tags"].(string); ok && v != "" {
+		apiObject.PropagateTags = types.PropagateTags(v)
+	}
+
+	if v, ok := tfMap["reference_id"].(string); ok && v != "" {
+		apiObject.ReferenceId = aws.String(v)
+	}
+
+	// Each ECS task tag is sent as a single-entry map of the form {"key": ..., "value": ...}.
+	if v, ok := tfMap["tags"].(map[string]interface{}); ok && len(v) > 0 {
+		for k, v := range tftags.New(v).IgnoreAWS().Map() {
+			apiObject.Tags = append(apiObject.Tags, map[string]string{
+				"key":   k,
+				"value": v,
+			})
+		}
+	}
+
+	if v, ok := tfMap["task_count"].(int); ok && v != 0 {
+		apiObject.TaskCount = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["task_definition_arn"].(string); ok && v != "" {
+		apiObject.TaskDefinitionArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenECSParameters(apiObject *types.EcsParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enable_ecs_managed_tags": aws.ToBool(apiObject.EnableECSManagedTags),
+		"enable_execute_command":  aws.ToBool(apiObject.EnableExecuteCommand),
+		"group":                   aws.ToString(apiObject.Group),
+		"launch_type":             string(apiObject.LaunchType),
+		"platform_version":        aws.ToString(apiObject.PlatformVersion),
+		"propagate_tags":          string(apiObject.PropagateTags),
+		"reference_id":            aws.ToString(apiObject.ReferenceId),
+		"task_count":              aws.ToInt32(apiObject.TaskCount),
+		"task_definition_arn":     aws.ToString(apiObject.TaskDefinitionArn),
+	}
+
+	if v := apiObject.CapacityProviderStrategy; len(v) > 0 {
+		tfMap["capacity_provider_strategy"] = flattenCapacityProviderStrategyItems(v)
+	}
+
+	if v := apiObject.NetworkConfiguration; v != nil {
+		tfMap["network_configuration"] = []interface{}{flattenNetworkConfiguration(v)}
+	}
+
+	if v := apiObject.PlacementConstraints; len(v) > 0 {
+		tfMap["placement_constraint"] = flattenPlacementConstraints(v)
+	}
+
+	if v := apiObject.PlacementStrategy; len(v) > 0 {
+		tfMap["placement_strategy"] = flattenPlacementStrategies(v)
+	}
+
+	if v := apiObject.Tags; len(v) > 0 {
+		tags := make(map[string]interface{}, len(v))
+
+		for _, tag := range v {
+			tags[tag["key"]] = tag["value"]
+		}
+
+		tfMap["tags"] = tags
+	}
+
+	return tfMap
+}
+
+func expandCapacityProviderStrategyItems(tfList []interface{}) []types.CapacityProviderStrategyItem {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.CapacityProviderStrategyItem
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.CapacityProviderStrategyItem{}
+
+		if v, ok := tfMap["base"].(int); ok {
+			apiObject.Base = int32(v)
+		}
+
+		if v, ok := tfMap["capacity_provider"].(string); ok && v != "" {
+			apiObject.CapacityProvider = aws.String(v)
+		}
+
+		if v, ok := tfMap["weight"].(int); ok {
+			apiObject.Weight = int32(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenCapacityProviderStrategyItems(apiObjects []types.CapacityProviderStrategyItem) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"base":              apiObject.Base,
+			"capacity_provider": aws.ToString(apiObject.CapacityProvider),
+			"weight":            apiObject.Weight,
+		})
+	}
+
+	return tfList
+}
+
+func expandNetworkConfiguration(tfMap map[string]interface{}) *types.NetworkConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.AwsVpcConfiguration{
+		AssignPublicIp: types.AssignPublicIpDisabled,
+	}
+
+	if v, ok := tfMap["assign_public_ip"].(bool); ok && v {
+		apiObject.AssignPublicIp = types.AssignPublicIpEnabled
+	}
+
+	if v, ok := tfMap["security_groups"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityGroups = aws.ToStringSlice(flex.ExpandStringSet(v))
+	}
+
+	if v, ok := tfMap["subnets"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Subnets = aws.ToStringSlice(flex.ExpandStringSet(v))
+	}
+
+	return &types.NetworkConfiguration{AwsvpcConfiguration: apiObject}
+}
+
+func flattenNetworkConfiguration(apiObject *types.NetworkConfiguration) map[string]interface{} {
+	if apiObject == nil || apiObject.AwsvpcConfiguration == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"assign_public_ip": apiObject.AwsvpcConfiguration.AssignPublicIp == types.AssignPublicIpEnabled,
+		"security_groups":  flex.FlattenStringSet(aws.StringSlice(apiObject.AwsvpcConfiguration.SecurityGroups)),
+		"subnets":          flex.FlattenStringSet(aws.StringSlice(apiObject.AwsvpcConfiguration.Subnets)),
+	}
+}
+
+func expandPlacementConstraints(tfList []interface{}) []types.PlacementConstraint {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.PlacementConstraint
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.PlacementConstraint{}
+
+		if v, ok := tfMap["expression"].(string); ok && v != "" {
+			apiObject.Expression = aws.String(v)
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = types.PlacementConstraintType(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenPlacementConstraints(apiObjects []types.PlacementConstraint) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"expression": aws.ToString(apiObject.Expression),
+			"type":       string(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func expandPlacementStrategies(tfList []interface{}) []types.PlacementStrategy {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.PlacementStrategy
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.PlacementStrategy{}
+
+		if v, ok := tfMap["field"].(string); ok && v != "" {
+			apiObject.Field = aws.String(v)
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = types.PlacementStrategyType(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenPlacementStrategies(apiObjects []types.PlacementStrategy) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"field": aws.ToString(apiObject.Field),
+			"type":  string(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func expandEventBridgeParameters(tfMap map[string]interface{}) *types.EventBridgeParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.EventBridgeParameters{}
+
+	if v, ok := tfMap["detail_type"].(string); ok && v != "" {
+		apiObject.DetailType = aws.String(v)
+	}
+
+	if v, ok := tfMap["source"].(string); ok && v != "" {
+		apiObject.Source = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenEventBridgeParameters(apiObject *types.EventBridgeParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"detail_type": aws.ToString(apiObject.DetailType),
+		"source":      aws.ToString(apiObject.Source),
+	}
+}
+
+func expandKinesisParameters(tfMap map[string]interface{}) *types.KinesisParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.KinesisParameters{}
+
+	if v, ok := tfMap["partition_key"].(string); ok && v != "" {
+		apiObject.PartitionKey = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenKinesisParameters(apiObject *types.KinesisParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"partition_key": aws.ToString(apiObject.PartitionKey),
+	}
+}
+
+func expandRetryPolicy(tfMap map[string]interface{}) *types.RetryPolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.RetryPolicy{}
+
+	if v, ok := tfMap["maximum_event_age_in_seconds"].(int); ok {
+		apiObject.MaximumEventAgeInSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["maximum_retry_attempts"].(int); ok {
+		apiObject.MaximumRetryAttempts = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenRetryPolicy(apiObject *types.RetryPolicy) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"maximum_event_age_in_seconds": aws.ToInt32(apiObject.MaximumEventAgeInSeconds),
+		"maximum_retry_attempts":       aws.ToInt32(apiObject.MaximumRetryAttempts),
+	}
+}
+
+func expandSageMakerPipelineParameters(tfMap map[string]interface{}) *types.SageMakerPipelineParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.SageMakerPipelineParameters{}
+
+	if v, ok := tfMap["pipeline_parameter"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.PipelineParameterList = append(apiObject.PipelineParameterList, types.SageMakerPipelineParameter{
+				Name:  aws.String(tfMap["name"].(string)),
+				Value: aws.String(tfMap["value"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenSageMakerPipelineParameters(apiObject *types.SageMakerPipelineParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, v := range apiObject.PipelineParameterList {
+		tfList = append(tfList, map[string]interface{}{
+			"name":  aws.ToString(v.Name),
+			"value": aws.ToString(v.Value),
+		})
+	}
+
+	return map[string]interface{}{
+		"pipeline_parameter": tfList,
+	}
+}
+
+func expandSQSParameters(tfMap map[string]interface{}) *types.SqsParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.SqsParameters{}
+
+	if v, ok := tfMap["message_group_id"].(string); ok && v != "" {
+		apiObject.MessageGroupId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSQSParameters(apiObject *types.SqsParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"message_group_id": aws.ToString(apiObject.MessageGroupId),
+	}
+}
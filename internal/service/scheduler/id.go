@@ -0,0 +1,25 @@
+package scheduler
+
+import (
+	"fmt"
+	"strings"
+)
+
+const scheduleResourceIDSeparator = "/"
+
+func ScheduleCreateResourceID(groupName, name string) string {
+	parts := []string{groupName, name}
+	id := strings.Join(parts, scheduleResourceIDSeparator)
+
+	return id
+}
+
+func ScheduleParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, scheduleResourceIDSeparator)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("please make sure ID is in format GROUP_NAME%[1]sSCHEDULE_NAME", scheduleResourceIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
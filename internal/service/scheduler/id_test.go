@@ -0,0 +1,67 @@
+package scheduler_test
+
+import (
+	"testing"
+
+	tfscheduler "github.com/hashicorp/terraform-provider-aws/internal/service/scheduler"
+)
+
+func TestScheduleParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName          string
+		Input             string
+		ExpectedGroupName string
+		ExpectedName      string
+		Error             bool
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Error:    true,
+		},
+		{
+			TestName: "Invalid ID",
+			Input:    "default/",
+			Error:    true,
+		},
+		{
+			TestName: "Invalid ID separator",
+			Input:    "default|my-schedule",
+			Error:    true,
+		},
+		{
+			TestName: "Invalid ID with more than 1 separator",
+			Input:    "default/my-schedule/extra",
+			Error:    true,
+		},
+		{
+			TestName:          "Valid ID",
+			Input:             "default/my-schedule",
+			ExpectedGroupName: "default",
+			ExpectedName:      "my-schedule",
+			Error:             false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotGroupName, gotName, err := tfscheduler.ScheduleParseResourceID(testCase.Input)
+
+			if err != nil && !testCase.Error {
+				t.Errorf("got error (%s), expected no error", err)
+			}
+
+			if err == nil && testCase.Error {
+				t.Errorf("got (GroupName: %s, Name: %s) and no error, expected error", gotGroupName, gotName)
+			}
+
+			if gotGroupName != testCase.ExpectedGroupName {
+				t.Errorf("got %s, expected %s", gotGroupName, testCase.ExpectedGroupName)
+			}
+
+			if gotName != testCase.ExpectedName {
+				t.Errorf("got %s, expected %s", gotName, testCase.ExpectedName)
+			}
+		})
+	}
+}
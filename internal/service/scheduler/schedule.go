@@ -0,0 +1,684 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	propagationTimeout = 2 * time.Minute
+
+	scheduleDefaultGroupName                  = "default"
+	scheduleDefaultScheduleExpressionTimezone = "UTC"
+
+	// validationExceptionMessage describes the error returned when the execution role has not yet propagated
+	validationExceptionMessage = "The execution role you provide must allow AWS EventBridge Scheduler to assume the role."
+)
+
+func ResourceSchedule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceScheduleCreate,
+		ReadWithoutTimeout:   resourceScheduleRead,
+		UpdateWithoutTimeout: resourceScheduleUpdate,
+		DeleteWithoutTimeout: resourceScheduleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"end_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"flexible_time_window": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"maximum_window_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 1440),
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(flexibleTimeWindowModeValues(types.FlexibleTimeWindowMode("").Values()...), false),
+						},
+					},
+				},
+			},
+			"group_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      scheduleDefaultGroupName,
+				ValidateFunc: validName,
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validName,
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validation.StringLenBetween(1, 64-resource.UniqueIDSuffixLength),
+			},
+			"schedule_expression": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validScheduleExpression,
+			},
+			"schedule_expression_timezone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  scheduleDefaultScheduleExpressionTimezone,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 50),
+					validTimezone,
+				),
+			},
+			"start_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(types.ScheduleStateEnabled),
+				ValidateFunc: validation.StringInSlice(scheduleStateValues(types.ScheduleState("").Values()...), false),
+			},
+			"target": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"dead_letter_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"ecs_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"capacity_provider_strategy": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 6,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"base": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 100000),
+												},
+												"capacity_provider": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+												"weight": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 1000),
+												},
+											},
+										},
+									},
+									"enable_ecs_managed_tags": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"enable_execute_command": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"group": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"launch_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(launchTypeValues(types.LaunchType("").Values()...), false),
+									},
+									"network_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"assign_public_ip": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  false,
+												},
+												"security_groups": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													MaxItems: 5,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"subnets": {
+													Type:     schema.TypeSet,
+													Required: true,
+													MaxItems: 16,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"placement_constraint": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 10,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"expression": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(0, 2000),
+												},
+												"type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(placementConstraintTypeValues(types.PlacementConstraintType("").Values()...), false),
+												},
+											},
+										},
+									},
+									"placement_strategy": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 5,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"field": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(0, 255),
+												},
+												"type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(placementStrategyTypeValues(types.PlacementStrategyType("").Values()...), false),
+												},
+											},
+										},
+									},
+									"platform_version": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+									"propagate_tags": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(propagateTagsValues(types.PropagateTags("").Values()...), false),
+									},
+									"reference_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 1024),
+									},
+									"tags": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"task_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      1,
+										ValidateFunc: validation.IntBetween(1, 10),
+									},
+									"task_definition_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"eventbridge_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"detail_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+									"source": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+								},
+							},
+						},
+						"input": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 8192),
+						},
+						"kinesis_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"partition_key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+								},
+							},
+						},
+						"retry_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"maximum_event_age_in_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      86400,
+										ValidateFunc: validation.IntBetween(60, 86400),
+									},
+									"maximum_retry_attempts": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      185,
+										ValidateFunc: validation.IntBetween(0, 185),
+									},
+								},
+							},
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"sagemaker_pipeline_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"pipeline_parameter": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 200,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 256),
+												},
+												"value": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1024),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"sqs_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"message_group_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SchedulerConn
+
+	groupName := d.Get("group_name").(string)
+	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	in := &scheduler.CreateScheduleInput{
+		GroupName:                  aws.String(groupName),
+		Name:                       aws.String(name),
+		ScheduleExpression:         aws.String(d.Get("schedule_expression").(string)),
+		ScheduleExpressionTimezone: aws.String(d.Get("schedule_expression_timezone").(string)),
+		State:                      types.ScheduleState(d.Get("state").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("end_date"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		in.EndDate = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("flexible_time_window"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.FlexibleTimeWindow = expandFlexibleTimeWindow(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		in.KmsKeyArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("start_date"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		in.StartDate = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("target"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.Target = expandTarget(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	id := ScheduleCreateResourceID(groupName, name)
+
+	_, err := tfresource.RetryWhen(
+		propagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateSchedule(ctx, in)
+		},
+		retryableRoleNotPropagated,
+	)
+
+	if err != nil {
+		return diag.Errorf("creating EventBridge Scheduler Schedule (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceScheduleRead(ctx, d, meta)
+}
+
+func resourceScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SchedulerConn
+
+	groupName, name, err := ScheduleParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	out, err := FindScheduleByTwoPartKey(ctx, conn, groupName, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EventBridge Scheduler Schedule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading EventBridge Scheduler Schedule (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("description", out.Description)
+
+	if out.EndDate != nil {
+		d.Set("end_date", aws.ToTime(out.EndDate).Format(time.RFC3339))
+	} else {
+		d.Set("end_date", nil)
+	}
+
+	if err := d.Set("flexible_time_window", []interface{}{flattenFlexibleTimeWindow(out.FlexibleTimeWindow)}); err != nil {
+		return diag.Errorf("setting flexible_time_window: %s", err)
+	}
+
+	d.Set("group_name", out.GroupName)
+	d.Set("kms_key_arn", out.KmsKeyArn)
+	d.Set("name", out.Name)
+	d.Set("name_prefix", create.NamePrefixFromName(aws.ToString(out.Name)))
+	d.Set("schedule_expression", out.ScheduleExpression)
+	d.Set("schedule_expression_timezone", out.ScheduleExpressionTimezone)
+
+	if out.StartDate != nil {
+		d.Set("start_date", aws.ToTime(out.StartDate).Format(time.RFC3339))
+	} else {
+		d.Set("start_date", nil)
+	}
+
+	d.Set("state", out.State)
+
+	if err := d.Set("target", []interface{}{flattenTarget(out.Target)}); err != nil {
+		return diag.Errorf("setting target: %s", err)
+	}
+
+	return nil
+}
+
+func resourceScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SchedulerConn
+
+	groupName, name, err := ScheduleParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// UpdateSchedule replaces the schedule's configuration, so every argument is sent.
+	in := &scheduler.UpdateScheduleInput{
+		GroupName:                  aws.String(groupName),
+		Name:                       aws.String(name),
+		ScheduleExpression:         aws.String(d.Get("schedule_expression").(string)),
+		ScheduleExpressionTimezone: aws.String(d.Get("schedule_expression_timezone").(string)),
+		State:                      types.ScheduleState(d.Get("state").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("end_date"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		in.EndDate = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("flexible_time_window"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.FlexibleTimeWindow = expandFlexibleTimeWindow(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		in.KmsKeyArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("start_date"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		in.StartDate = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("target"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.Target = expandTarget(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err = tfresource.RetryWhen(
+		propagationTimeout,
+		func() (interface{}, error) {
+			return conn.UpdateSchedule(ctx, in)
+		},
+		retryableRoleNotPropagated,
+	)
+
+	if err != nil {
+		return diag.Errorf("updating EventBridge Scheduler Schedule (%s): %s", d.Id(), err)
+	}
+
+	return resourceScheduleRead(ctx, d, meta)
+}
+
+func resourceScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SchedulerConn
+
+	groupName, name, err := ScheduleParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting EventBridge Scheduler Schedule: %s", d.Id())
+	_, err = conn.DeleteSchedule(ctx, &scheduler.DeleteScheduleInput{
+		GroupName: aws.String(groupName),
+		Name:      aws.String(name),
+	})
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting EventBridge Scheduler Schedule (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func retryableRoleNotPropagated(err error) (bool, error) {
+	var validationException *types.ValidationException
+
+	if errors.As(err, &validationException) && strings.Contains(validationException.ErrorMessage(), validationExceptionMessage) {
+		return true, err
+	}
+
+	return false, err
+}
+
+func flexibleTimeWindowModeValues(input ...types.FlexibleTimeWindowMode) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
+
+func launchTypeValues(input ...types.LaunchType) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
+
+func placementConstraintTypeValues(input ...types.PlacementConstraintType) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
+
+func placementStrategyTypeValues(input ...types.PlacementStrategyType) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
+
+func propagateTagsValues(input ...types.PropagateTags) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
+
+func scheduleStateValues(input ...types.ScheduleState) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
@@ -0,0 +1,627 @@
+package scheduler_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfscheduler "github.com/hashicorp/terraform-provider-aws/internal/service/scheduler"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSchedulerSchedule_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, names.SchedulerEndpointID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "scheduler", fmt.Sprintf("schedule/default/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "end_date", ""),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.0.mode", "OFF"),
+					resource.TestCheckResourceAttr(resourceName, "group_name", "default"),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("default/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "kms_key_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "schedule_expression", "rate(1 hour)"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_expression_timezone", "UTC"),
+					resource.TestCheckResourceAttr(resourceName, "start_date", ""),
+					resource.TestCheckResourceAttr(resourceName, "state", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "target.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.arn", "aws_sqs_queue.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.0.maximum_event_age_in_seconds", "86400"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.0.maximum_retry_attempts", "185"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, names.SchedulerEndpointID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfscheduler.ResourceSchedule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_groupName(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, names.SchedulerEndpointID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_groupName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "scheduler", fmt.Sprintf("schedule/%[1]s/%[1]s", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "group_name", "aws_scheduler_schedule_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%[1]s/%[1]s", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_scheduleExpression(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, names.SchedulerEndpointID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_scheduleExpression(rName, "cron(0 9 ? * MON-FRI *)", "Europe/Amsterdam"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schedule_expression", "cron(0 9 ? * MON-FRI *)"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_expression_timezone", "Europe/Amsterdam"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduleConfig_scheduleExpression(rName, "at(2099-11-20T13:00:00)", "America/New_York"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schedule_expression", "at(2099-11-20T13:00:00)"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_expression_timezone", "America/New_York"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_flexibleTimeWindow(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, names.SchedulerEndpointID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_flexibleTimeWindow(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.0.mode", "FLEXIBLE"),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.0.maximum_window_in_minutes", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduleConfig_flexibleTimeWindow(rName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.0.mode", "FLEXIBLE"),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.0.maximum_window_in_minutes", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_state(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, names.SchedulerEndpointID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_state(rName, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", "DISABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduleConfig_state(rName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_targetDeadLetterConfigAndRetryPolicy(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, names.SchedulerEndpointID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_targetDeadLetterConfigAndRetryPolicy(rName, 3600, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target.0.dead_letter_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.dead_letter_config.0.arn", "aws_sqs_queue.dlq", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.0.maximum_event_age_in_seconds", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.0.maximum_retry_attempts", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduleConfig_targetDeadLetterConfigAndRetryPolicy(rName, 60, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.0.maximum_event_age_in_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.0.maximum_retry_attempts", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_targetSQSParameters(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, names.SchedulerEndpointID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_targetSQSParameters(rName, "group1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target.0.input", `{"key":"value"}`),
+					resource.TestCheckResourceAttr(resourceName, "target.0.sqs_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.sqs_parameters.0.message_group_id", "group1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduleConfig_targetSQSParameters(rName, "group2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target.0.sqs_parameters.0.message_group_id", "group2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_targetKinesisParameters(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, names.SchedulerEndpointID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_targetKinesisParameters(rName, "key1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.arn", "aws_kinesis_stream.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.kinesis_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.kinesis_parameters.0.partition_key", "key1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduleConfig_targetKinesisParameters(rName, "key2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target.0.kinesis_parameters.0.partition_key", "key2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckScheduleDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SchedulerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_scheduler_schedule" {
+			continue
+		}
+
+		groupName, name, err := tfscheduler.ScheduleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfscheduler.FindScheduleByTwoPartKey(context.TODO(), conn, groupName, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EventBridge Scheduler Schedule %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckScheduleExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EventBridge Scheduler Schedule ID is set")
+		}
+
+		groupName, name, err := tfscheduler.ScheduleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SchedulerConn
+
+		_, err = tfscheduler.FindScheduleByTwoPartKey(context.TODO(), conn, groupName, name)
+
+		return err
+	}
+}
+
+func testAccScheduleBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "scheduler.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["sqs:SendMessage", "kinesis:PutRecord"]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccScheduleSQSQueueBaseConfig(rName string) string {
+	return acctest.ConfigCompose(testAccScheduleBaseConfig(rName), fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+`, rName))
+}
+
+func testAccScheduleConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccScheduleSQSQueueBaseConfig(rName), fmt.Sprintf(`
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccScheduleConfig_groupName(rName string) string {
+	return acctest.ConfigCompose(testAccScheduleSQSQueueBaseConfig(rName), fmt.Sprintf(`
+resource "aws_scheduler_schedule_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_scheduler_schedule" "test" {
+  name       = %[1]q
+  group_name = aws_scheduler_schedule_group.test.name
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccScheduleConfig_scheduleExpression(rName, expression, timezone string) string {
+	return acctest.ConfigCompose(testAccScheduleSQSQueueBaseConfig(rName), fmt.Sprintf(`
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression          = %[2]q
+  schedule_expression_timezone = %[3]q
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, expression, timezone))
+}
+
+func testAccScheduleConfig_flexibleTimeWindow(rName string, maximumWindowInMinutes int) string {
+	return acctest.ConfigCompose(testAccScheduleSQSQueueBaseConfig(rName), fmt.Sprintf(`
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    mode                      = "FLEXIBLE"
+    maximum_window_in_minutes = %[2]d
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, maximumWindowInMinutes))
+}
+
+func testAccScheduleConfig_state(rName, state string) string {
+	return acctest.ConfigCompose(testAccScheduleSQSQueueBaseConfig(rName), fmt.Sprintf(`
+resource "aws_scheduler_schedule" "test" {
+  name  = %[1]q
+  state = %[2]q
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, state))
+}
+
+func testAccScheduleConfig_targetDeadLetterConfigAndRetryPolicy(rName string, maximumEventAgeInSeconds, maximumRetryAttempts int) string {
+	return acctest.ConfigCompose(testAccScheduleSQSQueueBaseConfig(rName), fmt.Sprintf(`
+resource "aws_sqs_queue" "dlq" {
+  name = "%[1]s-dlq"
+}
+
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+
+    dead_letter_config {
+      arn = aws_sqs_queue.dlq.arn
+    }
+
+    retry_policy {
+      maximum_event_age_in_seconds = %[2]d
+      maximum_retry_attempts       = %[3]d
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, maximumEventAgeInSeconds, maximumRetryAttempts))
+}
+
+func testAccScheduleConfig_targetSQSParameters(rName, messageGroupID string) string {
+	return acctest.ConfigCompose(testAccScheduleBaseConfig(rName), fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name                        = "%[1]s.fifo"
+  fifo_queue                  = true
+  content_based_deduplication = true
+}
+
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+    input    = jsonencode({ key = "value" })
+
+    sqs_parameters {
+      message_group_id = %[2]q
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, messageGroupID))
+}
+
+func testAccScheduleConfig_targetKinesisParameters(rName, partitionKey string) string {
+	return acctest.ConfigCompose(testAccScheduleBaseConfig(rName), fmt.Sprintf(`
+resource "aws_kinesis_stream" "test" {
+  name        = %[1]q
+  shard_count = 1
+}
+
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_kinesis_stream.test.arn
+    role_arn = aws_iam_role.test.arn
+
+    kinesis_parameters {
+      partition_key = %[2]q
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, partitionKey))
+}
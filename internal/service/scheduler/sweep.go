@@ -0,0 +1,128 @@
+//go:build sweep
+// +build sweep
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_scheduler_schedule", &resource.Sweeper{
+		Name: "aws_scheduler_schedule",
+		F:    sweepSchedules,
+	})
+
+	resource.AddTestSweepers("aws_scheduler_schedule_group", &resource.Sweeper{
+		Name: "aws_scheduler_schedule_group",
+		F:    sweepScheduleGroups,
+		Dependencies: []string{
+			"aws_scheduler_schedule",
+		},
+	})
+}
+
+func sweepSchedules(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).SchedulerConn
+	input := &scheduler.ListSchedulesInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	for {
+		output, err := conn.ListSchedules(context.Background(), input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping EventBridge Scheduler Schedule sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing EventBridge Scheduler Schedules (%s): %w", region, err)
+		}
+
+		for _, v := range output.Schedules {
+			r := ResourceSchedule()
+			d := r.Data(nil)
+			d.SetId(ScheduleCreateResourceID(aws.ToString(v.GroupName), aws.ToString(v.Name)))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EventBridge Scheduler Schedules (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepScheduleGroups(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).SchedulerConn
+	input := &scheduler.ListScheduleGroupsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	for {
+		output, err := conn.ListScheduleGroups(context.Background(), input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping EventBridge Scheduler Schedule Group sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing EventBridge Scheduler Schedule Groups (%s): %w", region, err)
+		}
+
+		for _, v := range output.ScheduleGroups {
+			name := aws.ToString(v.Name)
+
+			// The default schedule group cannot be deleted.
+			if name == scheduleDefaultGroupName {
+				continue
+			}
+
+			r := ResourceScheduleGroup()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EventBridge Scheduler Schedule Groups (%s): %w", region, err)
+	}
+
+	return nil
+}
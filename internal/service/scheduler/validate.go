@@ -0,0 +1,138 @@
+package scheduler
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata" // Embed the IANA time zone database so that validation does not depend on the host's.
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// validName validates the name of a schedule or schedule group.
+var validName = validation.All(
+	validation.StringLenBetween(1, 64),
+	validation.StringMatch(regexp.MustCompile(`^[0-9a-zA-Z-_.]+$`), `The name must consist of alphanumerics, hyphens, underscores and periods.`),
+)
+
+var (
+	scheduleExpressionAtRegexp   = regexp.MustCompile(`^at\((.*)\)$`)
+	scheduleExpressionCronRegexp = regexp.MustCompile(`^cron\((.*)\)$`)
+	scheduleExpressionRateRegexp = regexp.MustCompile(`^rate\((.*)\)$`)
+
+	// https://docs.aws.amazon.com/scheduler/latest/UserGuide/schedule-types.html#cron-based
+	scheduleExpressionCronFieldRegexps = []struct {
+		name   string
+		regexp *regexp.Regexp
+	}{
+		{"minutes", regexp.MustCompile(`^[0-9,\-*/]+$`)},
+		{"hours", regexp.MustCompile(`^[0-9,\-*/]+$`)},
+		{"day-of-month", regexp.MustCompile(`^[0-9,\-*?/LW]+$`)},
+		{"month", regexp.MustCompile(`^[0-9A-Za-z,\-*/]+$`)},
+		{"day-of-week", regexp.MustCompile(`^[0-9A-Za-z,\-*?/L#]+$`)},
+		{"year", regexp.MustCompile(`^[0-9,\-*/]+$`)},
+	}
+)
+
+const scheduleExpressionAtTimeLayout = "2006-01-02T15:04:05"
+
+// validScheduleExpression validates the at(), rate() and cron() forms of a schedule expression.
+func validScheduleExpression(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if len(value) > 256 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 256 characters: %q", k, value))
+		return
+	}
+
+	if m := scheduleExpressionAtRegexp.FindStringSubmatch(value); m != nil {
+		if _, err := time.Parse(scheduleExpressionAtTimeLayout, m[1]); err != nil {
+			errors = append(errors, fmt.Errorf("%q: at() expression must be in the format at(yyyy-mm-ddThh:mm:ss): %q", k, value))
+		}
+
+		return
+	}
+
+	if m := scheduleExpressionRateRegexp.FindStringSubmatch(value); m != nil {
+		if err := validateScheduleExpressionRate(m[1]); err != nil {
+			errors = append(errors, fmt.Errorf("%q: %s: %q", k, err, value))
+		}
+
+		return
+	}
+
+	if m := scheduleExpressionCronRegexp.FindStringSubmatch(value); m != nil {
+		if err := validateScheduleExpressionCron(m[1]); err != nil {
+			errors = append(errors, fmt.Errorf("%q: %s: %q", k, err, value))
+		}
+
+		return
+	}
+
+	errors = append(errors, fmt.Errorf("%q must be an at(), rate() or cron() expression: %q", k, value))
+
+	return
+}
+
+func validateScheduleExpressionRate(s string) error {
+	parts := strings.Fields(s)
+
+	if len(parts) != 2 {
+		return fmt.Errorf("rate() expression must be in the format rate(value unit)")
+	}
+
+	if n, err := strconv.Atoi(parts[0]); err != nil || n < 1 {
+		return fmt.Errorf("rate() expression value must be a positive integer")
+	}
+
+	switch parts[1] {
+	case "minute", "minutes", "hour", "hours", "day", "days":
+	default:
+		return fmt.Errorf("rate() expression unit must be one of minute(s), hour(s) or day(s)")
+	}
+
+	return nil
+}
+
+func validateScheduleExpressionCron(s string) error {
+	fields := strings.Fields(s)
+
+	if len(fields) != len(scheduleExpressionCronFieldRegexps) {
+		return fmt.Errorf("cron() expression must have %d fields (minutes hours day-of-month month day-of-week year), found %d", len(scheduleExpressionCronFieldRegexps), len(fields))
+	}
+
+	for i, field := range fields {
+		if v := scheduleExpressionCronFieldRegexps[i]; !v.regexp.MatchString(field) {
+			return fmt.Errorf("cron() expression %s field contains invalid characters (%s)", v.name, field)
+		}
+	}
+
+	// You can't specify the day-of-month and day-of-week fields in the same cron expression.
+	if dayOfMonth, dayOfWeek := fields[2], fields[4]; (dayOfMonth == "?") == (dayOfWeek == "?") {
+		return fmt.Errorf("cron() expression must use ? in exactly one of the day-of-month and day-of-week fields")
+	}
+
+	return nil
+}
+
+// validTimezone validates that a schedule expression time zone is a known IANA time zone name.
+func validTimezone(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	// time.LoadLocation returns UTC for "" and the host's time zone for "Local".
+	if _, err := time.LoadLocation(value); err != nil || value == "" || value == "Local" {
+		errors = append(errors, fmt.Errorf("%q must be a valid time zone name (for example, America/New_York): %q", k, value))
+	}
+
+	return
+}
@@ -0,0 +1,128 @@
+package scheduler
+
+import (
+	"testing"
+)
+
+func TestValidScheduleExpression(t *testing.T) {
+	cases := []struct {
+		Value   string
+		IsValid bool
+	}{
+		{
+			Value:   "",
+			IsValid: false,
+		},
+		{
+			Value:   "at(2022-11-20T13:00:00)",
+			IsValid: true,
+		},
+		{
+			Value:   "at(2022-11-20 13:00:00)",
+			IsValid: false,
+		},
+		{
+			Value:   "at(2022-11-20T13:00)",
+			IsValid: false,
+		},
+		{
+			Value:   "rate(1 hour)",
+			IsValid: true,
+		},
+		{
+			Value:   "rate(5 minutes)",
+			IsValid: true,
+		},
+		{
+			Value:   "rate(7 days)",
+			IsValid: true,
+		},
+		{
+			Value:   "rate(0 minutes)",
+			IsValid: false,
+		},
+		{
+			Value:   "rate(1 week)",
+			IsValid: false,
+		},
+		{
+			Value:   "rate(hour)",
+			IsValid: false,
+		},
+		{
+			Value:   "cron(0 12 * * ? *)",
+			IsValid: true,
+		},
+		{
+			Value:   "cron(15 10 ? * 6L 2022-2023)",
+			IsValid: true,
+		},
+		{
+			Value:   "cron(0/5 8-17 ? * MON-FRI *)",
+			IsValid: true,
+		},
+		{
+			Value:   "cron(0 8 1W * ? *)",
+			IsValid: true,
+		},
+		{
+			Value:   "cron(0 12 * * *)",
+			IsValid: false,
+		},
+		{
+			Value:   "cron(0 12 * * * *)",
+			IsValid: false,
+		},
+		{
+			Value:   "cron(0 12 ? * ? *)",
+			IsValid: false,
+		},
+		{
+			Value:   "cron(0 12$ * * ? *)",
+			IsValid: false,
+		},
+		{
+			Value:   "0 12 * * ? *",
+			IsValid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validScheduleExpression(tc.Value, "schedule_expression")
+		isValid := len(errors) == 0
+		if tc.IsValid && !isValid {
+			t.Errorf("expected %q to return valid, but did not: %v", tc.Value, errors)
+		} else if !tc.IsValid && isValid {
+			t.Errorf("expected %q to not return valid, but did", tc.Value)
+		}
+	}
+}
+
+func TestValidTimezone(t *testing.T) {
+	validTimezones := []string{
+		"UTC",
+		"America/New_York",
+		"Europe/Amsterdam",
+		"Asia/Tokyo",
+	}
+	for _, v := range validTimezones {
+		_, errors := validTimezone(v, "schedule_expression_timezone")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid time zone: %q", v, errors)
+		}
+	}
+
+	invalidTimezones := []string{
+		"Mars/Olympus_Mons",
+		"America/NewYork",
+		"+0100",
+		"Local",
+		"",
+	}
+	for _, v := range invalidTimezones {
+		_, errors := validTimezone(v, "schedule_expression_timezone")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid time zone", v)
+		}
+	}
+}
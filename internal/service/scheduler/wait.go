@@ -0,0 +1,27 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitScheduleGroupDeleted(ctx context.Context, conn *scheduler.Client, name string, timeout time.Duration) (*scheduler.GetScheduleGroupOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{string(types.ScheduleGroupStateDeleting), string(types.ScheduleGroupStateActive)},
+		Target:  []string{},
+		Refresh: statusScheduleGroup(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*scheduler.GetScheduleGroupOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
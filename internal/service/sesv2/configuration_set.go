@@ -0,0 +1,547 @@
+package sesv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceConfigurationSet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfigurationSetCreate,
+		ReadWithoutTimeout:   resourceConfigurationSetRead,
+		UpdateWithoutTimeout: resourceConfigurationSetUpdate,
+		DeleteWithoutTimeout: resourceConfigurationSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_set_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"delivery_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sending_pool_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"tls_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      string(types.TlsPolicyOptional),
+							ValidateFunc: validation.StringInSlice(tlsPolicyValues(types.TlsPolicy("").Values()...), false),
+						},
+					},
+				},
+			},
+			"reputation_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"last_fresh_start": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reputation_metrics_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"sending_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sending_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"suppression_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"suppressed_reasons": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(suppressionListReasonValues(types.SuppressionListReason("").Values()...), false),
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"tracking_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_redirect_domain": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceConfigurationSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("configuration_set_name").(string)
+	input := &sesv2.CreateConfigurationSetInput{
+		ConfigurationSetName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("delivery_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DeliveryOptions = expandDeliveryOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("reputation_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ReputationOptions = expandReputationOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("sending_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SendingOptions = expandSendingOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("suppression_options"); ok && len(v.([]interface{})) > 0 {
+		input.SuppressionOptions = expandSuppressionOptions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("tracking_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TrackingOptions = expandTrackingOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.CreateConfigurationSet(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating SESv2 Configuration Set (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceConfigurationSetRead(ctx, d, meta)
+}
+
+func resourceConfigurationSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	out, err := FindConfigurationSetByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SESv2 Configuration Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading SESv2 Configuration Set (%s): %s", d.Id(), err)
+	}
+
+	arn := configurationSetARN(meta.(*conns.AWSClient), d.Id())
+
+	d.Set("arn", arn)
+	d.Set("configuration_set_name", out.ConfigurationSetName)
+
+	if out.DeliveryOptions != nil {
+		if err := d.Set("delivery_options", []interface{}{flattenDeliveryOptions(out.DeliveryOptions)}); err != nil {
+			return diag.Errorf("setting delivery_options: %s", err)
+		}
+	} else {
+		d.Set("delivery_options", nil)
+	}
+
+	if out.ReputationOptions != nil {
+		if err := d.Set("reputation_options", []interface{}{flattenReputationOptions(out.ReputationOptions)}); err != nil {
+			return diag.Errorf("setting reputation_options: %s", err)
+		}
+	} else {
+		d.Set("reputation_options", nil)
+	}
+
+	if out.SendingOptions != nil {
+		if err := d.Set("sending_options", []interface{}{flattenSendingOptions(out.SendingOptions)}); err != nil {
+			return diag.Errorf("setting sending_options: %s", err)
+		}
+	} else {
+		d.Set("sending_options", nil)
+	}
+
+	if out.SuppressionOptions != nil && len(out.SuppressionOptions.SuppressedReasons) > 0 {
+		if err := d.Set("suppression_options", []interface{}{flattenSuppressionOptions(out.SuppressionOptions)}); err != nil {
+			return diag.Errorf("setting suppression_options: %s", err)
+		}
+	} else {
+		d.Set("suppression_options", nil)
+	}
+
+	if out.TrackingOptions != nil {
+		if err := d.Set("tracking_options", []interface{}{flattenTrackingOptions(out.TrackingOptions)}); err != nil {
+			return diag.Errorf("setting tracking_options: %s", err)
+		}
+	} else {
+		d.Set("tracking_options", nil)
+	}
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("listing tags for SESv2 Configuration Set (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceConfigurationSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	if d.HasChange("delivery_options") {
+		input := &sesv2.PutConfigurationSetDeliveryOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("delivery_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			apiObject := expandDeliveryOptions(v.([]interface{})[0].(map[string]interface{}))
+
+			input.SendingPoolName = apiObject.SendingPoolName
+			input.TlsPolicy = apiObject.TlsPolicy
+		}
+
+		_, err := conn.PutConfigurationSetDeliveryOptions(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating SESv2 Configuration Set (%s) delivery options: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("reputation_options") {
+		input := &sesv2.PutConfigurationSetReputationOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("reputation_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ReputationMetricsEnabled = expandReputationOptions(v.([]interface{})[0].(map[string]interface{})).ReputationMetricsEnabled
+		}
+
+		_, err := conn.PutConfigurationSetReputationOptions(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating SESv2 Configuration Set (%s) reputation options: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("sending_options") {
+		input := &sesv2.PutConfigurationSetSendingOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+			SendingEnabled:       true,
+		}
+
+		if v, ok := d.GetOk("sending_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.SendingEnabled = expandSendingOptions(v.([]interface{})[0].(map[string]interface{})).SendingEnabled
+		}
+
+		_, err := conn.PutConfigurationSetSendingOptions(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating SESv2 Configuration Set (%s) sending options: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("suppression_options") {
+		input := &sesv2.PutConfigurationSetSuppressionOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("suppression_options"); ok && len(v.([]interface{})) > 0 {
+			input.SuppressedReasons = expandSuppressionOptions(v.([]interface{})).SuppressedReasons
+		}
+
+		_, err := conn.PutConfigurationSetSuppressionOptions(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating SESv2 Configuration Set (%s) suppression options: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tracking_options") {
+		input := &sesv2.PutConfigurationSetTrackingOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("tracking_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.CustomRedirectDomain = expandTrackingOptions(v.([]interface{})[0].(map[string]interface{})).CustomRedirectDomain
+		}
+
+		_, err := conn.PutConfigurationSetTrackingOptions(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating SESv2 Configuration Set (%s) tracking options: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating SESv2 Configuration Set (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceConfigurationSetRead(ctx, d, meta)
+}
+
+func resourceConfigurationSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	log.Printf("[INFO] Deleting SESv2 Configuration Set: %s", d.Id())
+	_, err := conn.DeleteConfigurationSet(ctx, &sesv2.DeleteConfigurationSetInput{
+		ConfigurationSetName: aws.String(d.Id()),
+	})
+
+	var notFoundException *types.NotFoundException
+	if errors.As(err, &notFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting SESv2 Configuration Set (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func configurationSetARN(client *conns.AWSClient, name string) string {
+	return client.RegionalARN("ses", fmt.Sprintf("configuration-set/%s", name))
+}
+
+func expandDeliveryOptions(tfMap map[string]interface{}) *types.DeliveryOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.DeliveryOptions{}
+
+	if v, ok := tfMap["sending_pool_name"].(string); ok && v != "" {
+		apiObject.SendingPoolName = aws.String(v)
+	}
+
+	if v, ok := tfMap["tls_policy"].(string); ok && v != "" {
+		apiObject.TlsPolicy = types.TlsPolicy(v)
+	}
+
+	return apiObject
+}
+
+func expandReputationOptions(tfMap map[string]interface{}) *types.ReputationOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.ReputationOptions{}
+
+	if v, ok := tfMap["reputation_metrics_enabled"].(bool); ok {
+		apiObject.ReputationMetricsEnabled = v
+	}
+
+	return apiObject
+}
+
+func expandSendingOptions(tfMap map[string]interface{}) *types.SendingOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.SendingOptions{}
+
+	if v, ok := tfMap["sending_enabled"].(bool); ok {
+		apiObject.SendingEnabled = v
+	}
+
+	return apiObject
+}
+
+// expandSuppressionOptions returns suppression options with no suppressed reasons for an empty block,
+// so that the account-level suppression list settings are overridden.
+func expandSuppressionOptions(tfList []interface{}) *types.SuppressionOptions {
+	apiObject := &types.SuppressionOptions{
+		SuppressedReasons: []types.SuppressionListReason{},
+	}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["suppressed_reasons"].(*schema.Set); ok {
+		for _, v := range v.List() {
+			apiObject.SuppressedReasons = append(apiObject.SuppressedReasons, types.SuppressionListReason(v.(string)))
+		}
+	}
+
+	return apiObject
+}
+
+func expandTrackingOptions(tfMap map[string]interface{}) *types.TrackingOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.TrackingOptions{}
+
+	if v, ok := tfMap["custom_redirect_domain"].(string); ok && v != "" {
+		apiObject.CustomRedirectDomain = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDeliveryOptions(apiObject *types.DeliveryOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"sending_pool_name": aws.ToString(apiObject.SendingPoolName),
+		"tls_policy":        string(apiObject.TlsPolicy),
+	}
+}
+
+func flattenReputationOptions(apiObject *types.ReputationOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"reputation_metrics_enabled": apiObject.ReputationMetricsEnabled,
+	}
+
+	if v := apiObject.LastFreshStart; v != nil {
+		tfMap["last_fresh_start"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
+func flattenSendingOptions(apiObject *types.SendingOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"sending_enabled": apiObject.SendingEnabled,
+	}
+}
+
+func flattenSuppressionOptions(apiObject *types.SuppressionOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var suppressedReasons []string
+
+	for _, v := range apiObject.SuppressedReasons {
+		suppressedReasons = append(suppressedReasons, string(v))
+	}
+
+	return map[string]interface{}{
+		"suppressed_reasons": suppressedReasons,
+	}
+}
+
+func flattenTrackingOptions(apiObject *types.TrackingOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"custom_redirect_domain": aws.ToString(apiObject.CustomRedirectDomain),
+	}
+}
+
+func suppressionListReasonValues(input ...types.SuppressionListReason) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
+
+func tlsPolicyValues(input ...types.TlsPolicy) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
@@ -0,0 +1,468 @@
+package sesv2
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceConfigurationSetEventDestination() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfigurationSetEventDestinationCreate,
+		ReadWithoutTimeout:   resourceConfigurationSetEventDestinationRead,
+		UpdateWithoutTimeout: resourceConfigurationSetEventDestinationUpdate,
+		DeleteWithoutTimeout: resourceConfigurationSetEventDestinationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"configuration_set_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"event_destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloud_watch_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimension_configuration": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"default_dimension_value": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"dimension_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"dimension_value_source": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(dimensionValueSourceValues(types.DimensionValueSource("").Values()...), false),
+												},
+											},
+										},
+									},
+								},
+							},
+							ExactlyOneOf: []string{
+								"event_destination.0.cloud_watch_destination",
+								"event_destination.0.kinesis_firehose_destination",
+								"event_destination.0.pinpoint_destination",
+								"event_destination.0.sns_destination",
+							},
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"kinesis_firehose_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delivery_stream_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"iam_role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+							ExactlyOneOf: []string{
+								"event_destination.0.cloud_watch_destination",
+								"event_destination.0.kinesis_firehose_destination",
+								"event_destination.0.pinpoint_destination",
+								"event_destination.0.sns_destination",
+							},
+						},
+						"matching_event_types": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(eventTypeValues(types.EventType("").Values()...), false),
+							},
+						},
+						"pinpoint_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"application_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+							ExactlyOneOf: []string{
+								"event_destination.0.cloud_watch_destination",
+								"event_destination.0.kinesis_firehose_destination",
+								"event_destination.0.pinpoint_destination",
+								"event_destination.0.sns_destination",
+							},
+						},
+						"sns_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"topic_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+							ExactlyOneOf: []string{
+								"event_destination.0.cloud_watch_destination",
+								"event_destination.0.kinesis_firehose_destination",
+								"event_destination.0.pinpoint_destination",
+								"event_destination.0.sns_destination",
+							},
+						},
+					},
+				},
+			},
+			"event_destination_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+	}
+}
+
+func resourceConfigurationSetEventDestinationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	configurationSetName := d.Get("configuration_set_name").(string)
+	eventDestinationName := d.Get("event_destination_name").(string)
+	id := ConfigurationSetEventDestinationCreateResourceID(configurationSetName, eventDestinationName)
+	input := &sesv2.CreateConfigurationSetEventDestinationInput{
+		ConfigurationSetName: aws.String(configurationSetName),
+		EventDestination:     expandEventDestinationDefinition(d.Get("event_destination").([]interface{})[0].(map[string]interface{})),
+		EventDestinationName: aws.String(eventDestinationName),
+	}
+
+	_, err := conn.CreateConfigurationSetEventDestination(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating SESv2 Configuration Set Event Destination (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceConfigurationSetEventDestinationRead(ctx, d, meta)
+}
+
+func resourceConfigurationSetEventDestinationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	configurationSetName, eventDestinationName, err := ConfigurationSetEventDestinationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	out, err := FindConfigurationSetEventDestinationByTwoPartKey(ctx, conn, configurationSetName, eventDestinationName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SESv2 Configuration Set Event Destination (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading SESv2 Configuration Set Event Destination (%s): %s", d.Id(), err)
+	}
+
+	d.Set("configuration_set_name", configurationSetName)
+	d.Set("event_destination_name", out.Name)
+
+	if err := d.Set("event_destination", []interface{}{flattenEventDestination(out)}); err != nil {
+		return diag.Errorf("setting event_destination: %s", err)
+	}
+
+	return nil
+}
+
+func resourceConfigurationSetEventDestinationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	configurationSetName, eventDestinationName, err := ConfigurationSetEventDestinationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("event_destination") {
+		input := &sesv2.UpdateConfigurationSetEventDestinationInput{
+			ConfigurationSetName: aws.String(configurationSetName),
+			EventDestination:     expandEventDestinationDefinition(d.Get("event_destination").([]interface{})[0].(map[string]interface{})),
+			EventDestinationName: aws.String(eventDestinationName),
+		}
+
+		_, err := conn.UpdateConfigurationSetEventDestination(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating SESv2 Configuration Set Event Destination (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceConfigurationSetEventDestinationRead(ctx, d, meta)
+}
+
+func resourceConfigurationSetEventDestinationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	configurationSetName, eventDestinationName, err := ConfigurationSetEventDestinationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting SESv2 Configuration Set Event Destination: %s", d.Id())
+	_, err = conn.DeleteConfigurationSetEventDestination(ctx, &sesv2.DeleteConfigurationSetEventDestinationInput{
+		ConfigurationSetName: aws.String(configurationSetName),
+		EventDestinationName: aws.String(eventDestinationName),
+	})
+
+	var notFoundException *types.NotFoundException
+	if errors.As(err, &notFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting SESv2 Configuration Set Event Destination (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandEventDestinationDefinition(tfMap map[string]interface{}) *types.EventDestinationDefinition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.EventDestinationDefinition{}
+
+	if v, ok := tfMap["cloud_watch_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CloudWatchDestination = expandCloudWatchDestination(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = v
+	}
+
+	if v, ok := tfMap["kinesis_firehose_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KinesisFirehoseDestination = expandKinesisFirehoseDestination(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["matching_event_types"].(*schema.Set); ok && v.Len() > 0 {
+		for _, v := range v.List() {
+			apiObject.MatchingEventTypes = append(apiObject.MatchingEventTypes, types.EventType(v.(string)))
+		}
+	}
+
+	if v, ok := tfMap["pinpoint_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PinpointDestination = expandPinpointDestination(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["sns_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SnsDestination = expandSNSDestination(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandCloudWatchDestination(tfMap map[string]interface{}) *types.CloudWatchDestination {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.CloudWatchDestination{}
+
+	if v, ok := tfMap["dimension_configuration"].([]interface{}); ok {
+		for _, v := range v {
+			tfMap, ok := v.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.DimensionConfigurations = append(apiObject.DimensionConfigurations, types.CloudWatchDimensionConfiguration{
+				DefaultDimensionValue: aws.String(tfMap["default_dimension_value"].(string)),
+				DimensionName:         aws.String(tfMap["dimension_name"].(string)),
+				DimensionValueSource:  types.DimensionValueSource(tfMap["dimension_value_source"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func expandKinesisFirehoseDestination(tfMap map[string]interface{}) *types.KinesisFirehoseDestination {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.KinesisFirehoseDestination{}
+
+	if v, ok := tfMap["delivery_stream_arn"].(string); ok && v != "" {
+		apiObject.DeliveryStreamArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["iam_role_arn"].(string); ok && v != "" {
+		apiObject.IamRoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandPinpointDestination(tfMap map[string]interface{}) *types.PinpointDestination {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PinpointDestination{}
+
+	if v, ok := tfMap["application_arn"].(string); ok && v != "" {
+		apiObject.ApplicationArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSNSDestination(tfMap map[string]interface{}) *types.SnsDestination {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.SnsDestination{}
+
+	if v, ok := tfMap["topic_arn"].(string); ok && v != "" {
+		apiObject.TopicArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenEventDestination(apiObject *types.EventDestination) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled": apiObject.Enabled,
+	}
+
+	if v := apiObject.CloudWatchDestination; v != nil {
+		tfMap["cloud_watch_destination"] = []interface{}{flattenCloudWatchDestination(v)}
+	}
+
+	if v := apiObject.KinesisFirehoseDestination; v != nil {
+		tfMap["kinesis_firehose_destination"] = []interface{}{map[string]interface{}{
+			"delivery_stream_arn": aws.ToString(v.DeliveryStreamArn),
+			"iam_role_arn":        aws.ToString(v.IamRoleArn),
+		}}
+	}
+
+	if v := apiObject.MatchingEventTypes; v != nil {
+		var matchingEventTypes []string
+
+		for _, v := range v {
+			matchingEventTypes = append(matchingEventTypes, string(v))
+		}
+
+		tfMap["matching_event_types"] = matchingEventTypes
+	}
+
+	if v := apiObject.PinpointDestination; v != nil {
+		tfMap["pinpoint_destination"] = []interface{}{map[string]interface{}{
+			"application_arn": aws.ToString(v.ApplicationArn),
+		}}
+	}
+
+	if v := apiObject.SnsDestination; v != nil {
+		tfMap["sns_destination"] = []interface{}{map[string]interface{}{
+			"topic_arn": aws.ToString(v.TopicArn),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenCloudWatchDestination(apiObject *types.CloudWatchDestination) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var dimensionConfigurations []interface{}
+
+	for _, v := range apiObject.DimensionConfigurations {
+		dimensionConfigurations = append(dimensionConfigurations, map[string]interface{}{
+			"default_dimension_value": aws.ToString(v.DefaultDimensionValue),
+			"dimension_name":          aws.ToString(v.DimensionName),
+			"dimension_value_source":  string(v.DimensionValueSource),
+		})
+	}
+
+	return map[string]interface{}{
+		"dimension_configuration": dimensionConfigurations,
+	}
+}
+
+func dimensionValueSourceValues(input ...types.DimensionValueSource) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
+
+func eventTypeValues(input ...types.EventType) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
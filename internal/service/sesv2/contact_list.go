@@ -0,0 +1,277 @@
+package sesv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceContactList() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceContactListCreate,
+		ReadWithoutTimeout:   resourceContactListRead,
+		UpdateWithoutTimeout: resourceContactListUpdate,
+		DeleteWithoutTimeout: resourceContactListDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_list_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"created_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"last_updated_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"topic": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_subscription_status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(subscriptionStatusValues(types.SubscriptionStatus("").Values()...), false),
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"topic_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceContactListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("contact_list_name").(string)
+	input := &sesv2.CreateContactListInput{
+		ContactListName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("topic"); ok && len(v.([]interface{})) > 0 {
+		input.Topics = expandTopics(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.CreateContactList(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating SESv2 Contact List (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceContactListRead(ctx, d, meta)
+}
+
+func resourceContactListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	out, err := FindContactListByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SESv2 Contact List (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading SESv2 Contact List (%s): %s", d.Id(), err)
+	}
+
+	arn := contactListARN(meta.(*conns.AWSClient), d.Id())
+
+	d.Set("arn", arn)
+	d.Set("contact_list_name", out.ContactListName)
+	d.Set("created_timestamp", aws.ToTime(out.CreatedTimestamp).Format(time.RFC3339))
+	d.Set("description", out.Description)
+	d.Set("last_updated_timestamp", aws.ToTime(out.LastUpdatedTimestamp).Format(time.RFC3339))
+
+	if err := d.Set("topic", flattenTopics(out.Topics)); err != nil {
+		return diag.Errorf("setting topic: %s", err)
+	}
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("listing tags for SESv2 Contact List (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceContactListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	if d.HasChanges("description", "topic") {
+		input := &sesv2.UpdateContactListInput{
+			ContactListName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("topic"); ok && len(v.([]interface{})) > 0 {
+			input.Topics = expandTopics(v.([]interface{}))
+		}
+
+		_, err := conn.UpdateContactList(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating SESv2 Contact List (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating SESv2 Contact List (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceContactListRead(ctx, d, meta)
+}
+
+func resourceContactListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	log.Printf("[INFO] Deleting SESv2 Contact List: %s", d.Id())
+	_, err := conn.DeleteContactList(ctx, &sesv2.DeleteContactListInput{
+		ContactListName: aws.String(d.Id()),
+	})
+
+	var notFoundException *types.NotFoundException
+	if errors.As(err, &notFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting SESv2 Contact List (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func contactListARN(client *conns.AWSClient, name string) string {
+	return client.RegionalARN("ses", fmt.Sprintf("contact-list/%s", name))
+}
+
+func expandTopics(tfList []interface{}) []types.Topic {
+	var apiObjects []types.Topic
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.Topic{
+			DefaultSubscriptionStatus: types.SubscriptionStatus(tfMap["default_subscription_status"].(string)),
+			DisplayName:               aws.String(tfMap["display_name"].(string)),
+			TopicName:                 aws.String(tfMap["topic_name"].(string)),
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenTopics(apiObjects []types.Topic) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"default_subscription_status": string(apiObject.DefaultSubscriptionStatus),
+			"description":                 aws.ToString(apiObject.Description),
+			"display_name":                aws.ToString(apiObject.DisplayName),
+			"topic_name":                  aws.ToString(apiObject.TopicName),
+		})
+	}
+
+	return tfList
+}
+
+func subscriptionStatusValues(input ...types.SubscriptionStatus) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
@@ -0,0 +1,334 @@
+package sesv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEmailIdentity() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEmailIdentityCreate,
+		ReadWithoutTimeout:   resourceEmailIdentityRead,
+		UpdateWithoutTimeout: resourceEmailIdentityUpdate,
+		DeleteWithoutTimeout: resourceEmailIdentityDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_set_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dkim_signing_attributes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"current_signing_key_length": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain_signing_private_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							RequiredWith: []string{"dkim_signing_attributes.0.domain_signing_selector"},
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 20480),
+								validation.StringIsBase64,
+							),
+						},
+						"domain_signing_selector": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							RequiredWith: []string{"dkim_signing_attributes.0.domain_signing_private_key"},
+							ValidateFunc: validation.StringLenBetween(1, 63),
+						},
+						"last_key_generation_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"next_signing_key_length": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Computed:      true,
+							ConflictsWith: []string{"dkim_signing_attributes.0.domain_signing_private_key", "dkim_signing_attributes.0.domain_signing_selector"},
+							ValidateFunc:  validation.StringInSlice(dkimSigningKeyLengthValues(types.DkimSigningKeyLength("").Values()...), false),
+						},
+						"signing_attributes_origin": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tokens": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"email_identity": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"identity_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"verified_for_sending_status": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceEmailIdentityCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("email_identity").(string)
+	input := &sesv2.CreateEmailIdentityInput{
+		EmailIdentity: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("configuration_set_name"); ok {
+		input.ConfigurationSetName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("dkim_signing_attributes"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DkimSigningAttributes = expandDkimSigningAttributes(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.CreateEmailIdentity(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating SESv2 Email Identity (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceEmailIdentityRead(ctx, d, meta)
+}
+
+func resourceEmailIdentityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	out, err := FindEmailIdentityByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SESv2 Email Identity (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading SESv2 Email Identity (%s): %s", d.Id(), err)
+	}
+
+	arn := emailIdentityARN(meta.(*conns.AWSClient), d.Id())
+
+	d.Set("arn", arn)
+	d.Set("configuration_set_name", out.ConfigurationSetName)
+	d.Set("email_identity", d.Id())
+	d.Set("identity_type", out.IdentityType)
+	d.Set("verified_for_sending_status", out.VerifiedForSendingStatus)
+
+	if out.DkimAttributes != nil {
+		tfMap := flattenDkimAttributes(out.DkimAttributes)
+
+		// The private key and selector of BYODKIM are not returned by the API.
+		tfMap["domain_signing_private_key"] = d.Get("dkim_signing_attributes.0.domain_signing_private_key").(string)
+		tfMap["domain_signing_selector"] = d.Get("dkim_signing_attributes.0.domain_signing_selector").(string)
+
+		if err := d.Set("dkim_signing_attributes", []interface{}{tfMap}); err != nil {
+			return diag.Errorf("setting dkim_signing_attributes: %s", err)
+		}
+	} else {
+		d.Set("dkim_signing_attributes", nil)
+	}
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("listing tags for SESv2 Email Identity (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceEmailIdentityUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	if d.HasChange("configuration_set_name") {
+		input := &sesv2.PutEmailIdentityConfigurationSetAttributesInput{
+			EmailIdentity: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("configuration_set_name"); ok {
+			input.ConfigurationSetName = aws.String(v.(string))
+		}
+
+		_, err := conn.PutEmailIdentityConfigurationSetAttributes(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating SESv2 Email Identity (%s) configuration set: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("dkim_signing_attributes.0.domain_signing_private_key", "dkim_signing_attributes.0.domain_signing_selector", "dkim_signing_attributes.0.next_signing_key_length") {
+		input := &sesv2.PutEmailIdentityDkimSigningAttributesInput{
+			EmailIdentity:           aws.String(d.Id()),
+			SigningAttributesOrigin: types.DkimSigningAttributesOriginAwsSes,
+		}
+
+		if v, ok := d.GetOk("dkim_signing_attributes"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.SigningAttributes = expandDkimSigningAttributes(v.([]interface{})[0].(map[string]interface{}))
+
+			if input.SigningAttributes.DomainSigningPrivateKey != nil {
+				input.SigningAttributesOrigin = types.DkimSigningAttributesOriginExternal
+			}
+		}
+
+		_, err := conn.PutEmailIdentityDkimSigningAttributes(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating SESv2 Email Identity (%s) DKIM signing attributes: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating SESv2 Email Identity (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceEmailIdentityRead(ctx, d, meta)
+}
+
+func resourceEmailIdentityDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	log.Printf("[INFO] Deleting SESv2 Email Identity: %s", d.Id())
+	_, err := conn.DeleteEmailIdentity(ctx, &sesv2.DeleteEmailIdentityInput{
+		EmailIdentity: aws.String(d.Id()),
+	})
+
+	var notFoundException *types.NotFoundException
+	if errors.As(err, &notFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting SESv2 Email Identity (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func emailIdentityARN(client *conns.AWSClient, name string) string {
+	return client.RegionalARN("ses", fmt.Sprintf("identity/%s", name))
+}
+
+func expandDkimSigningAttributes(tfMap map[string]interface{}) *types.DkimSigningAttributes {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.DkimSigningAttributes{}
+
+	if v, ok := tfMap["domain_signing_private_key"].(string); ok && v != "" {
+		apiObject.DomainSigningPrivateKey = aws.String(v)
+	}
+
+	if v, ok := tfMap["domain_signing_selector"].(string); ok && v != "" {
+		apiObject.DomainSigningSelector = aws.String(v)
+	}
+
+	if v, ok := tfMap["next_signing_key_length"].(string); ok && v != "" && apiObject.DomainSigningPrivateKey == nil {
+		apiObject.NextSigningKeyLength = types.DkimSigningKeyLength(v)
+	}
+
+	return apiObject
+}
+
+func flattenDkimAttributes(apiObject *types.DkimAttributes) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"current_signing_key_length": string(apiObject.CurrentSigningKeyLength),
+		"next_signing_key_length":    string(apiObject.NextSigningKeyLength),
+		"signing_attributes_origin":  string(apiObject.SigningAttributesOrigin),
+		"status":                     string(apiObject.Status),
+		"tokens":                     apiObject.Tokens,
+	}
+
+	if v := apiObject.LastKeyGenerationTimestamp; v != nil {
+		tfMap["last_key_generation_timestamp"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
+func dkimSigningKeyLengthValues(input ...types.DkimSigningKeyLength) []string {
+	var output []string
+
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
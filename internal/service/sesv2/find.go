@@ -0,0 +1,158 @@
+package sesv2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindConfigurationSetByName(ctx context.Context, conn *sesv2.Client, name string) (*sesv2.GetConfigurationSetOutput, error) {
+	in := &sesv2.GetConfigurationSetInput{
+		ConfigurationSetName: aws.String(name),
+	}
+
+	out, err := conn.GetConfigurationSet(ctx, in)
+
+	var notFoundException *types.NotFoundException
+	if errors.As(err, &notFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func FindConfigurationSetEventDestinationByTwoPartKey(ctx context.Context, conn *sesv2.Client, configurationSetName, eventDestinationName string) (*types.EventDestination, error) {
+	in := &sesv2.GetConfigurationSetEventDestinationsInput{
+		ConfigurationSetName: aws.String(configurationSetName),
+	}
+
+	out, err := conn.GetConfigurationSetEventDestinations(ctx, in)
+
+	var notFoundException *types.NotFoundException
+	if errors.As(err, &notFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	for _, eventDestination := range out.EventDestinations {
+		if aws.ToString(eventDestination.Name) == eventDestinationName {
+			return &eventDestination, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: in,
+	}
+}
+
+func FindContactListByName(ctx context.Context, conn *sesv2.Client, name string) (*sesv2.GetContactListOutput, error) {
+	in := &sesv2.GetContactListInput{
+		ContactListName: aws.String(name),
+	}
+
+	out, err := conn.GetContactList(ctx, in)
+
+	var notFoundException *types.NotFoundException
+	if errors.As(err, &notFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+// FindDedicatedIPPoolByName returns the name of the dedicated IP pool.
+// The SES API has no operation that describes a single dedicated IP pool.
+func FindDedicatedIPPoolByName(ctx context.Context, conn *sesv2.Client, name string) (string, error) {
+	in := &sesv2.ListDedicatedIpPoolsInput{}
+
+	for {
+		out, err := conn.ListDedicatedIpPools(ctx, in)
+
+		if err != nil {
+			return "", err
+		}
+
+		if out == nil {
+			return "", tfresource.NewEmptyResultError(in)
+		}
+
+		for _, v := range out.DedicatedIpPools {
+			if v == name {
+				return v, nil
+			}
+		}
+
+		if aws.ToString(out.NextToken) == "" {
+			break
+		}
+
+		in.NextToken = out.NextToken
+	}
+
+	return "", &resource.NotFoundError{
+		LastRequest: in,
+	}
+}
+
+func FindEmailIdentityByID(ctx context.Context, conn *sesv2.Client, id string) (*sesv2.GetEmailIdentityOutput, error) {
+	in := &sesv2.GetEmailIdentityInput{
+		EmailIdentity: aws.String(id),
+	}
+
+	out, err := conn.GetEmailIdentity(ctx, in)
+
+	var notFoundException *types.NotFoundException
+	if errors.As(err, &notFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
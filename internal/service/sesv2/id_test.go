@@ -0,0 +1,85 @@
+package sesv2_test
+
+import (
+	"testing"
+
+	tfsesv2 "github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
+)
+
+func TestConfigurationSetEventDestinationParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName                     string
+		Input                        string
+		ExpectedConfigurationSetName string
+		ExpectedEventDestinationName string
+		Error                        bool
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Error:    true,
+		},
+		{
+			TestName: "Invalid ID",
+			Input:    "my-configuration-set|",
+			Error:    true,
+		},
+		{
+			TestName: "Invalid ID separator",
+			Input:    "my-configuration-set/my-event-destination",
+			Error:    true,
+		},
+		{
+			TestName: "Invalid ID with more than 1 separator",
+			Input:    "my-configuration-set|my-event-destination|extra",
+			Error:    true,
+		},
+		{
+			TestName:                     "Valid ID",
+			Input:                        "my-configuration-set|my-event-destination",
+			ExpectedConfigurationSetName: "my-configuration-set",
+			ExpectedEventDestinationName: "my-event-destination",
+			Error:                        false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotConfigurationSetName, gotEventDestinationName, err := tfsesv2.ConfigurationSetEventDestinationParseResourceID(testCase.Input)
+
+			if err != nil && !testCase.Error {
+				t.Errorf("got error (%s), expected no error", err)
+			}
+
+			if err == nil && testCase.Error {
+				t.Errorf("got (ConfigurationSetName: %s, EventDestinationName: %s) and no error, expected error", gotConfigurationSetName, gotEventDestinationName)
+			}
+
+			if gotConfigurationSetName != testCase.ExpectedConfigurationSetName {
+				t.Errorf("got %s, expected %s", gotConfigurationSetName, testCase.ExpectedConfigurationSetName)
+			}
+
+			if gotEventDestinationName != testCase.ExpectedEventDestinationName {
+				t.Errorf("got %s, expected %s", gotEventDestinationName, testCase.ExpectedEventDestinationName)
+			}
+		})
+	}
+}
+
+func TestConfigurationSetEventDestinationCreateResourceID(t *testing.T) {
+	id := tfsesv2.ConfigurationSetEventDestinationCreateResourceID("my-configuration-set", "my-event-destination")
+
+	configurationSetName, eventDestinationName, err := tfsesv2.ConfigurationSetEventDestinationParseResourceID(id)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := configurationSetName, "my-configuration-set"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	if got, expected := eventDestinationName, "my-event-destination"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
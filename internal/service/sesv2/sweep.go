@@ -0,0 +1,221 @@
+//go:build sweep
+// +build sweep
+
+package sesv2
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_sesv2_configuration_set", &resource.Sweeper{
+		Name: "aws_sesv2_configuration_set",
+		F:    sweepConfigurationSets,
+	})
+
+	resource.AddTestSweepers("aws_sesv2_contact_list", &resource.Sweeper{
+		Name: "aws_sesv2_contact_list",
+		F:    sweepContactLists,
+	})
+
+	resource.AddTestSweepers("aws_sesv2_dedicated_ip_pool", &resource.Sweeper{
+		Name: "aws_sesv2_dedicated_ip_pool",
+		F:    sweepDedicatedIPPools,
+		Dependencies: []string{
+			"aws_sesv2_configuration_set",
+		},
+	})
+
+	resource.AddTestSweepers("aws_sesv2_email_identity", &resource.Sweeper{
+		Name: "aws_sesv2_email_identity",
+		F:    sweepEmailIdentities,
+	})
+}
+
+func sweepConfigurationSets(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).SESV2Conn
+	input := &sesv2.ListConfigurationSetsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	for {
+		output, err := conn.ListConfigurationSets(context.Background(), input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping SESv2 Configuration Set sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing SESv2 Configuration Sets (%s): %w", region, err)
+		}
+
+		for _, v := range output.ConfigurationSets {
+			r := ResourceConfigurationSet()
+			d := r.Data(nil)
+			d.SetId(v)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping SESv2 Configuration Sets (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepContactLists(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).SESV2Conn
+	input := &sesv2.ListContactListsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	for {
+		output, err := conn.ListContactLists(context.Background(), input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping SESv2 Contact List sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing SESv2 Contact Lists (%s): %w", region, err)
+		}
+
+		for _, v := range output.ContactLists {
+			r := ResourceContactList()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.ContactListName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping SESv2 Contact Lists (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDedicatedIPPools(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).SESV2Conn
+	input := &sesv2.ListDedicatedIpPoolsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	for {
+		output, err := conn.ListDedicatedIpPools(context.Background(), input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping SESv2 Dedicated IP Pool sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing SESv2 Dedicated IP Pools (%s): %w", region, err)
+		}
+
+		for _, v := range output.DedicatedIpPools {
+			r := ResourceDedicatedIPPool()
+			d := r.Data(nil)
+			d.SetId(v)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping SESv2 Dedicated IP Pools (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepEmailIdentities(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).SESV2Conn
+	input := &sesv2.ListEmailIdentitiesInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	for {
+		output, err := conn.ListEmailIdentities(context.Background(), input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping SESv2 Email Identity sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing SESv2 Email Identities (%s): %w", region, err)
+		}
+
+		for _, v := range output.EmailIdentities {
+			r := ResourceEmailIdentity()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.IdentityName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping SESv2 Email Identities (%s): %w", region, err)
+	}
+
+	return nil
+}
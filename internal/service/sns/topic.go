@@ -199,7 +199,7 @@ func ResourceTopic() *schema.Resource {
 		Update: resourceTopicUpdate,
 		Delete: resourceTopicDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: verify.ImportByARN(sns.ServiceName, verify.ImportARNPassthrough),
 		},
 		CustomizeDiff: customdiff.Sequence(
 			resourceTopicCustomizeDiff,
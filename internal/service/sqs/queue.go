@@ -7,6 +7,7 @@ import (
 	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -173,7 +174,7 @@ func ResourceQueue() *schema.Resource {
 		Update: resourceQueueUpdate,
 		Delete: resourceQueueDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: verify.ImportByARN(sqs.ServiceName, resourceQueueImportARN),
 		},
 		CustomizeDiff: customdiff.Sequence(
 			resourceQueueCustomizeDiff,
@@ -404,6 +405,25 @@ func resourceQueueDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceQueueImportARN(ctx context.Context, d *schema.ResourceData, meta interface{}, queueARN arn.ARN) (string, error) {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	output, err := conn.GetQueueUrlWithContext(ctx, &sqs.GetQueueUrlInput{
+		QueueName:              aws.String(queueARN.Resource),
+		QueueOwnerAWSAccountId: aws.String(queueARN.AccountID),
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("error getting SQS Queue (%s) URL: %w", queueARN.Resource, err)
+	}
+
+	if output == nil || output.QueueUrl == nil {
+		return "", fmt.Errorf("error getting SQS Queue (%s) URL: empty result", queueARN.Resource)
+	}
+
+	return aws.StringValue(output.QueueUrl), nil
+}
+
 func resourceQueueCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	fifoQueue := diff.Get("fifo_queue").(bool)
 	contentBasedDeduplication := diff.Get("content_based_deduplication").(bool)
@@ -1,12 +1,15 @@
 package sqs_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/sqs"
+	mockdatav1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/mockdata"
+	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -28,6 +31,83 @@ func testAccErrorCheckSkip(t *testing.T) resource.ErrorCheckFunc {
 	)
 }
 
+func TestQueueImportARN(t *testing.T) {
+	sqsEndpoints := []*servicemocks.MockEndpoint{
+		{
+			Request: &servicemocks.MockRequest{
+				Method: "POST",
+				Uri:    "/",
+				Body:   "Action=GetQueueUrl&QueueName=MyQueue&QueueOwnerAWSAccountId=123456789012&Version=2012-11-05",
+			},
+			Response: &servicemocks.MockResponse{
+				StatusCode:  200,
+				Body:        `<GetQueueUrlResponse><GetQueueUrlResult><QueueUrl>https://sqs.us-east-1.amazonaws.com/123456789012/MyQueue</QueueUrl></GetQueueUrlResult><ResponseMetadata><RequestId>470a6f13-2ed9-4181-ad8a-2fdea142988e</RequestId></ResponseMetadata></GetQueueUrlResponse>`, //lintignore:AWSAT003
+				ContentType: "text/xml",
+			},
+		},
+	}
+	closeFunc, sess, err := mockdatav1.GetMockedAwsApiSession("SQS", sqsEndpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFunc()
+
+	meta := &conns.AWSClient{
+		Partition: "aws",
+		Region:    "us-east-1", //lintignore:AWSAT003
+		SQSConn:   sqs.New(sess),
+	}
+
+	testCases := []struct {
+		Name        string
+		ImportID    string
+		Expected    string
+		ExpectError bool
+	}{
+		{
+			Name:     "URL",
+			ImportID: "https://sqs.us-east-1.amazonaws.com/123456789012/OtherQueue", //lintignore:AWSAT003
+			Expected: "https://sqs.us-east-1.amazonaws.com/123456789012/OtherQueue", //lintignore:AWSAT003
+		},
+		{
+			Name:     "ARN",
+			ImportID: "arn:aws:sqs:us-east-1:123456789012:MyQueue",               //lintignore:AWSAT003,AWSAT005
+			Expected: "https://sqs.us-east-1.amazonaws.com/123456789012/MyQueue", //lintignore:AWSAT003
+		},
+		{
+			Name:        "ARN other region",
+			ImportID:    "arn:aws:sqs:us-west-2:123456789012:MyQueue", //lintignore:AWSAT003,AWSAT005
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := tfsqs.ResourceQueue()
+			d := r.Data(nil)
+			d.SetId(testCase.ImportID)
+
+			result, err := r.Importer.StateContext(context.Background(), d, meta)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatal("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectError {
+				return
+			}
+
+			if got, expected := result[0].Id(), testCase.Expected; got != expected {
+				t.Errorf("got %q, expected %q", got, expected)
+			}
+		})
+	}
+}
+
 func TestAccSQSQueue_basic(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
@@ -69,6 +149,12 @@ func TestAccSQSQueue_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: acctest.AttrImportStateIdFunc(resourceName, "arn"),
+			},
 		},
 	})
 }
@@ -0,0 +1,313 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// SweepDependencyMaxWaves is the default maximum number of times resources are deleted
+	// when deletions fail because of dependent resources.
+	SweepDependencyMaxWaves = 5
+
+	// SweepDependencyWaveDelay is the default delay before re-deleting resources
+	// whose deletions failed because of dependent resources.
+	SweepDependencyWaveDelay = 30 * time.Second
+)
+
+// sweepThrottlingErrorCodes are error codes returned when API requests are throttled.
+var sweepThrottlingErrorCodes = []string{
+	"PriorRequestNotComplete",
+	"ProvisionedThroughputExceededException",
+	"RequestLimitExceeded",
+	"RequestThrottled",
+	"RequestThrottledException",
+	"SlowDown",
+	"ThrottledException",
+	"Throttling",
+	"ThrottlingException",
+	"TooManyRequestsException",
+}
+
+// sweepDependencyErrorCodes are error codes returned when a resource cannot be deleted
+// until resources that depend on it are deleted.
+var sweepDependencyErrorCodes = []string{
+	"DeleteConflict",
+	"DependencyViolation",
+	"InvalidGroup.InUse",
+	"InvalidNetworkInterface.InUse",
+	"ResourceInUse",
+	"ResourceInUseException",
+}
+
+// SweepOrchestratorOptions are options for deleting resources with SweepOrchestratorWithContext.
+type SweepOrchestratorOptions struct {
+	// Concurrency is the maximum number of resources deleted at once.
+	// Zero means no limit.
+	Concurrency int
+
+	// MaxWaves is the maximum number of times resources are deleted
+	// when deletions fail because of dependent resources.
+	MaxWaves int
+
+	// WaveDelay is the delay before re-deleting resources
+	// whose deletions failed because of dependent resources.
+	WaveDelay time.Duration
+}
+
+// WithConcurrency sets the maximum number of resources deleted at once.
+func WithConcurrency(concurrency int) func(*SweepOrchestratorOptions) {
+	return func(o *SweepOrchestratorOptions) {
+		o.Concurrency = concurrency
+	}
+}
+
+// WithMaxWaves sets the maximum number of times resources are deleted
+// when deletions fail because of dependent resources.
+func WithMaxWaves(maxWaves int) func(*SweepOrchestratorOptions) {
+	return func(o *SweepOrchestratorOptions) {
+		o.MaxWaves = maxWaves
+	}
+}
+
+// WithWaveDelay sets the delay before re-deleting resources
+// whose deletions failed because of dependent resources.
+func WithWaveDelay(waveDelay time.Duration) func(*SweepOrchestratorOptions) {
+	return func(o *SweepOrchestratorOptions) {
+		o.WaveDelay = waveDelay
+	}
+}
+
+func newSweepOrchestratorOptions(optFns ...func(*SweepOrchestratorOptions)) (*SweepOrchestratorOptions, error) {
+	options := &SweepOrchestratorOptions{
+		MaxWaves:  SweepDependencyMaxWaves,
+		WaveDelay: SweepDependencyWaveDelay,
+	}
+
+	if v := os.Getenv(conns.EnvVarSweepConcurrency); v != "" {
+		concurrency, err := strconv.Atoi(v)
+
+		if err != nil || concurrency < 0 {
+			return nil, fmt.Errorf("environment variable %s: invalid concurrency (%s)", conns.EnvVarSweepConcurrency, v)
+		}
+
+		options.Concurrency = concurrency
+	}
+
+	if v := os.Getenv(conns.EnvVarSweepDependencyMaxWaves); v != "" {
+		maxWaves, err := strconv.Atoi(v)
+
+		if err != nil || maxWaves < 1 {
+			return nil, fmt.Errorf("environment variable %s: invalid maximum number of waves (%s)", conns.EnvVarSweepDependencyMaxWaves, v)
+		}
+
+		options.MaxWaves = maxWaves
+	}
+
+	if v := os.Getenv(conns.EnvVarSweepDependencyWaveDelay); v != "" {
+		waveDelay, err := time.ParseDuration(v)
+
+		if err != nil || waveDelay < 0 {
+			return nil, fmt.Errorf("environment variable %s: invalid wave delay (%s)", conns.EnvVarSweepDependencyWaveDelay, v)
+		}
+
+		options.WaveDelay = waveDelay
+	}
+
+	for _, optFn := range optFns {
+		optFn(options)
+	}
+
+	return options, nil
+}
+
+// sweepErrorHasCode returns whether the error is for any of the error codes.
+// Errors from Delete functions returning diagnostics only have their messages,
+// so error codes are matched in the error message.
+func sweepErrorHasCode(err error, codes []string) bool {
+	if err == nil {
+		return false
+	}
+
+	message := err.Error()
+
+	for _, code := range codes {
+		if strings.Contains(message, code+":") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isSweepThrottlingError(err error) bool {
+	return err != nil && (strings.Contains(err.Error(), "Throttling") || sweepErrorHasCode(err, sweepThrottlingErrorCodes))
+}
+
+func isSweepDependencyError(err error) bool {
+	return sweepErrorHasCode(err, sweepDependencyErrorCodes)
+}
+
+// sweepFailure is a resource that could not be deleted.
+type sweepFailure struct {
+	sweepResource *SweepResource
+	err           error
+}
+
+// sweepWave deletes the resources, at most concurrency at once, and returns the failed deletions.
+func sweepWave(sweepResources []*SweepResource, concurrency int, f func(*SweepResource) error) []sweepFailure {
+	var wg sync.WaitGroup
+	var semaphore chan struct{}
+	errs := make([]error, len(sweepResources))
+
+	if concurrency > 0 {
+		semaphore = make(chan struct{}, concurrency)
+	}
+
+	for i, sweepResource := range sweepResources {
+		i, sweepResource := i, sweepResource
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if semaphore != nil {
+				semaphore <- struct{}{}
+				defer func() { <-semaphore }()
+			}
+
+			errs[i] = f(sweepResource)
+		}()
+	}
+
+	wg.Wait()
+
+	var failures []sweepFailure
+
+	for i, err := range errs {
+		if err != nil {
+			failures = append(failures, sweepFailure{sweepResource: sweepResources[i], err: err})
+		}
+	}
+
+	return failures
+}
+
+// sweepWaves deletes the resources in waves.
+// Resources whose deletions fail because of dependent resources are re-deleted in later waves
+// until all are deleted, a wave deletes no resources, or the maximum number of waves is reached.
+// Only the resources of one sweeper are deleted, so dependent resources deleted by other sweepers
+// are not waited for.
+func sweepWaves(ctx context.Context, sweepResources []*SweepResource, options *SweepOrchestratorOptions, f func(*SweepResource) error) []sweepFailure {
+	var failures []sweepFailure
+
+	for wave := 1; len(sweepResources) > 0; wave++ {
+		waveFailures := sweepWave(sweepResources, options.Concurrency, f)
+
+		var dependencyFailures []sweepFailure
+
+		for _, failure := range waveFailures {
+			if isSweepDependencyError(failure.err) {
+				dependencyFailures = append(dependencyFailures, failure)
+			}
+		}
+
+		if len(dependencyFailures) == 0 || len(waveFailures) == len(sweepResources) || wave >= options.MaxWaves {
+			return append(failures, waveFailures...)
+		}
+
+		for _, failure := range waveFailures {
+			if !isSweepDependencyError(failure.err) {
+				failures = append(failures, failure)
+			}
+		}
+
+		log.Printf("[INFO] Sweeper wave %d: %d of %d resources failed to delete because of dependent resources. Retrying...", wave, len(dependencyFailures), len(sweepResources))
+
+		select {
+		case <-ctx.Done():
+			return append(failures, dependencyFailures...)
+		case <-time.After(options.WaveDelay):
+		}
+
+		sweepResources = nil
+
+		for _, failure := range dependencyFailures {
+			sweepResources = append(sweepResources, failure.sweepResource)
+		}
+	}
+
+	return failures
+}
+
+// deleteSweepResource deletes the resource, retrying on throttling errors.
+func deleteSweepResource(ctx context.Context, sweepResource *SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
+	err := tfresource.RetryConfigContext(ctx, delay, delayRand, minTimeout, pollInterval, timeout, func() *resource.RetryError {
+		err := deleteResource(ctx, sweepResource.resource, sweepResource.d, sweepResource.meta)
+
+		if err != nil {
+			if isSweepThrottlingError(err) {
+				log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sweepResource.d.Id(), err)
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		err = deleteResource(ctx, sweepResource.resource, sweepResource.d, sweepResource.meta)
+	}
+
+	return err
+}
+
+// sweepSummary logs the resources that remain and returns their errors.
+func sweepSummary(total int, failures []sweepFailure) error {
+	if len(failures) == 0 {
+		return nil
+	}
+
+	var errs *multierror.Error
+	var summary strings.Builder
+	var dependencyFailures int
+
+	fmt.Fprintf(&summary, "%d of %d resources remain:", len(failures), total)
+
+	for _, failure := range failures {
+		fmt.Fprintf(&summary, "\n  - %s: %s", failure.sweepResource.d.Id(), failure.err)
+
+		if isSweepDependencyError(failure.err) {
+			dependencyFailures++
+		}
+
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping resource (%s): %w", failure.sweepResource.d.Id(), failure.err))
+	}
+
+	if dependencyFailures > 0 {
+		fmt.Fprintf(&summary, "\n%d resources remain because of dependent resources. Dependent resources are only waited for if deleted by the same sweeper:"+
+			" re-run the sweepers to delete resources that depend on resources deleted by other sweepers, or set %s or %s to wait for longer.",
+			dependencyFailures, conns.EnvVarSweepDependencyMaxWaves, conns.EnvVarSweepDependencyWaveDelay)
+	}
+
+	log.Printf("[WARN] Sweeper summary: %s", summary.String())
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,258 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func testSweepResources(ids ...string) []*SweepResource {
+	r := &schema.Resource{}
+	var sweepResources []*SweepResource
+
+	for _, id := range ids {
+		d := r.Data(nil)
+		d.SetId(id)
+		sweepResources = append(sweepResources, NewSweepResource(r, d, nil))
+	}
+
+	return sweepResources
+}
+
+func TestSweepErrorCodes(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		Err                error
+		ExpectedThrottling bool
+		ExpectedDependency bool
+	}{
+		{
+			Name: "nil",
+		},
+		{
+			Name: "other",
+			Err:  errors.New("error deleting resource: InvalidParameterValue: invalid"),
+		},
+		{
+			Name:               "Throttling",
+			Err:                errors.New("error deleting resource: Throttling: Rate exceeded"),
+			ExpectedThrottling: true,
+		},
+		{
+			Name:               "RequestLimitExceeded",
+			Err:                errors.New("error deleting resource: RequestLimitExceeded: Request limit exceeded."),
+			ExpectedThrottling: true,
+		},
+		{
+			Name:               "TooManyRequestsException SDK v2",
+			Err:                errors.New("operation error Lambda: DeleteFunction, https response error StatusCode: 429, api error TooManyRequestsException: Rate exceeded"),
+			ExpectedThrottling: true,
+		},
+		{
+			Name:               "DependencyViolation",
+			Err:                errors.New("error deleting EC2 Subnet (subnet-12345678): DependencyViolation: The subnet has dependencies and cannot be deleted."),
+			ExpectedDependency: true,
+		},
+		{
+			Name:               "ResourceInUseException",
+			Err:                errors.New("error deleting resource: ResourceInUseException: in use"),
+			ExpectedDependency: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got, want := isSweepThrottlingError(testCase.Err), testCase.ExpectedThrottling; got != want {
+				t.Errorf("throttling got %t, want %t", got, want)
+			}
+
+			if got, want := isSweepDependencyError(testCase.Err), testCase.ExpectedDependency; got != want {
+				t.Errorf("dependency got %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestSweepWaves(t *testing.T) {
+	options := &SweepOrchestratorOptions{
+		MaxWaves: SweepDependencyMaxWaves,
+	}
+
+	// "vpc" can only be deleted once "subnet" is deleted, which can only be deleted once "eni" is deleted.
+	var lock sync.Mutex
+	deleted := make(map[string]bool)
+	dependents := map[string]string{
+		"subnet": "eni",
+		"vpc":    "subnet",
+	}
+
+	failures := sweepWaves(context.Background(), testSweepResources("vpc", "subnet", "eni", "other"), options, func(sweepResource *SweepResource) error {
+		lock.Lock()
+		defer lock.Unlock()
+
+		id := sweepResource.d.Id()
+
+		if id == "other" {
+			return errors.New("InvalidParameterValue: invalid")
+		}
+
+		if dependent, ok := dependents[id]; ok && !deleted[dependent] {
+			return errors.New("DependencyViolation: has dependencies")
+		}
+
+		deleted[id] = true
+
+		return nil
+	})
+
+	for _, id := range []string{"eni", "subnet", "vpc"} {
+		if !deleted[id] {
+			t.Errorf("%s not deleted", id)
+		}
+	}
+
+	if got, want := len(failures), 1; got != want {
+		t.Fatalf("failures got %d, want %d", got, want)
+	}
+
+	if got, want := failures[0].sweepResource.d.Id(), "other"; got != want {
+		t.Errorf("failure got %s, want %s", got, want)
+	}
+}
+
+func TestSweepWavesNoProgress(t *testing.T) {
+	options := &SweepOrchestratorOptions{
+		MaxWaves: SweepDependencyMaxWaves,
+	}
+
+	var calls int32
+
+	failures := sweepWaves(context.Background(), testSweepResources("subnet"), options, func(sweepResource *SweepResource) error {
+		atomic.AddInt32(&calls, 1)
+
+		return errors.New("DependencyViolation: has dependencies")
+	})
+
+	if got, want := atomic.LoadInt32(&calls), int32(1); got != want {
+		t.Errorf("calls got %d, want %d", got, want)
+	}
+
+	if got, want := len(failures), 1; got != want {
+		t.Errorf("failures got %d, want %d", got, want)
+	}
+
+	if err := sweepSummary(1, failures); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestSweepWaveConcurrency(t *testing.T) {
+	const concurrency = 2
+
+	var current, max int32
+
+	failures := sweepWave(testSweepResources("a", "b", "c", "d", "e", "f"), concurrency, func(sweepResource *SweepResource) error {
+		n := atomic.AddInt32(&current, 1)
+		defer atomic.AddInt32(&current, -1)
+
+		for {
+			m := atomic.LoadInt32(&max)
+			if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+
+		return nil
+	})
+
+	if len(failures) != 0 {
+		t.Errorf("unexpected failures: %d", len(failures))
+	}
+
+	if got := atomic.LoadInt32(&max); got > concurrency {
+		t.Errorf("concurrency got %d, want at most %d", got, concurrency)
+	}
+}
+
+func TestNewSweepOrchestratorOptions(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		MaxWaves          string
+		WaveDelay         string
+		OptFns            []func(*SweepOrchestratorOptions)
+		ExpectedMaxWaves  int
+		ExpectedWaveDelay time.Duration
+		ExpectedError     bool
+	}{
+		{
+			Name:              "defaults",
+			ExpectedMaxWaves:  SweepDependencyMaxWaves,
+			ExpectedWaveDelay: SweepDependencyWaveDelay,
+		},
+		{
+			Name:              "environment variables",
+			MaxWaves:          "10",
+			WaveDelay:         "1m",
+			ExpectedMaxWaves:  10,
+			ExpectedWaveDelay: time.Minute,
+		},
+		{
+			Name:              "option functions",
+			MaxWaves:          "10",
+			WaveDelay:         "1m",
+			OptFns:            []func(*SweepOrchestratorOptions){WithMaxWaves(2), WithWaveDelay(time.Second)},
+			ExpectedMaxWaves:  2,
+			ExpectedWaveDelay: time.Second,
+		},
+		{
+			Name:          "invalid max waves",
+			MaxWaves:      "0",
+			ExpectedError: true,
+		},
+		{
+			Name:          "invalid wave delay",
+			WaveDelay:     "30",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Setenv(conns.EnvVarSweepDependencyMaxWaves, testCase.MaxWaves)
+			t.Setenv(conns.EnvVarSweepDependencyWaveDelay, testCase.WaveDelay)
+
+			options, err := newSweepOrchestratorOptions(testCase.OptFns...)
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := options.MaxWaves, testCase.ExpectedMaxWaves; got != want {
+				t.Errorf("max waves got %d, want %d", got, want)
+			}
+
+			if got, want := options.WaveDelay, testCase.ExpectedWaveDelay; got != want {
+				t.Errorf("wave delay got %s, want %s", got, want)
+			}
+		})
+	}
+}
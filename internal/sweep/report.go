@@ -0,0 +1,290 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// SweepReportEntry is a resource that would be deleted when sweeping in dry-run mode.
+type SweepReportEntry struct {
+	ID     string            `json:"id"`
+	Region string            `json:"region"`
+	Tags   map[string]string `json:"tags,omitempty"`
+}
+
+// SweepReportRejectedOperation is an API operation made by a sweeper that does not delete resources
+// with SweepOrchestrator or DeleteResource and so was rejected in dry-run mode or because tag filters are set.
+type SweepReportRejectedOperation struct {
+	Operation string `json:"operation"`
+	Region    string `json:"region"`
+	Service   string `json:"service"`
+}
+
+// SweepReportReadError is a resource that could not be read to determine its tags
+// in dry-run mode or when tag filters are set, and so was neither reported nor swept.
+type SweepReportReadError struct {
+	ID     string `json:"id"`
+	Region string `json:"region"`
+	Error  string `json:"error"`
+}
+
+// SweepReport is the sweeper report.
+type SweepReport struct {
+	Resources          []SweepReportEntry             `json:"resources"`
+	RejectedOperations []SweepReportRejectedOperation `json:"rejected_operations,omitempty"`
+	ReadErrors         []SweepReportReadError         `json:"read_errors,omitempty"`
+}
+
+var sweepReport struct {
+	lock   sync.Mutex
+	report SweepReport
+}
+
+// DryRun returns whether sweepers should report, instead of delete, resources.
+func DryRun() bool {
+	return os.Getenv(conns.EnvVarSweepDryRun) != ""
+}
+
+// filtered returns whether sweepers are in dry-run mode or tag filters are set.
+// Only resources deleted with SweepOrchestrator or DeleteResource are then filtered and reported.
+func filtered() bool {
+	return DryRun() || os.Getenv(conns.EnvVarSweepIncludeTags) != "" || os.Getenv(conns.EnvVarSweepExcludeTags) != ""
+}
+
+// tagFilter matches resource tags.
+// A nil value matches any value for the key.
+type tagFilter map[string]*string
+
+// parseTagFilter parses a comma-separated list of "key" or "key=value" entries.
+func parseTagFilter(s string) tagFilter {
+	if s == "" {
+		return nil
+	}
+
+	filter := make(tagFilter)
+
+	for _, v := range strings.Split(s, ",") {
+		v = strings.TrimSpace(v)
+
+		if v == "" {
+			continue
+		}
+
+		if parts := strings.SplitN(v, "=", 2); len(parts) == 2 {
+			filter[parts[0]] = &parts[1]
+		} else {
+			filter[v] = nil
+		}
+	}
+
+	return filter
+}
+
+// matchesAny returns whether any of the tags match the filter.
+func (filter tagFilter) matchesAny(tags map[string]string) bool {
+	for k, want := range filter {
+		if got, ok := tags[k]; ok && (want == nil || *want == got) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// includeSweepResource returns whether a resource with the specified tags may be swept
+// according to the include and exclude tag filters.
+// If the include filter is set, untagged resources are not swept.
+func includeSweepResource(tags map[string]string, include, exclude tagFilter) bool {
+	if len(include) > 0 && !include.matchesAny(tags) {
+		return false
+	}
+
+	if len(exclude) > 0 && exclude.matchesAny(tags) {
+		return false
+	}
+
+	return true
+}
+
+// filterSweepResources returns the resources to sweep.
+// When in dry-run mode or tag filters are set, each resource is read to determine its tags
+// and resources that no longer exist are removed.
+// Resources that cannot be read are reported and skipped, so that the remaining resources are still swept.
+func filterSweepResources(ctx context.Context, sweepResources []*SweepResource) ([]*SweepResource, error) {
+	include := parseTagFilter(os.Getenv(conns.EnvVarSweepIncludeTags))
+	exclude := parseTagFilter(os.Getenv(conns.EnvVarSweepExcludeTags))
+
+	if !filtered() {
+		return sweepResources, nil
+	}
+
+	var result []*SweepResource
+
+	for _, sweepResource := range sweepResources {
+		if err := readSweepResource(ctx, sweepResource); err != nil {
+			reportReadError(sweepResource, err)
+			continue
+		}
+
+		if sweepResource.d.Id() == "" {
+			continue
+		}
+
+		if !includeSweepResource(sweepResourceTags(sweepResource), include, exclude) {
+			log.Printf("[INFO] Skipping resource (%s): excluded by tag filters", sweepResource.d.Id())
+			continue
+		}
+
+		result = append(result, sweepResource)
+	}
+
+	return result, nil
+}
+
+// readSweepResource refreshes the resource's data using the resource's Read function.
+func readSweepResource(ctx context.Context, sweepResource *SweepResource) error {
+	r, d, meta := sweepResource.resource, sweepResource.d, sweepResource.meta
+
+	if r.ReadContext != nil || r.ReadWithoutTimeout != nil {
+		var diags diag.Diagnostics
+
+		if r.ReadContext != nil {
+			diags = r.ReadContext(ctx, d, meta)
+		} else {
+			diags = r.ReadWithoutTimeout(ctx, d, meta)
+		}
+
+		for i := range diags {
+			if diags[i].Severity == diag.Error {
+				return fmt.Errorf("%s", diags[i].Summary)
+			}
+		}
+
+		return nil
+	}
+
+	if r.Read != nil {
+		return r.Read(d, meta)
+	}
+
+	return nil
+}
+
+// sweepResourceTags returns the resource's tags, including any provider default tags.
+func sweepResourceTags(sweepResource *SweepResource) map[string]string {
+	for _, k := range []string{"tags_all", "tags"} {
+		if _, ok := sweepResource.resource.Schema[k]; !ok {
+			continue
+		}
+
+		tags := make(map[string]string)
+
+		for k, v := range sweepResource.d.Get(k).(map[string]interface{}) {
+			tags[k] = v.(string)
+		}
+
+		return tags
+	}
+
+	return nil
+}
+
+// reportSweepResources adds the resources to the dry-run report and writes the report.
+func reportSweepResources(sweepResources []*SweepResource) error {
+	sweepReport.lock.Lock()
+	defer sweepReport.lock.Unlock()
+
+	for _, sweepResource := range sweepResources {
+		entry := SweepReportEntry{
+			ID:   sweepResource.d.Id(),
+			Tags: sweepResourceTags(sweepResource),
+		}
+
+		if client, ok := sweepResource.meta.(*conns.AWSClient); ok {
+			entry.Region = client.Region
+		}
+
+		log.Printf("[INFO] Dry run: would delete resource (%s)", entry.ID)
+
+		sweepReport.report.Resources = append(sweepReport.report.Resources, entry)
+	}
+
+	return writeSweepReport()
+}
+
+// reportRejectedOperation adds the API operation rejected by a read-only sweeper client to the report
+// and writes the report.
+// Sweepers that delete resources other than with SweepOrchestrator or DeleteResource fail in dry-run mode
+// or when tag filters are set, instead of deleting resources that would not be reported or filtered.
+func reportRejectedOperation(region string, err *conns.ReadOnlyError) {
+	sweepReport.lock.Lock()
+	defer sweepReport.lock.Unlock()
+
+	log.Printf("[WARN] Sweeper API operation rejected (%s %s in %s): sweepers must delete resources with sweep.SweepOrchestrator or sweep.DeleteResource in dry-run mode or when tag filters are set", err.Service, err.Operation, region)
+
+	sweepReport.report.RejectedOperations = append(sweepReport.report.RejectedOperations, SweepReportRejectedOperation{
+		Operation: err.Operation,
+		Region:    region,
+		Service:   err.Service,
+	})
+
+	if err := writeSweepReport(); err != nil {
+		log.Printf("[WARN] %s", err)
+	}
+}
+
+// reportReadError adds the resource that could not be read to the report and writes the report.
+func reportReadError(sweepResource *SweepResource, err error) {
+	sweepReport.lock.Lock()
+	defer sweepReport.lock.Unlock()
+
+	entry := SweepReportReadError{
+		ID:    sweepResource.d.Id(),
+		Error: err.Error(),
+	}
+
+	if client, ok := sweepResource.meta.(*conns.AWSClient); ok {
+		entry.Region = client.Region
+	}
+
+	log.Printf("[WARN] Skipping resource (%s): error reading resource: %s", entry.ID, err)
+
+	sweepReport.report.ReadErrors = append(sweepReport.report.ReadErrors, entry)
+
+	if err := writeSweepReport(); err != nil {
+		log.Printf("[WARN] %s", err)
+	}
+}
+
+// writeSweepReport writes the report to the file named by the environment variable, if set, otherwise to the log.
+// The report lock must be held.
+func writeSweepReport() error {
+	b, err := json.MarshalIndent(sweepReport.report, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("error marshalling sweeper report: %w", err)
+	}
+
+	if path := os.Getenv(conns.EnvVarSweepReportFile); path != "" {
+		if err := os.WriteFile(path, b, 0644); err != nil {
+			return fmt.Errorf("error writing sweeper report (%s): %w", path, err)
+		}
+
+		return nil
+	}
+
+	log.Printf("[INFO] Sweeper report:\n%s", b)
+
+	return nil
+}
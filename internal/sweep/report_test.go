@@ -0,0 +1,279 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestIncludeSweepResource(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Tags     map[string]string
+		Include  string
+		Exclude  string
+		Expected bool
+	}{
+		{
+			Name:     "no filters",
+			Tags:     map[string]string{"key1": "value1"},
+			Expected: true,
+		},
+		{
+			Name:     "no filters untagged",
+			Expected: true,
+		},
+		{
+			Name:     "include key",
+			Tags:     map[string]string{"key1": "value1"},
+			Include:  "key1",
+			Expected: true,
+		},
+		{
+			Name:     "include key value",
+			Tags:     map[string]string{"key1": "value1"},
+			Include:  "key2=value2, key1=value1",
+			Expected: true,
+		},
+		{
+			Name:     "include key value mismatch",
+			Tags:     map[string]string{"key1": "value1"},
+			Include:  "key1=value2",
+			Expected: false,
+		},
+		{
+			Name:     "include untagged",
+			Include:  "key1",
+			Expected: false,
+		},
+		{
+			Name:     "exclude key",
+			Tags:     map[string]string{"key1": "value1"},
+			Exclude:  "key1",
+			Expected: false,
+		},
+		{
+			Name:     "exclude key value mismatch",
+			Tags:     map[string]string{"key1": "value1"},
+			Exclude:  "key1=value2",
+			Expected: true,
+		},
+		{
+			Name:     "include and exclude",
+			Tags:     map[string]string{"key1": "value1", "key2": "value2"},
+			Include:  "key1",
+			Exclude:  "key2=value2",
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := includeSweepResource(testCase.Tags, parseTagFilter(testCase.Include), parseTagFilter(testCase.Exclude))
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func testSweepReportResource() (*schema.Resource, *schema.ResourceData, *bool) {
+	deleted := false
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return d.Set("tags", map[string]string{"key1": "value1"})
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			deleted = true
+			return nil
+		},
+	}
+	d := r.Data(nil)
+	d.SetId("test")
+
+	return r, d, &deleted
+}
+
+func TestDeleteResourceDryRun(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sweep.json")
+	t.Setenv(conns.EnvVarSweepDryRun, "1")
+	t.Setenv(conns.EnvVarSweepReportFile, path)
+
+	sweepReport.lock.Lock()
+	sweepReport.report = SweepReport{}
+	sweepReport.lock.Unlock()
+
+	r, d, deleted := testSweepReportResource()
+
+	if err := DeleteResource(r, d, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if *deleted {
+		t.Error("resource deleted in dry-run mode")
+	}
+
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		t.Fatalf("error reading report: %s", err)
+	}
+
+	var report SweepReport
+
+	if err := json.Unmarshal(b, &report); err != nil {
+		t.Fatalf("error unmarshalling report: %s", err)
+	}
+
+	if len(report.Resources) != 1 || report.Resources[0].ID != "test" || report.Resources[0].Tags["key1"] != "value1" {
+		t.Errorf("unexpected report resources: %v", report.Resources)
+	}
+}
+
+func TestDeleteResourceTagFilters(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Include  string
+		Exclude  string
+		Expected bool
+	}{
+		{
+			Name:     "no filters",
+			Expected: true,
+		},
+		{
+			Name:     "include",
+			Include:  "key1=value1",
+			Expected: true,
+		},
+		{
+			Name:     "include mismatch",
+			Include:  "key2",
+			Expected: false,
+		},
+		{
+			Name:     "exclude",
+			Exclude:  "key1",
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Setenv(conns.EnvVarSweepIncludeTags, testCase.Include)
+			t.Setenv(conns.EnvVarSweepExcludeTags, testCase.Exclude)
+
+			r, d, deleted := testSweepReportResource()
+
+			if err := DeleteResource(r, d, nil); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if *deleted != testCase.Expected {
+				t.Errorf("got deleted %t, expected %t", *deleted, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestFilterSweepResourcesReadError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sweep.json")
+	t.Setenv(conns.EnvVarSweepIncludeTags, "key1")
+	t.Setenv(conns.EnvVarSweepReportFile, path)
+
+	sweepReport.lock.Lock()
+	sweepReport.report = SweepReport{}
+	sweepReport.lock.Unlock()
+
+	r1, d1, _ := testSweepReportResource()
+	d1.SetId("test1")
+	r2, d2, _ := testSweepReportResource()
+	d2.SetId("test2")
+	r2.Read = func(d *schema.ResourceData, meta interface{}) error {
+		return errors.New("AccessDenied")
+	}
+	r3, d3, _ := testSweepReportResource()
+	d3.SetId("test3")
+
+	sweepResources, err := filterSweepResources(context.Background(), []*SweepResource{
+		NewSweepResource(r1, d1, nil),
+		NewSweepResource(r2, d2, nil),
+		NewSweepResource(r3, d3, nil),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(sweepResources), 2; got != expected {
+		t.Fatalf("got %d resources, expected %d", got, expected)
+	}
+
+	if got, expected := sweepResources[1].d.Id(), "test3"; got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		t.Fatalf("error reading report: %s", err)
+	}
+
+	var report SweepReport
+
+	if err := json.Unmarshal(b, &report); err != nil {
+		t.Fatalf("error unmarshalling report: %s", err)
+	}
+
+	if len(report.ReadErrors) != 1 || report.ReadErrors[0].ID != "test2" || report.ReadErrors[0].Error != "AccessDenied" {
+		t.Errorf("unexpected report read errors: %v", report.ReadErrors)
+	}
+}
+
+func TestReportRejectedOperation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sweep.json")
+	t.Setenv(conns.EnvVarSweepReportFile, path)
+
+	sweepReport.lock.Lock()
+	sweepReport.report = SweepReport{}
+	sweepReport.lock.Unlock()
+
+	reportRejectedOperation("us-west-2", &conns.ReadOnlyError{Operation: "DeleteTopic", Service: "SNS"}) //lintignore:AWSAT003
+
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		t.Fatalf("error reading report: %s", err)
+	}
+
+	var report SweepReport
+
+	if err := json.Unmarshal(b, &report); err != nil {
+		t.Fatalf("error unmarshalling report: %s", err)
+	}
+
+	if got, expected := len(report.RejectedOperations), 1; got != expected {
+		t.Fatalf("got %d rejected operations, expected %d", got, expected)
+	}
+
+	if got, expected := report.RejectedOperations[0].Operation, "DeleteTopic"; got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+}
@@ -6,20 +6,16 @@ package sweep
 import (
 	"context"
 	"fmt"
-	"log"
 	"os"
 	"strconv"
-	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -32,8 +28,19 @@ const defaultSweeperAssumeRoleDurationSeconds = 3600
 
 // SweeperClients is a shared cache of regional conns.AWSClient
 // This prevents client re-initialization for every resource with no benefit.
+// In dry-run mode, or when tag filters are set, the clients are read-only so that
+// sweepers cannot delete resources other than with SweepOrchestrator or DeleteResource.
 var SweeperClients map[string]interface{}
 
+// sweeperWritableClients is a shared cache of regional conns.AWSClient used by SweepOrchestrator
+// and DeleteResource to delete resources when SweeperClients are read-only.
+var sweeperWritableClients = struct {
+	lock    sync.Mutex
+	clients map[string]interface{}
+}{
+	clients: make(map[string]interface{}),
+}
+
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper
 // functions for a given region
 func SharedRegionalSweepClient(region string) (interface{}, error) {
@@ -45,6 +52,42 @@ func SharedRegionalSweepClientWithContext(ctx context.Context, region string) (i
 		return client, nil
 	}
 
+	client, err := newSweepClient(ctx, region, filtered())
+	if err != nil {
+		return nil, err
+	}
+
+	SweeperClients[region] = client
+
+	return client, nil
+}
+
+// writableSweepClient returns a conns.AWSClient that may delete resources in the region of the specified client.
+func writableSweepClient(ctx context.Context, meta interface{}) (interface{}, error) {
+	client, ok := meta.(*conns.AWSClient)
+
+	if !ok || !filtered() {
+		return meta, nil
+	}
+
+	sweeperWritableClients.lock.Lock()
+	defer sweeperWritableClients.lock.Unlock()
+
+	if writableClient, ok := sweeperWritableClients.clients[client.Region]; ok {
+		return writableClient, nil
+	}
+
+	writableClient, err := newSweepClient(ctx, client.Region, false)
+	if err != nil {
+		return nil, err
+	}
+
+	sweeperWritableClients.clients[client.Region] = writableClient
+
+	return writableClient, nil
+}
+
+func newSweepClient(ctx context.Context, region string, readOnly bool) (interface{}, error) {
 	_, _, err := conns.RequireOneOfEnvVar([]string{conns.EnvVarProfile, conns.EnvVarAccessKeyId, conns.EnvVarContainerCredentialsFullURI}, "credentials for running sweepers")
 	if err != nil {
 		return nil, err
@@ -63,6 +106,13 @@ func SharedRegionalSweepClientWithContext(ctx context.Context, region string) (i
 		SuppressDebugLog: true,
 	}
 
+	if readOnly {
+		conf.ReadOnly = true
+		conf.ReadOnlyErrorFunc = func(err *conns.ReadOnlyError) {
+			reportRejectedOperation(region, err)
+		}
+	}
+
 	if role := os.Getenv(conns.EnvVarAssumeRoleARN); role != "" {
 		conf.AssumeRole.RoleARN = role
 
@@ -90,8 +140,6 @@ func SharedRegionalSweepClientWithContext(ctx context.Context, region string) (i
 		return nil, fmt.Errorf("error getting AWS client: %#v", diags)
 	}
 
-	SweeperClients[region] = client
-
 	return client, nil
 }
 
@@ -113,37 +161,31 @@ func SweepOrchestrator(sweepResources []*SweepResource) error {
 	return SweepOrchestratorWithContext(context.Background(), sweepResources, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, SweepThrottlingRetryTimeout)
 }
 
-func SweepOrchestratorWithContext(ctx context.Context, sweepResources []*SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
-	var g multierror.Group
-
-	for _, sweepResource := range sweepResources {
-		sweepResource := sweepResource
-
-		g.Go(func() error {
-			err := tfresource.RetryConfigContext(ctx, delay, delayRand, minTimeout, pollInterval, timeout, func() *resource.RetryError {
-				err := DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
+// SweepOrchestratorWithContext deletes the resources, retrying throttled deletions until the timeout.
+// Resources whose deletions fail because of dependent resources are re-deleted in later waves.
+// The resources that remain are logged in a summary.
+func SweepOrchestratorWithContext(ctx context.Context, sweepResources []*SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration, optFns ...func(*SweepOrchestratorOptions)) error {
+	options, err := newSweepOrchestratorOptions(optFns...)
 
-				if err != nil {
-					if strings.Contains(err.Error(), "Throttling") {
-						log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sweepResource.d.Id(), err)
-						return resource.RetryableError(err)
-					}
-
-					return resource.NonRetryableError(err)
-				}
+	if err != nil {
+		return err
+	}
 
-				return nil
-			})
+	sweepResources, err = filterSweepResources(ctx, sweepResources)
 
-			if tfresource.TimedOut(err) {
-				err = DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
-			}
+	if err != nil {
+		return err
+	}
 
-			return err
-		})
+	if DryRun() {
+		return reportSweepResources(sweepResources)
 	}
 
-	return g.Wait().ErrorOrNil()
+	failures := sweepWaves(ctx, sweepResources, options, func(sweepResource *SweepResource) error {
+		return deleteSweepResource(ctx, sweepResource, delay, delayRand, minTimeout, pollInterval, timeout)
+	})
+
+	return sweepSummary(len(sweepResources), failures)
 }
 
 // Check sweeper API call error for reasons to skip sweeping
@@ -212,14 +254,44 @@ func SkipSweepError(err error) bool {
 	return false
 }
 
+// DeleteResource deletes the resource using the resource's Delete function.
+// In dry-run mode, or when tag filters are set, the resource is handled as by SweepOrchestrator:
+// it is read and then either skipped, added to the dry-run report or deleted.
 func DeleteResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) error {
+	ctx := context.Background()
+
+	sweepResources, err := filterSweepResources(ctx, []*SweepResource{NewSweepResource(resource, d, meta)})
+
+	if err != nil {
+		return err
+	}
+
+	if len(sweepResources) == 0 {
+		return nil
+	}
+
+	if DryRun() {
+		return reportSweepResources(sweepResources)
+	}
+
+	return deleteResource(ctx, resource, d, meta)
+}
+
+// deleteResource deletes the resource using the resource's Delete function.
+func deleteResource(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta interface{}) error {
+	meta, err := writableSweepClient(ctx, meta)
+
+	if err != nil {
+		return err
+	}
+
 	if resource.DeleteContext != nil || resource.DeleteWithoutTimeout != nil {
 		var diags diag.Diagnostics
 
 		if resource.DeleteContext != nil {
-			diags = resource.DeleteContext(context.Background(), d, meta)
+			diags = resource.DeleteContext(ctx, d, meta)
 		} else {
-			diags = resource.DeleteWithoutTimeout(context.Background(), d, meta)
+			diags = resource.DeleteWithoutTimeout(ctx, d, meta)
 		}
 
 		for i := range diags {
@@ -87,6 +87,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/lexmodels"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/location"
@@ -100,6 +101,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/pipes"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
@@ -111,11 +113,13 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sagemaker"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/scheduler"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/schemas"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalog"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/servicediscovery"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ses"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
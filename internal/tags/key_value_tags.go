@@ -8,6 +8,7 @@ import (
 	"sort"
 	"strings"
 
+	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 )
 
@@ -26,8 +27,17 @@ type DefaultConfig struct {
 
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
-	Keys        KeyValueTags
-	KeyPrefixes KeyValueTags
+	Keys         KeyValueTags
+	KeyPrefixes  KeyValueTags
+	KeyRegexes   []*regexp.Regexp
+	ValueRegexes []*regexp.Regexp
+}
+
+// RequiredConfig contains tags that must be present on all resources.
+type RequiredConfig struct {
+	ExemptResourceTypes []string
+	Keys                KeyValueTags
+	ValueRegexes        map[string]*regexp.Regexp
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -88,6 +98,34 @@ func (dc *DefaultConfig) TagsEqual(tags KeyValueTags) bool {
 	return dc.Tags.ContainsAll(tags)
 }
 
+// Validate returns an error for each required tag key that is missing
+// from the given tags or whose value does not match the key's value regex.
+func (rc *RequiredConfig) Validate(tags KeyValueTags) error {
+	if rc == nil {
+		return nil
+	}
+
+	var errs *multierror.Error
+
+	keys := rc.Keys.Keys()
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v := tags.KeyValue(k)
+
+		if v == nil {
+			errs = multierror.Append(errs, fmt.Errorf("missing required tag %q", k))
+			continue
+		}
+
+		if r, ok := rc.ValueRegexes[k]; ok && r != nil && !r.MatchString(*v) {
+			errs = multierror.Append(errs, fmt.Errorf("tag %q value %q does not match %q", k, *v, r.String()))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
 // IgnoreConfig returns any tags not removed by a given configuration.
 func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 	if config == nil {
@@ -96,6 +134,8 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
 	result = result.Ignore(config.Keys)
+	result = result.IgnoreKeyRegexes(config.KeyRegexes)
+	result = result.IgnoreValueRegexes(config.ValueRegexes)
 
 	return result
 }
@@ -147,6 +187,56 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnoreKeyRegexes returns tags whose keys do not match any of the regular expressions.
+func (tags KeyValueTags) IgnoreKeyRegexes(regexes []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for _, regex := range regexes {
+			if regex.MatchString(k) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreValueRegexes returns tags whose values do not match any of the regular expressions.
+func (tags KeyValueTags) IgnoreValueRegexes(regexes []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		if v != nil && v.Value != nil {
+			for _, regex := range regexes {
+				if regex.MatchString(*v.Value) {
+					ignore = true
+					break
+				}
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreRDS returns non-AWS and non-RDS tag keys.
 func (tags KeyValueTags) IgnoreRDS() KeyValueTags {
 	result := make(KeyValueTags)
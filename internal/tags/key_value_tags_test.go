@@ -1,6 +1,7 @@
 package tags
 
 import (
+	"regexp"
 	"testing"
 )
 
@@ -291,6 +292,129 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsRequiredConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name           string
+		tags           KeyValueTags
+		requiredConfig *RequiredConfig
+		wantErr        string
+	}{
+		{
+			name: "no config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			requiredConfig: nil,
+		},
+		{
+			name: "empty config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			requiredConfig: &RequiredConfig{},
+		},
+		{
+			name: "keys all present",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			requiredConfig: &RequiredConfig{
+				Keys: New([]string{
+					"key1",
+					"key2",
+				}),
+			},
+		},
+		{
+			name: "keys some missing",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			requiredConfig: &RequiredConfig{
+				Keys: New([]string{
+					"key1",
+					"key2",
+					"key3",
+				}),
+			},
+			wantErr: `2 errors occurred:
+	* missing required tag "key2"
+	* missing required tag "key3"
+
+`,
+		},
+		{
+			name: "no tags",
+			tags: New(map[string]string{}),
+			requiredConfig: &RequiredConfig{
+				Keys: New([]string{
+					"key1",
+				}),
+			},
+			wantErr: `1 error occurred:
+	* missing required tag "key1"
+
+`,
+		},
+		{
+			name: "value matching",
+			tags: New(map[string]string{
+				"key1": "cc-1234",
+			}),
+			requiredConfig: &RequiredConfig{
+				Keys: New([]string{
+					"key1",
+				}),
+				ValueRegexes: map[string]*regexp.Regexp{
+					"key1": regexp.MustCompile(`^cc-[0-9]{4}$`),
+				},
+			},
+		},
+		{
+			name: "value not matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			requiredConfig: &RequiredConfig{
+				Keys: New([]string{
+					"key1",
+				}),
+				ValueRegexes: map[string]*regexp.Regexp{
+					"key1": regexp.MustCompile(`^cc-[0-9]{4}$`),
+				},
+			},
+			wantErr: `1 error occurred:
+	* tag "key1" value "value1" does not match "^cc-[0-9]{4}$"
+
+`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.requiredConfig.Validate(testCase.tags)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error: %s", testCase.wantErr)
+			}
+
+			if got, want := err.Error(), testCase.wantErr; got != want {
+				t.Errorf("got error %q, want %q", got, want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAWS(t *testing.T) { // nosemgrep:aws-in-func-name
 	testCases := []struct {
 		name string
@@ -533,6 +657,67 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key regexes some matching",
+			tags: New(map[string]string{
+				"kubernetes.io/cluster/test": "owned",
+				"Team:CreatedBy":             "someone",
+				"key3":                       "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyRegexes: []*regexp.Regexp{
+					regexp.MustCompile(`^kubernetes\.io/cluster/`),
+					regexp.MustCompile(`(?i):createdby$`),
+				},
+			},
+			want: map[string]string{
+				"key3": "value3",
+			},
+		},
+		{
+			name: "value regexes some matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "MANAGED-BY-TOOL",
+				"key3": "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				ValueRegexes: []*regexp.Regexp{
+					regexp.MustCompile(`(?i)^managed-by-`),
+				},
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "all options",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+				"key4": "value4",
+				"key5": "value5",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys: New([]string{
+					"key1",
+				}),
+				KeyPrefixes: New([]string{
+					"key2",
+				}),
+				KeyRegexes: []*regexp.Regexp{
+					regexp.MustCompile(`3$`),
+				},
+				ValueRegexes: []*regexp.Regexp{
+					regexp.MustCompile(`^value4$`),
+				},
+			},
+			want: map[string]string{
+				"key5": "value5",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -689,6 +874,146 @@ func TestKeyValueTagsIgnorePrefixes(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsIgnoreKeyRegexes(t *testing.T) {
+	testCases := []struct {
+		name    string
+		tags    KeyValueTags
+		regexes []*regexp.Regexp
+		want    map[string]string
+	}{
+		{
+			name: "empty regexes",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			regexes: nil,
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "all matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			regexes: []*regexp.Regexp{
+				regexp.MustCompile(`^key`),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "some matching case-insensitive",
+			tags: New(map[string]string{
+				"CreatedBy": "value1",
+				"createdby": "value2",
+				"key3":      "value3",
+			}),
+			regexes: []*regexp.Regexp{
+				regexp.MustCompile(`(?i)^createdby$`),
+			},
+			want: map[string]string{
+				"key3": "value3",
+			},
+		},
+		{
+			name: "none matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			regexes: []*regexp.Regexp{
+				regexp.MustCompile(`^value`),
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreKeyRegexes(testCase.regexes)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnoreValueRegexes(t *testing.T) {
+	testCases := []struct {
+		name    string
+		tags    KeyValueTags
+		regexes []*regexp.Regexp
+		want    map[string]string
+	}{
+		{
+			name: "empty regexes",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			regexes: nil,
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "some matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "generated-1234",
+			}),
+			regexes: []*regexp.Regexp{
+				regexp.MustCompile(`^generated-[0-9]+$`),
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "nil value",
+			tags: New(map[string]*string{
+				"key1": nil,
+				"key2": nil,
+			}),
+			regexes: []*regexp.Regexp{
+				regexp.MustCompile(`.*`),
+			},
+			want: map[string]string{
+				"key1": "",
+				"key2": "",
+			},
+		},
+		{
+			name: "none matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			regexes: []*regexp.Regexp{
+				regexp.MustCompile(`^key`),
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreValueRegexes(testCase.regexes)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreRDS(t *testing.T) {
 	testCases := []struct {
 		name string
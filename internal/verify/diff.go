@@ -14,15 +14,29 @@ import (
 
 // Find JSON diff functions in the json.go file.
 
+type requiredTagsExemptContextKey struct{}
+
+// CustomizeDiffExemptFromRequiredTags returns a CustomizeDiffFunc that calls the
+// specified CustomizeDiffFunc without SetTagsDiff enforcing the provider-level
+// required tags configuration.
+func CustomizeDiffExemptFromRequiredTags(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		return f(context.WithValue(ctx, requiredTagsExemptContextKey{}, true), diff, meta)
+	}
+}
+
 // SetTagsDiff sets the new plan difference with the result of
 // merging resource tags on to those defined at the provider-level;
 // returns an error if unsuccessful or if the resource tags are identical
 // to those configured at the provider-level to avoid non-empty plans
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
-func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+// An error is also returned if the merged tags do not satisfy the
+// provider-level required tags configuration.
+func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	requiredTagsConfig := meta.(*conns.AWSClient).RequiredTagsConfig
 
 	resourceTags := tftags.New(diff.Get("tags").(map[string]interface{}))
 
@@ -30,6 +44,14 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 		return fmt.Errorf(`"tags" are identical to those in the "default_tags" configuration block of the provider: please de-duplicate and try again`)
 	}
 
+	// Required tags can only be checked once all resource tags are known.
+	// Resources exempted via CustomizeDiffExemptFromRequiredTags are not checked.
+	if exempt, _ := ctx.Value(requiredTagsExemptContextKey{}).(bool); !exempt && diff.NewValueKnown("tags") {
+		if err := requiredTagsConfig.Validate(defaultTagsConfig.MergeTags(resourceTags)); err != nil {
+			return fmt.Errorf(`"tags_all" do not satisfy the "required_tags" configuration block of the provider: %w`, err)
+		}
+	}
+
 	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
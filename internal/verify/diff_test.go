@@ -1,11 +1,17 @@
 package verify
 
 import (
+	"context"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 func TestSuppressEquivalentRoundedTime(t *testing.T) {
@@ -196,3 +202,61 @@ func TestDiffStringMaps(t *testing.T) {
 		}
 	}
 }
+
+func TestSetTagsDiffRequiredTags(t *testing.T) {
+	meta := &conns.AWSClient{
+		RequiredTagsConfig: &tftags.RequiredConfig{
+			Keys: tftags.New([]string{"CostCenter"}),
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		customizeDiff schema.CustomizeDiffFunc
+		tags          map[string]interface{}
+		wantErr       string
+	}{
+		{
+			name:          "present",
+			customizeDiff: SetTagsDiff,
+			tags:          map[string]interface{}{"CostCenter": "cc-1234"},
+		},
+		{
+			name:          "missing",
+			customizeDiff: SetTagsDiff,
+			tags:          map[string]interface{}{"Owner": "test"},
+			wantErr:       `missing required tag "CostCenter"`,
+		},
+		{
+			name:          "missing exempt",
+			customizeDiff: CustomizeDiffExemptFromRequiredTags(SetTagsDiff),
+			tags:          map[string]interface{}{"Owner": "test"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"tags":     tftags.TagsSchema(),
+					"tags_all": tftags.TagsSchemaComputed(),
+				},
+				CustomizeDiff: testCase.customizeDiff,
+			}
+
+			_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{"tags": testCase.tags}), meta)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Errorf("expected error containing %q, got %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
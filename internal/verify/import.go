@@ -0,0 +1,71 @@
+package verify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// ImportARNFunc returns the resource ID for the ARN of a resource being imported.
+type ImportARNFunc func(ctx context.Context, d *schema.ResourceData, meta interface{}, arn arn.ARN) (string, error)
+
+// ImportARNPassthrough is an ImportARNFunc for resources whose ID is their ARN.
+// The ARN is still checked against the provider's partition and region.
+func ImportARNPassthrough(ctx context.Context, d *schema.ResourceData, meta interface{}, arn arn.ARN) (string, error) {
+	return arn.String(), nil
+}
+
+// ImportByARN returns an importer State function that accepts either the resource ID or the resource ARN.
+// ARNs are converted to the resource ID using the specified function.
+func ImportByARN(service string, f ImportARNFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if err := ImportARN(ctx, d, meta, service, f); err != nil {
+			return nil, err
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// ImportARN sets the resource ID of a resource being imported by ARN.
+// The ARN must be for the specified service and the provider's partition and region.
+// Import IDs that are not ARNs are left unchanged.
+func ImportARN(ctx context.Context, d *schema.ResourceData, meta interface{}, service string, f ImportARNFunc) error {
+	if !arn.IsARN(d.Id()) {
+		return nil
+	}
+
+	parsedARN, err := arn.Parse(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing import ARN (%s): %w", d.Id(), err)
+	}
+
+	if parsedARN.Service != service {
+		return fmt.Errorf("import ARN (%s) service (%s) does not match the expected service (%s)", d.Id(), parsedARN.Service, service)
+	}
+
+	client := meta.(*conns.AWSClient)
+
+	if parsedARN.Partition != client.Partition {
+		return fmt.Errorf("import ARN (%s) partition (%s) does not match the provider partition (%s)", d.Id(), parsedARN.Partition, client.Partition)
+	}
+
+	// Global services, e.g. IAM, have no region in their ARNs.
+	if parsedARN.Region != "" && parsedARN.Region != client.Region {
+		return fmt.Errorf("import ARN (%s) region (%s) does not match the provider region (%s), import using a provider configured for region %[2]s", d.Id(), parsedARN.Region, client.Region)
+	}
+
+	id, err := f(ctx, d, meta, parsedARN)
+
+	if err != nil {
+		return fmt.Errorf("error importing ARN (%s): %w", d.Id(), err)
+	}
+
+	d.SetId(id)
+
+	return nil
+}
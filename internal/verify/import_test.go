@@ -0,0 +1,78 @@
+package verify
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestImportARN(t *testing.T) {
+	meta := &conns.AWSClient{
+		Partition: "aws",
+		Region:    "us-west-2",
+	}
+
+	resourceName := func(ctx context.Context, d *schema.ResourceData, meta interface{}, arn arn.ARN) (string, error) {
+		return strings.TrimPrefix(arn.Resource, "thing/"), nil
+	}
+
+	testCases := []struct {
+		id            string
+		expectedID    string
+		expectedError string
+	}{
+		{
+			id:         "test",
+			expectedID: "test",
+		},
+		{
+			id:         "arn:aws:example:us-west-2:123456789012:thing/test",
+			expectedID: "test",
+		},
+		{
+			id:         "arn:aws:example::123456789012:thing/test",
+			expectedID: "test",
+		},
+		{
+			id:            "arn:aws:other:us-west-2:123456789012:thing/test",
+			expectedError: "does not match the expected service (example)",
+		},
+		{
+			id:            "arn:aws-us-gov:example:us-gov-west-1:123456789012:thing/test",
+			expectedError: "does not match the provider partition (aws)",
+		},
+		{
+			id:            "arn:aws:example:us-east-1:123456789012:thing/test",
+			expectedError: "region (us-east-1) does not match the provider region (us-west-2)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.id, func(t *testing.T) {
+			d := (&schema.Resource{}).Data(nil)
+			d.SetId(tc.id)
+
+			err := ImportARN(context.Background(), d, meta, "example", resourceName)
+
+			if tc.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectedError) {
+					t.Fatalf("expected error containing %q, got %v", tc.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := d.Id(); got != tc.expectedID {
+				t.Errorf("got ID %s, expected %s", got, tc.expectedID)
+			}
+		})
+	}
+}
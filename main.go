@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
@@ -31,4 +32,6 @@ func main() {
 	logFlags = logFlags &^ (log.Ldate | log.Ltime)
 	log.SetFlags(logFlags)
 	plugin.Serve(opts)
+
+	conns.GlobalRequestCounts.Log()
 }
@@ -0,0 +1,145 @@
+package names
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CheckServiceRow checks a row of names_data.csv against the rules described
+// in README.md, returning an error for the first rule the row breaks.
+func CheckServiceRow(l []string) error {
+	if len(l) != ColNote+1 {
+		return fmt.Errorf("row must have %d columns, found %d", ColNote+1, len(l))
+	}
+
+	if l[ColHumanFriendly] == "" {
+		return fmt.Errorf("HumanFriendly cannot be blank")
+	}
+
+	if l[ColAWSCLIV2Command] != "" && strings.Replace(l[ColAWSCLIV2Command], "-", "", -1) != l[ColAWSCLIV2CommandNoDashes] {
+		return fmt.Errorf("for service %s, AWSCLIV2CommandNoDashes must be the same as AWSCLIV2Command without dashes (%s)", l[ColHumanFriendly], strings.Replace(l[ColAWSCLIV2Command], "-", "", -1))
+	}
+
+	if l[ColProviderPackageCorrect] != "" && l[ColAWSCLIV2CommandNoDashes] != "" && l[ColGoV2Package] != "" {
+		if len(l[ColAWSCLIV2CommandNoDashes]) < len(l[ColGoV2Package]) && l[ColProviderPackageCorrect] != l[ColAWSCLIV2CommandNoDashes] {
+			return fmt.Errorf("for service %s, ProviderPackageCorrect must be shorter of AWSCLIV2CommandNoDashes (%s) and GoV2Package (%s)", l[ColHumanFriendly], l[ColAWSCLIV2CommandNoDashes], l[ColGoV2Package])
+		}
+
+		if len(l[ColAWSCLIV2CommandNoDashes]) > len(l[ColGoV2Package]) && l[ColProviderPackageCorrect] != l[ColGoV2Package] {
+			return fmt.Errorf("for service %s, ProviderPackageCorrect must be shorter of AWSCLIV2CommandNoDashes (%s) and GoV2Package (%s)", l[ColHumanFriendly], l[ColAWSCLIV2CommandNoDashes], l[ColGoV2Package])
+		}
+	}
+
+	if l[ColAWSCLIV2CommandNoDashes] == "" && l[ColGoV2Package] == "" && l[ColExclude] == "" {
+		return fmt.Errorf("for service %s, if Exclude is blank, either AWSCLIV2CommandNoDashes or GoV2Package must have values", l[ColHumanFriendly])
+	}
+
+	if l[ColProviderPackageActual] != "" && l[ColProviderPackageCorrect] == "" {
+		return fmt.Errorf("for service %s, ProviderPackageActual can't be non-blank if ProviderPackageCorrect is blank", l[ColHumanFriendly])
+	}
+
+	if l[ColProviderPackageActual] == "" && l[ColProviderPackageCorrect] == "" && l[ColExclude] == "" {
+		return fmt.Errorf("for service %s, ProviderPackageActual and ProviderPackageCorrect cannot both be blank unless Exclude is non-blank", l[ColHumanFriendly])
+	}
+
+	if l[ColProviderPackageCorrect] != "" && l[ColProviderPackageActual] == l[ColProviderPackageCorrect] {
+		return fmt.Errorf("for service %s, ProviderPackageActual should only be used if different from ProviderPackageCorrect", l[ColHumanFriendly])
+	}
+
+	packageToUse := l[ColProviderPackageCorrect]
+
+	if l[ColProviderPackageActual] != "" {
+		packageToUse = l[ColProviderPackageActual]
+	}
+
+	if l[ColAliases] != "" && packageToUse != "" {
+		p := strings.Split(l[ColAliases], ";")
+
+		for _, v := range p {
+			if v == packageToUse {
+				return fmt.Errorf("for service %s, Aliases should not include ProviderPackageActual, if not blank, or ProviderPackageCorrect, if not blank and ProviderPackageActual is blank", l[ColHumanFriendly])
+			}
+		}
+	}
+
+	if l[ColSDKVersion] != "1" && l[ColSDKVersion] != "2" && l[ColExclude] == "" {
+		return fmt.Errorf("for service %s, SDKVersion must have a value if Exclude is blank", l[ColHumanFriendly])
+	}
+
+	if l[ColSDKVersion] == "1" && (l[ColGoV1Package] == "" || l[ColGoV1ClientTypeName] == "") {
+		return fmt.Errorf("for service %s, SDKVersion is set to 1 so neither GoV1Package nor GoV1ClientTypeName can be blank", l[ColHumanFriendly])
+	}
+
+	if l[ColSDKVersion] == "2" && l[ColGoV2Package] == "" {
+		return fmt.Errorf("for service %s, SDKVersion is set to 2 so GoV2Package cannot be blank", l[ColHumanFriendly])
+	}
+
+	if l[ColResourcePrefixCorrect] != "" && l[ColResourcePrefixCorrect] != fmt.Sprintf("aws_%s_", l[ColProviderPackageCorrect]) {
+		return fmt.Errorf("for service %s, ResourcePrefixCorrect should be aws_<package>_, where <package> is ProviderPackageCorrect", l[ColHumanFriendly])
+	}
+
+	if l[ColResourcePrefixCorrect] != "" && l[ColResourcePrefixActual] == l[ColResourcePrefixCorrect] {
+		return fmt.Errorf("for service %s, ResourcePrefixActual should not be the same as ResourcePrefixCorrect, set ResourcePrefixActual to blank", l[ColHumanFriendly])
+	}
+
+	if l[ColSplitPackageRealPackage] != "" && (l[ColProviderPackageCorrect] == "" || l[ColFilePrefix] == "" || l[ColResourcePrefixActual] == "") {
+		return fmt.Errorf("for service %s, if SplitPackageRealPackage has a value, ProviderPackageCorrect, ResourcePrefixActual and FilePrefix must have values", l[ColHumanFriendly])
+	}
+
+	if l[ColSplitPackageRealPackage] == "" && l[ColFilePrefix] != "" {
+		return fmt.Errorf("for service %s, if SplitPackageRealPackge is blank, FilePrefix must also be blank", l[ColHumanFriendly])
+	}
+
+	if l[ColBrand] != "AWS" && l[ColBrand] != "Amazon" && l[ColBrand] != "" {
+		return fmt.Errorf("for service %s, Brand must be AWS, Amazon, or blank; found %s", l[ColHumanFriendly], l[ColBrand])
+	}
+
+	if (l[ColExclude] == "" || (l[ColExclude] != "" && l[ColAllowedSubcategory] != "")) && l[ColDocPrefix] == "" {
+		return fmt.Errorf("for service %s, DocPrefix cannot be blank unless Exclude is non-blank and AllowedSubcategory is blank", l[ColHumanFriendly])
+	}
+
+	for _, v := range []struct {
+		name string
+		col  int
+	}{
+		{"AWSCLIV2Command", ColAWSCLIV2Command},
+		{"AWSCLIV2CommandNoDashes", ColAWSCLIV2CommandNoDashes},
+		{"GoV1Package", ColGoV1Package},
+		{"GoV2Package", ColGoV2Package},
+		{"ProviderPackageActual", ColProviderPackageActual},
+		{"ProviderPackageCorrect", ColProviderPackageCorrect},
+		{"SplitPackageRealPackage", ColSplitPackageRealPackage},
+		{"Aliases", ColAliases},
+		{"ResourcePrefixActual", ColResourcePrefixActual},
+		{"ResourcePrefixCorrect", ColResourcePrefixCorrect},
+		{"FilePrefix", ColFilePrefix},
+		{"DocPrefix", ColDocPrefix},
+	} {
+		if s := l[v.col]; s != "" && strings.ToLower(s) != s {
+			return fmt.Errorf("for service %s, %s should not include uppercase letters (%s)", l[ColHumanFriendly], v.name, s)
+		}
+	}
+
+	for _, v := range []struct {
+		name string
+		col  int
+	}{
+		{"ProviderNameUpper", ColProviderNameUpper},
+		{"GoV1ClientTypeName", ColGoV1ClientTypeName},
+		{"HumanFriendly", ColHumanFriendly},
+	} {
+		if s := l[v.col]; s != "" && strings.ToLower(s) == s {
+			return fmt.Errorf("for service %s, %s should be properly capitalized; it does not include uppercase letters (%s)", l[ColHumanFriendly], v.name, s)
+		}
+	}
+
+	if l[ColExclude] == "" && l[ColAllowedSubcategory] != "" {
+		return fmt.Errorf("for service %s, AllowedSubcategory can only be non-blank if Exclude is non-blank", l[ColHumanFriendly])
+	}
+
+	if l[ColExclude] != "" && l[ColNote] == "" {
+		return fmt.Errorf("for service %s, if Exclude is not blank, include a Note why", l[ColHumanFriendly])
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package names
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckServiceRow(t *testing.T) {
+	kendra := "kendra,kendra,kendra,kendra,,kendra,,,Kendra,Kendra,x,2,,aws_kendra_,,kendra_,Kendra,Amazon,,,,,"
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		Error    bool
+	}{
+		{
+			TestName: "valid",
+			Input:    kendra,
+			Error:    false,
+		},
+		{
+			TestName: "excluded",
+			Input:    "importexport,importexport,importexport,,,,,,ImportExport,ImportExport,,1,,,,,Import/Export,AWS,x,,,,Legacy",
+			Error:    false,
+		},
+		{
+			TestName: "columns",
+			Input:    "kendra,kendra,kendra",
+			Error:    true,
+		},
+		{
+			TestName: "no human friendly",
+			Input:    strings.Replace(kendra, ",Kendra,Amazon,", ",,Amazon,", 1),
+			Error:    true,
+		},
+		{
+			TestName: "resource prefix",
+			Input:    strings.Replace(kendra, "aws_kendra_", "aws_kendr_", 1),
+			Error:    true,
+		},
+		{
+			TestName: "uppercase package",
+			Input:    strings.Replace(kendra, "kendra,kendra,kendra,kendra,", "kendra,kendra,Kendra,kendra,", 1),
+			Error:    true,
+		},
+		{
+			TestName: "lowercase client type name",
+			Input:    strings.Replace(kendra, ",Kendra,Kendra,", ",Kendra,kendra,", 1),
+			Error:    true,
+		},
+		{
+			TestName: "brand",
+			Input:    strings.Replace(kendra, ",Amazon,", ",Amazin,", 1),
+			Error:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			err := CheckServiceRow(strings.Split(testCase.Input, ","))
+
+			if err != nil && !testCase.Error {
+				t.Errorf("got error (%s), expected no error", err)
+			}
+
+			if err == nil && testCase.Error {
+				t.Errorf("got no error, expected error")
+			}
+		})
+	}
+}
@@ -166,7 +166,7 @@ const (
 	LakeFormation                = "lakeformation"
 	Lambda                       = "lambda"
 	LexModels                    = "lexmodels"
-	LexModelsV2                  = "lexmodelsv2"
+	LexModelsV2                  = "lexv2models"
 	LexRuntime                   = "lexruntime"
 	LexRuntimeV2                 = "lexruntimev2"
 	LicenseManager               = "licensemanager"
@@ -219,6 +219,7 @@ const (
 	Pinpoint                     = "pinpoint"
 	PinpointEmail                = "pinpointemail"
 	PinpointSMSVoice             = "pinpointsmsvoice"
+	Pipes                        = "pipes"
 	Polly                        = "polly"
 	Pricing                      = "pricing"
 	Proton                       = "proton"
@@ -266,6 +267,7 @@ const (
 	SageMakerFeatureStoreRuntime = "sagemakerfeaturestoreruntime"
 	SageMakerRuntime             = "sagemakerruntime"
 	SavingsPlans                 = "savingsplans"
+	Scheduler                    = "scheduler"
 	Schemas                      = "schemas"
 	SecretsManager               = "secretsmanager"
 	SecurityHub                  = "securityhub"
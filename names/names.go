@@ -23,8 +23,12 @@ import (
 
 // This "should" be defined by the AWS Go SDK v2, but currently isn't.
 const (
+	Inspector2EndpointID     = "inspector2"
 	KendraEndpointID         = "kendra"
+	PipesEndpointID          = "pipes"
 	Route53DomainsEndpointID = "route53domains"
+	SchedulerEndpointID      = "scheduler"
+	SESV2EndpointID          = "email"
 )
 
 // Type ServiceDatum corresponds closely to columns in `names_data.csv` and are
@@ -39,6 +43,7 @@ type ServiceDatum struct {
 	GoV2Package        string
 	HumanFriendly      string
 	ProviderNameUpper  string
+	SDKVersion         string
 }
 
 // serviceData key is the AWS provider service package
@@ -95,6 +100,7 @@ func readCSVIntoServiceData() error {
 			GoV2Package:        l[ColGoV2Package],
 			HumanFriendly:      l[ColHumanFriendly],
 			ProviderNameUpper:  l[ColProviderNameUpper],
+			SDKVersion:         l[ColSDKVersion],
 		}
 
 		a := []string{p}
@@ -167,6 +173,28 @@ func EnvVar(service string) string {
 	return ""
 }
 
+// EndpointURLEnvVarPrefix is the prefix of the environment variables which set service endpoint URLs,
+// e.g. AWS_ENDPOINT_URL_DYNAMODB.
+const EndpointURLEnvVarPrefix = "AWS_ENDPOINT_URL_"
+
+// EndpointURLEnvVars returns the names of the environment variables which set the endpoint URL of the service.
+// There is an environment variable for the service and for each of its aliases, in order of precedence.
+func EndpointURLEnvVars(service string) []string {
+	v, ok := serviceData[service]
+
+	if !ok {
+		return nil
+	}
+
+	envvars := make([]string, len(v.Aliases))
+
+	for i, alias := range v.Aliases {
+		envvars[i] = EndpointURLEnvVarPrefix + strings.ToUpper(alias)
+	}
+
+	return envvars
+}
+
 func FullHumanFriendly(service string) (string, error) {
 	if v, ok := serviceData[service]; ok {
 		if v.Brand == "" {
@@ -210,6 +238,21 @@ func AWSGoV2Package(providerPackage string) (string, error) {
 	return "", fmt.Errorf("getting AWS SDK Go v2 package, %s not found", providerPackage)
 }
 
+func AWSGoSDKVersion(providerPackage string) (int, error) {
+	if v, ok := serviceData[providerPackage]; ok {
+		switch v.SDKVersion {
+		case "1":
+			return 1, nil
+		case "2":
+			return 2, nil
+		}
+
+		return 0, fmt.Errorf("getting AWS SDK Go version, %s has unsupported version: %q", providerPackage, v.SDKVersion)
+	}
+
+	return 0, fmt.Errorf("getting AWS SDK Go version, %s not found", providerPackage)
+}
+
 func AWSGoClientTypeName(providerPackage string, version int) (string, error) {
 	switch version {
 	case 1:
@@ -3,6 +3,7 @@ package names
 import (
 	"fmt"
 	"os"
+	"reflect"
 	"testing"
 )
 
@@ -132,7 +133,6 @@ func TestServicesForDirectories(t *testing.T) {
 		"kinesisvideomedia",
 		"kinesisvideosignaling",
 		"kinesisvideosignalingchannels",
-		"lexmodelsv2",
 		"lexruntime",
 		"lexruntimev2",
 		"location",
@@ -466,3 +466,89 @@ func TestAWSGoV1ClientName(t *testing.T) {
 		})
 	}
 }
+
+func TestAWSGoSDKVersion(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected int
+		Error    bool
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Expected: 0,
+			Error:    true,
+		},
+		{
+			TestName: MQ,
+			Input:    MQ,
+			Expected: 1,
+			Error:    false,
+		},
+		{
+			TestName: Kendra,
+			Input:    Kendra,
+			Expected: 2,
+			Error:    false,
+		},
+		{
+			TestName: "doesnotexist",
+			Input:    "doesnotexist",
+			Expected: 0,
+			Error:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := AWSGoSDKVersion(testCase.Input)
+
+			if err != nil && !testCase.Error {
+				t.Errorf("got error (%s), expected no error", err)
+			}
+
+			if err == nil && testCase.Error {
+				t.Errorf("got (%d) and no error, expected error", got)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %d, expected %d", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestEndpointURLEnvVars(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected []string
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			TestName: "no aliases",
+			Input:    DynamoDB,
+			Expected: []string{"AWS_ENDPOINT_URL_DYNAMODB"},
+		},
+		{
+			TestName: "aliases",
+			Input:    Transcribe,
+			Expected: []string{"AWS_ENDPOINT_URL_TRANSCRIBE", "AWS_ENDPOINT_URL_TRANSCRIBESERVICE"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := EndpointURLEnvVars(testCase.Input)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Package resourceutils provides helpers for analyzers working with schema.Resource literals.
+package resourceutils
+
+import (
+	"go/ast"
+
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"golang.org/x/tools/go/analysis"
+)
+
+var (
+	CreateFields = []string{schema.ResourceFieldCreate, schema.ResourceFieldCreateContext, schema.ResourceFieldCreateWithoutTimeout}
+	ReadFields   = []string{schema.ResourceFieldRead, schema.ResourceFieldReadContext, schema.ResourceFieldReadWithoutTimeout}
+)
+
+// IsResource returns if the schema.Resource literal is a managed resource.
+// Unlike (*schema.ResourceInfo).IsResource(), context-aware Create fields are considered.
+func IsResource(info *schema.ResourceInfo) bool {
+	for _, field := range CreateFields {
+		if info.DeclaresField(field) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FieldFuncDecl returns the declaration of the package function assigned to the first of the given fields declared, and that field.
+// The declaration is nil if the field value is not a function declared in the package.
+func FieldFuncDecl(pass *analysis.Pass, info *schema.ResourceInfo, fieldNames ...string) (*ast.FuncDecl, *ast.KeyValueExpr) {
+	for _, fieldName := range fieldNames {
+		kvExpr := info.Fields[fieldName]
+
+		if kvExpr == nil {
+			continue
+		}
+
+		ident, ok := kvExpr.Value.(*ast.Ident)
+
+		if !ok {
+			return nil, kvExpr
+		}
+
+		return FuncDecl(pass, ident), kvExpr
+	}
+
+	return nil, nil
+}
+
+// FuncDecl returns the declaration of the package function referenced by the identifier, if any.
+func FuncDecl(pass *analysis.Pass, ident *ast.Ident) *ast.FuncDecl {
+	obj := pass.TypesInfo.ObjectOf(ident)
+
+	if obj == nil {
+		return nil
+	}
+
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+
+			if !ok || funcDecl.Recv != nil || funcDecl.Body == nil {
+				continue
+			}
+
+			if pass.TypesInfo.Defs[funcDecl.Name] == obj {
+				return funcDecl
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+package AWSAT007
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourceinfo"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/helper/resourceutils"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for resources without a _disappears acceptance test
+
+The AWSAT007 analyzer reports when an exported function returning a resource,
+e.g. ResourceCluster(), is not covered by an acceptance test function ending
+in _disappears in the package's test files. The _disappears test verifies
+that the resource is removed from state when deleted outside Terraform, e.g.
+via acctest.CheckResourceDisappears().
+
+A test covers the resource if it references the resource function or if its
+name ends with the resource function name without the Resource prefix, e.g.
+TestAccExampleCluster_disappears.
+`
+
+const analyzerName = "AWSAT007"
+
+const disappearsSuffix = "_disappears"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		resourceinfo.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	resourceInfos := pass.ResultOf[resourceinfo.Analyzer].([]*schema.ResourceInfo)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+
+	var tests *disappearsTests
+
+	for _, resourceInfo := range resourceInfos {
+		if !resourceutils.IsResource(resourceInfo) {
+			continue
+		}
+
+		funcDecl := enclosingFuncDecl(pass, resourceInfo.AstCompositeLit)
+
+		if funcDecl == nil || !funcDecl.Name.IsExported() {
+			continue
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, funcDecl) {
+			continue
+		}
+
+		if tests == nil {
+			var err error
+
+			if tests, err = loadDisappearsTests(pass); err != nil {
+				return nil, err
+			}
+		}
+
+		if tests.covers(funcDecl.Name.Name) {
+			continue
+		}
+
+		pass.Reportf(funcDecl.Name.Pos(), "%s: missing %s acceptance test for %s", analyzerName, disappearsSuffix, funcDecl.Name.Name)
+	}
+
+	return nil, nil
+}
+
+// enclosingFuncDecl returns the top-level function declaration in a non-test file containing the node, if any.
+func enclosingFuncDecl(pass *analysis.Pass, n ast.Node) *ast.FuncDecl {
+	for _, file := range pass.Files {
+		if n.Pos() < file.Pos() || n.End() > file.End() {
+			continue
+		}
+
+		if strings.HasSuffix(pass.Fset.File(file.Pos()).Name(), "_test.go") {
+			return nil
+		}
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+
+			if !ok || funcDecl.Recv != nil {
+				continue
+			}
+
+			if n.Pos() >= funcDecl.Pos() && n.End() <= funcDecl.End() {
+				return funcDecl
+			}
+		}
+	}
+
+	return nil
+}
+
+// disappearsTests holds the names of _disappears test functions and the identifiers they reference.
+type disappearsTests struct {
+	names      []string
+	references map[string]bool
+}
+
+func (t *disappearsTests) covers(resourceFuncName string) bool {
+	if t.references[resourceFuncName] {
+		return true
+	}
+
+	suffix := strings.TrimPrefix(resourceFuncName, "Resource") + disappearsSuffix
+
+	for _, name := range t.names {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// loadDisappearsTests parses all test files in the package directory,
+// including those of an external test package, which are not part of the pass.
+func loadDisappearsTests(pass *analysis.Pass) (*disappearsTests, error) {
+	tests := &disappearsTests{
+		references: make(map[string]bool),
+	}
+
+	if len(pass.Files) == 0 {
+		return tests, nil
+	}
+
+	paths, err := filepath.Glob(filepath.Join(filepath.Dir(pass.Fset.File(pass.Files[0].Pos()).Name()), "*_test.go"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+
+	for _, path := range paths {
+		file, err := parser.ParseFile(fset, path, nil, 0)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+
+			if !ok || funcDecl.Body == nil || !strings.HasSuffix(funcDecl.Name.Name, disappearsSuffix) {
+				continue
+			}
+
+			tests.names = append(tests.names, funcDecl.Name.Name)
+
+			ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+				if ident, ok := n.(*ast.Ident); ok {
+					tests.references[ident.Name] = true
+				}
+
+				return true
+			})
+		}
+	}
+
+	return tests, nil
+}
@@ -0,0 +1,70 @@
+package a
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/* Passing cases */
+
+func ResourceCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCreate,
+		Read:   resourceRead,
+		Delete: resourceDelete,
+	}
+}
+
+func ResourceClusterPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCreate,
+		Read:   resourceRead,
+		Delete: resourceDelete,
+	}
+}
+
+func DataSourceCluster() *schema.Resource {
+	return &schema.Resource{
+		Read: resourceRead,
+	}
+}
+
+func resourceUnexported() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCreate,
+		Read:   resourceRead,
+		Delete: resourceDelete,
+	}
+}
+
+/* Comment ignored cases */
+
+// lintignore:AWSAT007
+func ResourceIgnored() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCreate,
+		Read:   resourceRead,
+		Delete: resourceDelete,
+	}
+}
+
+/* Failing cases */
+
+func ResourceSnapshot() *schema.Resource { // want "missing _disappears acceptance test for ResourceSnapshot"
+	return &schema.Resource{
+		Create: resourceCreate,
+		Read:   resourceRead,
+		Delete: resourceDelete,
+	}
+}
+
+func resourceCreate(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
@@ -0,0 +1,21 @@
+package a
+
+import (
+	"testing"
+)
+
+func TestAccExampleCluster_disappears(t *testing.T) {
+	checkResourceDisappears(t, ResourceCluster())
+}
+
+func TestAccExampleClusterPolicy_disappears(t *testing.T) {
+	testAccCheckClusterPolicyDisappears(t)
+}
+
+func TestAccExampleSnapshot_basic(t *testing.T) {
+	checkResourceDisappears(t, ResourceSnapshot())
+}
+
+func checkResourceDisappears(t *testing.T, r interface{}) {}
+
+func testAccCheckClusterPolicyDisappears(t *testing.T) {}
@@ -0,0 +1,111 @@
+package AWSR003
+
+import (
+	"go/ast"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourceinfo"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/helper/awsprovidertype/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/helper/resourceutils"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for resource Read functions that do not remove missing resources from state
+
+The AWSR003 analyzer reports when a resource Read function does not check
+tfresource.NotFound() and clear the resource ID with d.SetId(""). Resources
+deleted outside Terraform should be removed from state so they can be
+recreated, rather than returning an error.
+`
+
+const analyzerName = "AWSR003"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		resourceinfo.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	resourceInfos := pass.ResultOf[resourceinfo.Analyzer].([]*schema.ResourceInfo)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+
+	for _, resourceInfo := range resourceInfos {
+		if !resourceutils.IsResource(resourceInfo) {
+			continue
+		}
+
+		funcDecl, kvExpr := resourceutils.FieldFuncDecl(pass, resourceInfo, resourceutils.ReadFields...)
+
+		if funcDecl == nil {
+			continue
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, kvExpr) || commentIgnorer.ShouldIgnore(analyzerName, funcDecl) {
+			continue
+		}
+
+		if handlesNotFound(pass, funcDecl.Body) {
+			continue
+		}
+
+		pass.Reportf(kvExpr.Pos(), "%s: Read should call d.SetId(\"\") when tfresource.NotFound()", analyzerName)
+	}
+
+	return nil, nil
+}
+
+// handlesNotFound returns whether the block contains an if statement checking tfresource.NotFound() which clears the resource ID.
+func handlesNotFound(pass *analysis.Pass, body *ast.BlockStmt) bool {
+	var found bool
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		ifStmt, ok := n.(*ast.IfStmt)
+
+		if !ok || found {
+			return !found
+		}
+
+		if containsCall(ifStmt.Cond, func(callExpr *ast.CallExpr) bool {
+			return tfresource.IsFunc(callExpr.Fun, pass.TypesInfo, tfresource.FuncNameNotFound)
+		}) && containsCall(ifStmt.Body, isSetIdEmpty) {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+func containsCall(n ast.Node, f func(*ast.CallExpr) bool) bool {
+	var found bool
+
+	ast.Inspect(n, func(n ast.Node) bool {
+		if callExpr, ok := n.(*ast.CallExpr); ok && f(callExpr) {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+func isSetIdEmpty(callExpr *ast.CallExpr) bool {
+	selExpr, ok := callExpr.Fun.(*ast.SelectorExpr)
+
+	if !ok || selExpr.Sel.Name != "SetId" || len(callExpr.Args) != 1 {
+		return false
+	}
+
+	v := astutils.ExprStringValue(callExpr.Args[0])
+
+	return v != nil && *v == ""
+}
@@ -0,0 +1,161 @@
+package AWSR004
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourceinfo"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/helper/resourceutils"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for resource Create functions that do not wait for asynchronous operations
+
+The AWSR004 analyzer reports when a resource Create function calls an AWS API
+Create operation whose output includes a status or state, which indicates the
+operation completes asynchronously, without a subsequent call to a waiter
+function. Waiting for the resource to become available prevents errors in
+dependent resources and the following Read.
+`
+
+const analyzerName = "AWSR004"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		resourceinfo.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	resourceInfos := pass.ResultOf[resourceinfo.Analyzer].([]*schema.ResourceInfo)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+
+	for _, resourceInfo := range resourceInfos {
+		if !resourceutils.IsResource(resourceInfo) {
+			continue
+		}
+
+		funcDecl, _ := resourceutils.FieldFuncDecl(pass, resourceInfo, resourceutils.CreateFields...)
+
+		if funcDecl == nil {
+			continue
+		}
+
+		var asyncCallExprs []*ast.CallExpr
+		var waitCallExprs []*ast.CallExpr
+
+		ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+			callExpr, ok := n.(*ast.CallExpr)
+
+			if !ok {
+				return true
+			}
+
+			switch name := funcName(callExpr); {
+			case strings.HasPrefix(name, "wait"), strings.HasPrefix(name, "Wait"):
+				waitCallExprs = append(waitCallExprs, callExpr)
+			case strings.HasPrefix(name, "Create") && isAsyncOutput(pass.TypesInfo.TypeOf(callExpr)):
+				asyncCallExprs = append(asyncCallExprs, callExpr)
+			}
+
+			return true
+		})
+
+		for _, callExpr := range asyncCallExprs {
+			if commentIgnorer.ShouldIgnore(analyzerName, callExpr) {
+				continue
+			}
+
+			if waitsAfter(callExpr, waitCallExprs) {
+				continue
+			}
+
+			pass.Reportf(callExpr.Pos(), "%s: Create should wait for asynchronous %s to complete", analyzerName, funcName(callExpr))
+		}
+	}
+
+	return nil, nil
+}
+
+// funcName returns the name of the called function or method.
+func funcName(callExpr *ast.CallExpr) string {
+	switch fun := callExpr.Fun.(type) {
+	case *ast.Ident:
+		return fun.Name
+	case *ast.SelectorExpr:
+		return fun.Sel.Name
+	}
+
+	return ""
+}
+
+// isAsyncOutput returns whether the first result of an AWS API call is a structure
+// with a status or state field, either directly or in a nested structure.
+func isAsyncOutput(t types.Type) bool {
+	if tuple, ok := t.(*types.Tuple); ok {
+		if tuple.Len() == 0 {
+			return false
+		}
+
+		t = tuple.At(0).Type()
+	}
+
+	output := structType(t)
+
+	if output == nil {
+		return false
+	}
+
+	if hasStatusField(output) {
+		return true
+	}
+
+	for i := 0; i < output.NumFields(); i++ {
+		if v := structType(output.Field(i).Type()); v != nil && hasStatusField(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func structType(t types.Type) *types.Struct {
+	if t == nil {
+		return nil
+	}
+
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	v, _ := t.Underlying().(*types.Struct)
+
+	return v
+}
+
+func hasStatusField(s *types.Struct) bool {
+	for i := 0; i < s.NumFields(); i++ {
+		if name := s.Field(i).Name(); strings.HasSuffix(name, "Status") || strings.HasSuffix(name, "State") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func waitsAfter(callExpr *ast.CallExpr, waitCallExprs []*ast.CallExpr) bool {
+	for _, waitCallExpr := range waitCallExprs {
+		if waitCallExpr.Pos() > callExpr.End() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,115 @@
+package a
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type Cluster struct {
+	Name   *string
+	Status *string
+}
+
+type CreateClusterOutput struct {
+	Cluster *Cluster
+}
+
+type CreateTagsOutput struct{}
+
+type Conn struct{}
+
+func (c *Conn) CreateCluster() (*CreateClusterOutput, error) {
+	return nil, nil
+}
+
+func (c *Conn) CreateTags() (*CreateTagsOutput, error) {
+	return nil, nil
+}
+
+func waitClusterCreated(conn *Conn, name string) error {
+	return nil
+}
+
+func resourcePassing() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePassingCreate,
+		Read:   resourceRead,
+		Delete: resourceDelete,
+	}
+}
+
+func resourcePassingCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*Conn)
+
+	if _, err := conn.CreateCluster(); err != nil {
+		return err
+	}
+
+	if err := waitClusterCreated(conn, d.Id()); err != nil {
+		return err
+	}
+
+	return resourceRead(d, meta)
+}
+
+func resourceSyncCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*Conn)
+
+	if _, err := conn.CreateTags(); err != nil {
+		return err
+	}
+
+	return resourceRead(d, meta)
+}
+
+func resourceSync() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSyncCreate,
+		Read:   resourceRead,
+		Delete: resourceDelete,
+	}
+}
+
+func resourceIgnored() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIgnoredCreate,
+		Read:   resourceRead,
+		Delete: resourceDelete,
+	}
+}
+
+func resourceIgnoredCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*Conn)
+
+	//lintignore:AWSR004
+	if _, err := conn.CreateCluster(); err != nil {
+		return err
+	}
+
+	return resourceRead(d, meta)
+}
+
+func resourceFailing() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFailingCreate,
+		Read:   resourceRead,
+		Delete: resourceDelete,
+	}
+}
+
+func resourceFailingCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*Conn)
+
+	if _, err := conn.CreateCluster(); err != nil { // want "Create should wait for asynchronous CreateCluster to complete"
+		return err
+	}
+
+	return resourceRead(d, meta)
+}
+
+func resourceRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
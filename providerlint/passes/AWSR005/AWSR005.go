@@ -0,0 +1,118 @@
+package AWSR005
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourcedatasetcallexpr"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for d.Set() of nested blocks with ignored errors
+
+The AWSR005 analyzer reports when the error returned by a (schema.ResourceData).Set()
+call is ignored and the value is a nested block, either the result of a flatten
+function or a []map[string]interface{}. Unlike simple values, nested block values
+are easily mismatched with the schema, and the error explains which attribute
+could not be set.
+`
+
+const analyzerName = "AWSR005"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+		resourcedatasetcallexpr.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	callExprs := pass.ResultOf[resourcedatasetcallexpr.Analyzer].([]*ast.CallExpr)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	setCallExprs := make(map[*ast.CallExpr]bool, len(callExprs))
+
+	for _, callExpr := range callExprs {
+		setCallExprs[callExpr] = true
+	}
+
+	nodeFilter := []ast.Node{
+		(*ast.AssignStmt)(nil),
+		(*ast.ExprStmt)(nil),
+	}
+
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		var callExpr *ast.CallExpr
+
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if len(n.Lhs) != 1 || len(n.Rhs) != 1 || !isBlankIdent(n.Lhs[0]) {
+				return
+			}
+
+			callExpr, _ = n.Rhs[0].(*ast.CallExpr)
+		case *ast.ExprStmt:
+			callExpr, _ = n.X.(*ast.CallExpr)
+		}
+
+		if callExpr == nil || !setCallExprs[callExpr] || len(callExpr.Args) < 2 {
+			return
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, callExpr) {
+			return
+		}
+
+		if !isNestedBlock(pass, callExpr.Args[1]) {
+			return
+		}
+
+		pass.Reportf(callExpr.Pos(), "%s: check error returned by d.Set() of nested block", analyzerName)
+	})
+
+	return nil, nil
+}
+
+func isBlankIdent(e ast.Expr) bool {
+	ident, ok := e.(*ast.Ident)
+
+	return ok && ident.Name == "_"
+}
+
+// isNestedBlock returns whether the value is the result of a flatten function or a []map[string]interface{}.
+func isNestedBlock(pass *analysis.Pass, e ast.Expr) bool {
+	if callExpr, ok := e.(*ast.CallExpr); ok {
+		if ident, ok := callExpr.Fun.(*ast.Ident); ok && strings.HasPrefix(ident.Name, "flatten") {
+			return true
+		}
+	}
+
+	slice, ok := pass.TypesInfo.TypeOf(e).(*types.Slice)
+
+	if !ok {
+		return false
+	}
+
+	m, ok := slice.Elem().(*types.Map)
+
+	if !ok {
+		return false
+	}
+
+	if basic, ok := m.Key().(*types.Basic); !ok || basic.Kind() != types.String {
+		return false
+	}
+
+	iface, ok := m.Elem().(*types.Interface)
+
+	return ok && iface.Empty()
+}
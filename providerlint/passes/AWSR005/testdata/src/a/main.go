@@ -0,0 +1,48 @@
+package a
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func f() error {
+	var d schema.ResourceData
+
+	/* Passing cases */
+
+	if err := d.Set("configuration", flattenConfiguration()); err != nil {
+		return fmt.Errorf("error setting configuration: %w", err)
+	}
+
+	if err := d.Set("rule", []map[string]interface{}{}); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+
+	d.Set("name", "test")
+
+	d.Set("names", []interface{}{"test"})
+
+	d.Set("tags", map[string]interface{}{})
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR005
+	d.Set("configuration", flattenConfiguration())
+
+	d.Set("configuration", flattenConfiguration()) //lintignore:AWSR005
+
+	/* Failing cases */
+
+	d.Set("configuration", flattenConfiguration()) // want "check error returned by d.Set\\(\\) of nested block"
+
+	_ = d.Set("configuration", flattenConfiguration()) // want "check error returned by d.Set\\(\\) of nested block"
+
+	d.Set("rule", []map[string]interface{}{}) // want "check error returned by d.Set\\(\\) of nested block"
+
+	return nil
+}
+
+func flattenConfiguration() []interface{} {
+	return nil
+}
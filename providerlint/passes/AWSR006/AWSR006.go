@@ -0,0 +1,123 @@
+package AWSR006
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/stdlib/fmtsprintfcallexpr"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for hand-built ARN strings
+
+The AWSR006 analyzer reports when an Amazon Resource Name (ARN) is built by
+a fmt.Sprintf() call with a format string beginning with "arn:" or by string
+concatenation beginning with "arn:". Hand-built ARNs frequently hardcode the
+aws partition, which is not valid in the AWS China and AWS GovCloud (US)
+partitions. Test files are not checked.
+
+To ensure the correct partition is used in all partitions, the AWSClient
+available to all resources provides the GlobalARN() and RegionalARN()
+receiver methods. Otherwise, use the arn.ARN type String() receiver method.
+`
+
+const analyzerName = "AWSR006"
+
+const arnPrefix = "arn:"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		fmtsprintfcallexpr.Analyzer,
+		inspect.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	callExprs := pass.ResultOf[fmtsprintfcallexpr.Analyzer].([]*ast.CallExpr)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	for _, callExpr := range callExprs {
+		if isTestFile(pass, callExpr) || commentIgnorer.ShouldIgnore(analyzerName, callExpr) {
+			continue
+		}
+
+		formatString := astutils.ExprStringValue(callExpr.Args[0])
+
+		if formatString == nil || !strings.HasPrefix(*formatString, arnPrefix) {
+			continue
+		}
+
+		pass.Reportf(callExpr.Pos(), "%s: prefer (*AWSClient).RegionalARN(), (*AWSClient).GlobalARN() or arn.ARN{}.String()", analyzerName)
+	}
+
+	nodeFilter := []ast.Node{
+		(*ast.BinaryExpr)(nil),
+	}
+
+	inspect.Nodes(nodeFilter, func(n ast.Node, push bool) bool {
+		binaryExpr := n.(*ast.BinaryExpr)
+
+		if !push || binaryExpr.Op != token.ADD {
+			return true
+		}
+
+		// Constant concatenations, e.g. of error message text, are not built at runtime.
+		if tv, ok := pass.TypesInfo.Types[binaryExpr]; ok && tv.Value != nil {
+			return false
+		}
+
+		if !hasARNPrefix(binaryExpr) {
+			return true
+		}
+
+		if isTestFile(pass, binaryExpr) || commentIgnorer.ShouldIgnore(analyzerName, binaryExpr) {
+			return false
+		}
+
+		pass.Reportf(binaryExpr.Pos(), "%s: prefer (*AWSClient).RegionalARN(), (*AWSClient).GlobalARN() or arn.ARN{}.String()", analyzerName)
+
+		// Do not report the nested concatenations.
+		return false
+	})
+
+	return nil, nil
+}
+
+// hasARNPrefix returns whether the leftmost operand of a concatenation is a string literal beginning with "arn:".
+func hasARNPrefix(binaryExpr *ast.BinaryExpr) bool {
+	var e ast.Expr = binaryExpr
+
+	for {
+		switch v := e.(type) {
+		case *ast.BinaryExpr:
+			e = v.X
+		case *ast.ParenExpr:
+			e = v.X
+		case *ast.BasicLit:
+			if v.Kind != token.STRING {
+				return false
+			}
+
+			s, err := strconv.Unquote(v.Value)
+
+			return err == nil && strings.HasPrefix(s, arnPrefix)
+		default:
+			return false
+		}
+	}
+}
+
+func isTestFile(pass *analysis.Pass, n ast.Node) bool {
+	return strings.HasSuffix(pass.Fset.File(n.Pos()).Name(), "_test.go")
+}
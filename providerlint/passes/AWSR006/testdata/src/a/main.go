@@ -0,0 +1,38 @@
+package a
+
+import (
+	"fmt"
+)
+
+func f() {
+	partition := "aws"
+	name := "test"
+
+	/* Passing cases */
+
+	_ = fmt.Sprintf("%s.amazonaws.com", name)
+
+	_ = fmt.Sprintf("name: %s", name)
+
+	_ = "prefix-" + name
+
+	_ = "must be an ARN, e.g. " +
+		"arn:PARTITION:sqs:REGION:ACCOUNTID:NAME"
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR006
+	_ = fmt.Sprintf("arn:%s:iam::aws:policy/%s", partition, name)
+
+	_ = "arn:" + partition + ":ecs:" //lintignore:AWSR006
+
+	/* Failing cases */
+
+	_ = fmt.Sprintf("arn:%s:iam::aws:policy/%s", partition, name) // want "prefer \\(\\*AWSClient\\).RegionalARN\\(\\), \\(\\*AWSClient\\).GlobalARN\\(\\) or arn.ARN{}.String\\(\\)"
+
+	_ = fmt.Sprintf("arn:aws:sqs:us-west-2:123456789012:%s", name) // want "prefer \\(\\*AWSClient\\).RegionalARN\\(\\), \\(\\*AWSClient\\).GlobalARN\\(\\) or arn.ARN{}.String\\(\\)"
+
+	_ = "arn:" + partition + ":ecs:" // want "prefer \\(\\*AWSClient\\).RegionalARN\\(\\), \\(\\*AWSClient\\).GlobalARN\\(\\) or arn.ARN{}.String\\(\\)"
+
+	_ = "arn:aws:iam::aws:policy/" + name // want "prefer \\(\\*AWSClient\\).RegionalARN\\(\\), \\(\\*AWSClient\\).GlobalARN\\(\\) or arn.ARN{}.String\\(\\)"
+}
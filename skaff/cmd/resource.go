@@ -10,12 +10,17 @@ var (
 	clearComments bool
 	name          string
 	force         bool
+	operations    resource.Operations
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if operations.Create != "" || operations.Read != "" || operations.Update != "" || operations.Delete != "" {
+			return resource.CreateFromModel(name, snakeName, operations, force)
+		}
+
 		return resource.Create(name, snakeName, !clearComments, force)
 	},
 }
@@ -26,4 +31,9 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&clearComments, "clear-comments", "c", false, "Do not include instructional comments in source")
 	resourceCmd.Flags().StringVarP(&name, "name", "n", "", "Name of the entity")
 	resourceCmd.Flags().BoolVarP(&force, "force", "f", false, "Force creation, overwriting existing files")
+	resourceCmd.Flags().StringVar(&operations.Create, "create", "", "Generate from the AWS API model using this create operation (e.g., CreateBroker)")
+	resourceCmd.Flags().StringVar(&operations.Read, "read", "", "AWS API read operation used with --create (e.g., DescribeBroker)")
+	resourceCmd.Flags().StringVar(&operations.Update, "update", "", "Optional AWS API update operation used with --create (e.g., UpdateBroker)")
+	resourceCmd.Flags().StringVar(&operations.Delete, "delete", "", "AWS API delete operation used with --create (e.g., DeleteBroker)")
+	resourceCmd.Flags().StringVar(&operations.ModelPath, "model", "", "Path of the AWS API model (api-2.json) used with --create; defaults to the model in the AWS SDK for Go module")
 }
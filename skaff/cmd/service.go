@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/service"
+	"github.com/spf13/cobra"
+)
+
+var serviceOptions service.Options
+
+var serviceCmd = &cobra.Command{
+	Use:   "service",
+	Short: "Create a service package, adding the service to names_data.csv and running generators",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return service.Create(name, serviceOptions)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(serviceCmd)
+	serviceCmd.Flags().StringVarP(&name, "name", "n", "", "AWS SDK for Go package name of the service (e.g., resourcegroups)")
+	serviceCmd.Flags().IntVar(&serviceOptions.SDKVersion, "sdk-version", 0, "AWS SDK for Go major version used by the service, 1 or 2 (default: names_data.csv, if the service is listed, or 1)")
+	serviceCmd.Flags().StringVar(&serviceOptions.HumanFriendly, "human-friendly", "", "Human-friendly service name, if not yet in names_data.csv (default: from the AWS API model)")
+	serviceCmd.Flags().StringVar(&serviceOptions.Brand, "brand", "", "Service brand (AWS or Amazon), if not yet in names_data.csv (default: from the AWS API model)")
+	serviceCmd.Flags().StringVar(&serviceOptions.ProviderNameUpper, "provider-name-upper", "", "Correctly capitalized service name, if not yet in names_data.csv (default: AWS SDK for Go v1 client type name)")
+}
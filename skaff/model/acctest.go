@@ -0,0 +1,174 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// resourceType returns the Terraform resource type name, e.g. "aws_mq_broker".
+func (g *generator) resourceType() string {
+	return fmt.Sprintf("aws_%s_%s", g.ServicePackage, toSnakeCase(g.Name))
+}
+
+// endpointsID returns the Go expression of the service's endpoints ID used by acceptance test error checks.
+func (g *generator) endpointsID(f *File) string {
+	if g.SDKVersion == 2 {
+		f.use("github.com/hashicorp/terraform-provider-aws/names")
+
+		return "names." + g.Service
+	}
+
+	return g.GoPackage + ".EndpointsID"
+}
+
+func (g *generator) testFile() *File {
+	f := newFile()
+	name := g.Name
+	variable := strings.ToLower(name[0:1]) + name[1:]
+	tfPackage := "tf" + g.ServicePackage
+
+	f.use("context")
+	f.use("fmt")
+	f.use("testing")
+	f.useNamed("sdkacctest", "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest")
+	f.use(importResource)
+	f.use("github.com/hashicorp/terraform-plugin-sdk/v2/terraform")
+	f.use("github.com/hashicorp/terraform-provider-aws/internal/acctest")
+	f.use(importConns)
+	f.useNamed(tfPackage, "github.com/hashicorp/terraform-provider-aws/internal/service/"+g.ServicePackage)
+	f.use(importTFResource)
+
+	if g.SDKVersion == 1 {
+		f.use("github.com/aws/aws-sdk-go/service/" + g.GoPackage)
+	} else if g.readPath == "out" {
+		f.use("github.com/aws/aws-sdk-go-v2/service/" + g.GoPackage)
+	} else {
+		g.useTypes(f)
+	}
+
+	endpointsID := g.endpointsID(f)
+	objectType := strings.TrimPrefix(g.readObjectType(), "*")
+
+	for _, v := range []string{"basic", "disappears"} {
+		f.printf("func TestAcc%s%s_%s(t *testing.T) {\n", g.Service, name, v)
+		f.printf("var %s %s\n", variable, objectType)
+		f.printf("rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)\n")
+		f.printf("resourceName := \"%s.test\"\n\n", g.resourceType())
+		f.printf("resource.ParallelTest(t, resource.TestCase{\n")
+		f.printf("PreCheck: func() { acctest.PreCheck(t) },\n")
+		f.printf("ErrorCheck: acctest.ErrorCheck(t, %s),\n", endpointsID)
+		f.printf("ProviderFactories: acctest.ProviderFactories,\n")
+		f.printf("CheckDestroy: testAccCheck%sDestroy,\n", name)
+		f.printf("Steps: []resource.TestStep{\n{\n")
+		f.printf("Config: testAcc%sConfig_basic(rName),\n", name)
+
+		if v == "basic" {
+			f.printf("Check: resource.ComposeTestCheckFunc(\n")
+			f.printf("testAccCheck%sExists(resourceName, &%s),\n", name, variable)
+
+			if g.arnAttribute != "" {
+				f.use("regexp")
+				f.printf("acctest.MatchResourceAttrRegionalARN(resourceName, %q, %q, regexp.MustCompile(`%s/.+`)),\n", g.arnAttribute, g.API.Metadata.EndpointPrefix, toSnakeCase(name))
+			}
+
+			if g.tags {
+				f.printf("resource.TestCheckResourceAttr(resourceName, \"tags.%%\", \"0\"),\n")
+			}
+
+			f.printf("),\n},\n{\nResourceName: resourceName,\nImportState: true,\nImportStateVerify: true,\n},\n")
+		} else {
+			f.printf("Check: resource.ComposeTestCheckFunc(\n")
+			f.printf("testAccCheck%sExists(resourceName, &%s),\n", name, variable)
+			f.printf("acctest.CheckResourceDisappears(acctest.Provider, %s.Resource%s(), resourceName),\n", tfPackage, name)
+			f.printf("),\nExpectNonEmptyPlan: true,\n},\n")
+		}
+
+		f.printf("},\n})\n}\n\n")
+	}
+
+	f.printf("func testAccCheck%sDestroy(s *terraform.State) error {\n", name)
+	f.printf("conn := acctest.Provider.Meta().(*conns.AWSClient).%sConn\n\n", g.Service)
+	f.printf("for _, rs := range s.RootModule().Resources {\n")
+	f.printf("if rs.Type != %q {\ncontinue\n}\n\n", g.resourceType())
+	f.printf("_, err := %s.Find%sByID(context.Background(), conn, rs.Primary.ID)\n\n", tfPackage, name)
+	f.printf("if tfresource.NotFound(err) {\ncontinue\n}\n\n")
+	f.printf("if err != nil {\nreturn err\n}\n\n")
+	f.printf("return fmt.Errorf(\"%s %s %%s still exists\", rs.Primary.ID)\n}\n\nreturn nil\n}\n\n", g.AWSServiceName, name)
+
+	f.printf("func testAccCheck%sExists(n string, v *%s) resource.TestCheckFunc {\n", name, objectType)
+	f.printf("return func(s *terraform.State) error {\n")
+	f.printf("rs, ok := s.RootModule().Resources[n]\nif !ok {\nreturn fmt.Errorf(\"Not found: %%s\", n)\n}\n\n")
+	f.printf("if rs.Primary.ID == \"\" {\nreturn fmt.Errorf(\"No %s %s ID is set\")\n}\n\n", g.AWSServiceName, name)
+	f.printf("conn := acctest.Provider.Meta().(*conns.AWSClient).%sConn\n\n", g.Service)
+	f.printf("output, err := %s.Find%sByID(context.Background(), conn, rs.Primary.ID)\n\n", tfPackage, name)
+	f.printf("if err != nil {\nreturn err\n}\n\n*v = *output\n\nreturn nil\n}\n}\n\n")
+
+	config := newFile()
+	g.writeConfig(config, g.fields, "  ")
+
+	f.printf("func testAcc%sConfig_basic(rName string) string {\n", name)
+
+	if strings.Contains(config.body.String(), "%[1]q") {
+		f.printf("return fmt.Sprintf(`\nresource %q \"test\" {\n%s}\n`, rName)\n}\n", g.resourceType(), config.body.String())
+	} else {
+		f.printf("return `\nresource %q \"test\" {\n%s}\n`\n}\n", g.resourceType(), config.body.String())
+	}
+
+	return f
+}
+
+// writeConfig writes the Terraform configuration of the required arguments, aligning the equals signs of
+// consecutive attributes as `terraform fmt` does.
+func (g *generator) writeConfig(f *File, fields []*field, indent string) {
+	var attributes, blocks []*field
+	width := 0
+
+	for _, field := range fields {
+		if !field.Required {
+			continue
+		}
+
+		switch field.kind(g.API) {
+		case "structure", "list-structure":
+			blocks = append(blocks, field)
+		default:
+			attributes = append(attributes, field)
+
+			if len(field.Attribute) > width {
+				width = len(field.Attribute)
+			}
+		}
+	}
+
+	for _, field := range attributes {
+		f.printf("%s%-*s = %s\n", indent, width, field.Attribute, g.configValue(field))
+	}
+
+	for _, field := range blocks {
+		f.printf("\n%s%s {\n", indent, field.Attribute)
+		g.writeConfig(f, field.Fields, indent+"  ")
+		f.printf("%s}\n", indent)
+	}
+}
+
+// configValue returns a Terraform configuration value for a required argument.
+func (g *generator) configValue(field *field) string {
+	switch kind := field.kind(g.API); kind {
+	case "enum":
+		return fmt.Sprintf("%q", field.Shape.Enum[0])
+	case "timestamp":
+		return `"2030-01-01T00:00:00Z"`
+	case "boolean":
+		return "true"
+	case "integer", "long", "float", "double":
+		return "1"
+	case "list-string":
+		return "[%[1]q]"
+	case "list-integer", "list-long":
+		return "[1]"
+	case "map-string":
+		return "{\n    key = %[1]q\n  }"
+	}
+
+	return "%[1]q"
+}
@@ -0,0 +1,984 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	importAWSV1      = "github.com/aws/aws-sdk-go/aws"
+	importAWSV2      = "github.com/aws/aws-sdk-go-v2/aws"
+	importConns      = "github.com/hashicorp/terraform-provider-aws/internal/conns"
+	importDiag       = "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	importFlex       = "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	importResource   = "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	importSchema     = "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	importTags       = "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	importTFAWSErr   = "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	importTFResource = "github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	importValidation = "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	importVerify     = "github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// useSDK adds the AWS SDK for Go imports to the file.
+func (g *generator) useSDK(f *File) {
+	if g.SDKVersion == 2 {
+		f.use(importAWSV2)
+	} else {
+		f.use(importAWSV1)
+	}
+
+	g.useClient(f)
+}
+
+// useClient adds the AWS SDK for Go service package import to the file.
+func (g *generator) useClient(f *File) {
+	if g.SDKVersion == 2 {
+		f.use("github.com/aws/aws-sdk-go-v2/service/" + g.GoPackage)
+		return
+	}
+
+	f.use("github.com/aws/aws-sdk-go/service/" + g.GoPackage)
+}
+
+// funcName returns the name of a generated helper function, e.g. "expandSettings".
+// Functions already declared in the service package may have other signatures, so the name is then
+// qualified by the resource name, e.g. "expandThingSettings".
+func (g *generator) funcName(prefix, name string) string {
+	if v := prefix + name; !g.Declared[v] {
+		return v
+	}
+
+	return prefix + g.Name + name
+}
+
+// valuesFuncName returns the name of the generated function listing an AWS SDK for Go v2 enum's values.
+func (g *generator) valuesFuncName(shapeName string) string {
+	if v := lowerFirst(shapeName) + "Values"; !g.Declared[v] {
+		return v
+	}
+
+	return lowerFirst(g.Name) + exportedName(shapeName) + "Values"
+}
+
+func (g *generator) useTypes(f *File) {
+	if g.SDKVersion == 2 {
+		f.use("github.com/aws/aws-sdk-go-v2/service/" + g.GoPackage + "/types")
+	}
+}
+
+// clientType returns the Go type of the service client, e.g. "*mq.MQ".
+func (g *generator) clientType() string {
+	return fmt.Sprintf("*%s.%s", g.GoPackage, g.ClientTypeName)
+}
+
+// readObjectType returns the Go type returned by the resource's finder, e.g. "*mq.DescribeBrokerOutput".
+func (g *generator) readObjectType() string {
+	if g.readPath == "out" {
+		return fmt.Sprintf("*%s.%sOutput", g.sdkPackage(), g.Read)
+	}
+
+	return fmt.Sprintf("*%s.%s", g.typesPackage(), exportedName(g.readObjectName))
+}
+
+// identifierMember returns the input member used to pass the resource identifier to an operation.
+func (g *generator) identifierMember(op string, input Shape) string {
+	if _, ok := input.Members[g.idMember]; ok {
+		return g.idMember
+	}
+
+	required := append([]string{}, input.Required...)
+	sort.Strings(required)
+
+	if len(required) == 0 {
+		g.warn("%s input has no member for the resource identifier, using %s", op, g.idMember)
+		return g.idMember
+	}
+
+	g.warn("%s input has no %s member, using %s for the resource identifier", op, g.idMember, required[0])
+
+	return required[0]
+}
+
+// notFoundCheck returns the condition testing whether err is the operation's not found error.
+func (g *generator) notFoundCheck(f *File) (string, string) {
+	if g.SDKVersion == 2 {
+		f.use("errors")
+		g.useTypes(f)
+
+		return fmt.Sprintf("var nfe *types.%s\n", exportedName(g.notFound)), "errors.As(err, &nfe)"
+	}
+
+	f.use(importTFAWSErr)
+
+	return "", fmt.Sprintf("tfawserr.ErrCodeEquals(err, %s.ErrCode%s)", g.GoPackage, exportedName(g.notFound))
+}
+
+func (g *generator) stringValue(expr string) string {
+	if g.SDKVersion == 2 {
+		return fmt.Sprintf("aws.ToString(%s)", expr)
+	}
+
+	return fmt.Sprintf("aws.StringValue(%s)", expr)
+}
+
+func (g *generator) resourceFile() *File {
+	f := newFile()
+
+	f.use("context")
+	f.use(importDiag)
+	f.use(importSchema)
+	f.use(importConns)
+
+	g.writeResourceSchema(f)
+	g.writeCreate(f)
+	g.writeRead(f)
+
+	if g.Update != "" || g.tags {
+		g.writeUpdate(f)
+	}
+
+	g.writeDelete(f)
+	g.writeFlex(f)
+
+	return f
+}
+
+func (g *generator) writeResourceSchema(f *File) {
+	f.printf("func Resource%s() *schema.Resource {\n", g.Name)
+	f.printf("return &schema.Resource{\n")
+	f.printf("CreateWithoutTimeout: resource%sCreate,\n", g.Name)
+	f.printf("ReadWithoutTimeout: resource%sRead,\n", g.Name)
+
+	if g.Update != "" || g.tags {
+		f.printf("UpdateWithoutTimeout: resource%sUpdate,\n", g.Name)
+	}
+
+	f.printf("DeleteWithoutTimeout: resource%sDelete,\n\n", g.Name)
+	f.printf("Importer: &schema.ResourceImporter{\nStateContext: schema.ImportStatePassthroughContext,\n},\n\n")
+
+	if g.statusMember != "" {
+		f.use("time")
+		f.printf("Timeouts: &schema.ResourceTimeout{\n")
+		f.printf("Create: schema.DefaultTimeout(30 * time.Minute),\n")
+
+		if g.Update != "" {
+			f.printf("Update: schema.DefaultTimeout(30 * time.Minute),\n")
+		}
+
+		f.printf("Delete: schema.DefaultTimeout(30 * time.Minute),\n")
+		f.printf("},\n\n")
+	}
+
+	f.printf("Schema: map[string]*schema.Schema{\n")
+
+	var tagsWritten bool
+
+	for _, field := range g.fields {
+		if g.tags && !tagsWritten && field.Attribute > "tags" {
+			g.writeTagsSchema(f)
+			tagsWritten = true
+		}
+
+		g.writeFieldSchema(f, field, false)
+	}
+
+	if g.tags && !tagsWritten {
+		g.writeTagsSchema(f)
+	}
+
+	f.printf("},\n")
+
+	if g.tags {
+		f.use(importVerify)
+		f.printf("\nCustomizeDiff: verify.SetTagsDiff,\n")
+	}
+
+	f.printf("}\n}\n\n")
+
+	for _, name := range sortedKeys(g.enums) {
+		g.useTypes(f)
+		f.printf("func %s(input ...types.%s) []string {\n", g.valuesFuncName(name), exportedName(name))
+		f.printf("var output []string\n\nfor _, v := range input {\noutput = append(output, string(v))\n}\n\nreturn output\n}\n\n")
+	}
+}
+
+func (g *generator) writeTagsSchema(f *File) {
+	f.useNamed("tftags", importTags)
+	f.printf("\"tags\": tftags.TagsSchema(),\n")
+	f.printf("\"tags_all\": tftags.TagsSchemaComputed(),\n")
+}
+
+func (g *generator) writeFieldSchema(f *File, field *field, forceNew bool) {
+	forceNew = forceNew || field.ForceNew
+	kind := field.kind(g.API)
+	computedOnly := field.Computed && !field.Optional && !field.Required
+
+	f.printf("%q: {\n", field.Attribute)
+
+	switch kind {
+	case "string", "enum", "timestamp":
+		f.printf("Type: schema.TypeString,\n")
+	case "boolean":
+		f.printf("Type: schema.TypeBool,\n")
+	case "integer", "long":
+		f.printf("Type: schema.TypeInt,\n")
+	case "float", "double":
+		f.printf("Type: schema.TypeFloat,\n")
+	case "map-string":
+		f.printf("Type: schema.TypeMap,\n")
+	default:
+		f.printf("Type: schema.TypeList,\n")
+	}
+
+	if field.Required {
+		f.printf("Required: true,\n")
+	}
+
+	if field.Optional {
+		f.printf("Optional: true,\n")
+	}
+
+	if field.Computed {
+		f.printf("Computed: true,\n")
+	}
+
+	if forceNew && !computedOnly {
+		f.printf("ForceNew: true,\n")
+	}
+
+	if kind == "structure" && !computedOnly {
+		f.printf("MaxItems: 1,\n")
+	}
+
+	if !computedOnly {
+		switch kind {
+		case "enum":
+			f.use(importValidation)
+
+			if g.SDKVersion == 2 {
+				g.enums[field.ShapeName] = true
+				f.printf("ValidateFunc: validation.StringInSlice(%s(types.%s(\"\").Values()...), false),\n", g.valuesFuncName(field.ShapeName), exportedName(field.ShapeName))
+			} else {
+				f.printf("ValidateFunc: validation.StringInSlice(%s.%s_Values(), false),\n", g.GoPackage, exportedName(field.ShapeName))
+			}
+		case "timestamp":
+			f.use(importVerify)
+			f.printf("ValidateFunc: verify.ValidUTCTimestamp,\n")
+		}
+	}
+
+	switch kind {
+	case "list-string", "map-string":
+		f.printf("Elem: &schema.Schema{Type: schema.TypeString},\n")
+	case "list-integer", "list-long":
+		f.printf("Elem: &schema.Schema{Type: schema.TypeInt},\n")
+	case "structure", "list-structure":
+		f.printf("Elem: &schema.Resource{\nSchema: map[string]*schema.Schema{\n")
+
+		for _, child := range field.Fields {
+			g.writeFieldSchema(f, child, forceNew)
+		}
+
+		f.printf("},\n},\n")
+	}
+
+	f.printf("},\n")
+}
+
+func (g *generator) writeConn(f *File) {
+	f.printf("conn := meta.(*conns.AWSClient).%sConn\n\n", g.Service)
+}
+
+// writeExpand writes the statements setting an input member from a configured attribute value.
+// The value is read from the resource data if tfMap is false, otherwise from the tfMap variable.
+// owner is the Go type name of the structure containing the member.
+func (g *generator) writeExpand(f *File, target, owner string, field *field, tfMap bool) {
+	kind := field.kind(g.API)
+	isValue := g.isValue(owner, field.Member)
+
+	var goType, cond string
+
+	switch kind {
+	case "string", "enum", "timestamp":
+		goType, cond = "string", `v != ""`
+	case "boolean":
+		goType = "bool"
+	case "integer", "long":
+		goType, cond = "int", "v != 0"
+	case "float", "double":
+		goType, cond = "float64", "v != 0"
+	case "map-string":
+		goType, cond = "map[string]interface{}", "len(v) > 0"
+	case "structure":
+		goType, cond = "[]interface{}", "len(v) > 0 && v[0] != nil"
+	default:
+		goType, cond = "[]interface{}", "len(v) > 0"
+	}
+
+	value := "v"
+
+	if tfMap {
+		f.printf("if v, ok := tfMap[%q].(%s); ok", field.Attribute, goType)
+
+		if cond != "" {
+			f.printf(" && %s", cond)
+		}
+	} else {
+		value = fmt.Sprintf("v.(%s)", goType)
+		f.printf("if v, ok := d.GetOk(%q); ok", field.Attribute)
+
+		if kind == "structure" {
+			f.printf(" && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil")
+		}
+	}
+
+	f.printf(" {\n")
+
+	switch kind {
+	case "string":
+		f.printf("%s = aws.String(%s)\n", target, value)
+	case "enum":
+		if g.SDKVersion == 2 {
+			f.printf("%s = types.%s(%s)\n", target, exportedName(field.ShapeName), value)
+		} else {
+			f.printf("%s = aws.String(%s)\n", target, value)
+		}
+	case "timestamp":
+		f.use("time")
+		f.printf("t, _ := time.Parse(time.RFC3339, %s)\n\n%s = aws.Time(t)\n", value, target)
+	case "boolean":
+		if isValue {
+			f.printf("%s = %s\n", target, value)
+		} else {
+			f.printf("%s = aws.Bool(%s)\n", target, value)
+		}
+	case "integer":
+		if isValue {
+			f.printf("%s = int32(%s)\n", target, value)
+		} else if g.SDKVersion == 2 {
+			f.printf("%s = aws.Int32(int32(%s))\n", target, value)
+		} else {
+			f.printf("%s = aws.Int64(int64(%s))\n", target, value)
+		}
+	case "long":
+		if isValue {
+			f.printf("%s = int64(%s)\n", target, value)
+		} else {
+			f.printf("%s = aws.Int64(int64(%s))\n", target, value)
+		}
+	case "float":
+		if isValue {
+			f.printf("%s = float32(%s)\n", target, value)
+		} else if g.SDKVersion == 2 {
+			f.printf("%s = aws.Float32(float32(%s))\n", target, value)
+		} else {
+			f.printf("%s = aws.Float64(%s)\n", target, value)
+		}
+	case "double":
+		if isValue {
+			f.printf("%s = %s\n", target, value)
+		} else {
+			f.printf("%s = aws.Float64(%s)\n", target, value)
+		}
+	case "list-string":
+		f.use(importFlex)
+
+		if g.SDKVersion == 2 {
+			f.printf("%s = aws.ToStringSlice(flex.ExpandStringList(%s))\n", target, value)
+		} else {
+			f.printf("%s = flex.ExpandStringList(%s)\n", target, value)
+		}
+	case "list-integer", "list-long":
+		f.use(importFlex)
+		f.printf("%s = flex.ExpandInt64List(%s)\n", target, value)
+	case "map-string":
+		f.use(importFlex)
+
+		if g.SDKVersion == 2 {
+			f.printf("%s = aws.ToStringMap(flex.ExpandStringMap(%s))\n", target, value)
+		} else {
+			f.printf("%s = flex.ExpandStringMap(%s)\n", target, value)
+		}
+	case "structure":
+		g.expanders[field.structureName()] = true
+
+		if tfMap {
+			f.printf("%s = %s(v[0].(map[string]interface{}))\n", target, g.funcName("expand", exportedName(field.structureName())))
+		} else {
+			f.printf("%s = %s(v.([]interface{})[0].(map[string]interface{}))\n", target, g.funcName("expand", exportedName(field.structureName())))
+		}
+	case "list-structure":
+		g.expanders[field.structureName()] = true
+		g.listExpand[field.structureName()] = true
+		f.printf("%s = %s(%s)\n", target, g.funcName("expand", plural(exportedName(field.structureName()))), value)
+	}
+
+	f.printf("}\n\n")
+}
+
+// flattenValue returns the expression converting the non-nil API value v to a Terraform value.
+func (g *generator) flattenValue(field *field, v string) string {
+	switch kind := field.kind(g.API); kind {
+	case "string":
+		return g.stringValue(v)
+	case "enum":
+		if g.SDKVersion == 2 {
+			return fmt.Sprintf("string(%s)", v)
+		}
+
+		return g.stringValue(v)
+	case "timestamp":
+		if g.SDKVersion == 2 {
+			return fmt.Sprintf("aws.ToTime(%s).Format(time.RFC3339)", v)
+		}
+
+		return fmt.Sprintf("aws.TimeValue(%s).Format(time.RFC3339)", v)
+	case "boolean":
+		if g.SDKVersion == 2 {
+			return fmt.Sprintf("aws.ToBool(%s)", v)
+		}
+
+		return fmt.Sprintf("aws.BoolValue(%s)", v)
+	case "integer", "long":
+		if g.SDKVersion == 2 {
+			if kind == "integer" {
+				return fmt.Sprintf("aws.ToInt32(%s)", v)
+			}
+
+			return fmt.Sprintf("aws.ToInt64(%s)", v)
+		}
+
+		return fmt.Sprintf("aws.Int64Value(%s)", v)
+	case "float", "double":
+		if g.SDKVersion == 2 {
+			if kind == "float" {
+				return fmt.Sprintf("aws.ToFloat32(%s)", v)
+			}
+
+			return fmt.Sprintf("aws.ToFloat64(%s)", v)
+		}
+
+		return fmt.Sprintf("aws.Float64Value(%s)", v)
+	case "list-string":
+		if g.SDKVersion == 2 {
+			return v
+		}
+
+		return fmt.Sprintf("aws.StringValueSlice(%s)", v)
+	case "list-integer", "list-long":
+		return fmt.Sprintf("flex.FlattenInt64List(%s)", v)
+	case "map-string":
+		if g.SDKVersion == 2 {
+			return v
+		}
+
+		return fmt.Sprintf("aws.StringValueMap(%s)", v)
+	case "structure":
+		g.flatteners[field.structureName()] = true
+
+		return fmt.Sprintf("[]interface{}{%s(%s)}", g.funcName("flatten", exportedName(field.structureName())), v)
+	case "list-structure":
+		g.flatteners[field.structureName()] = true
+		g.listFlatten[field.structureName()] = true
+
+		return fmt.Sprintf("%s(%s)", g.funcName("flatten", plural(exportedName(field.structureName()))), v)
+	}
+
+	return v
+}
+
+func (g *generator) useFlatten(f *File, field *field) {
+	switch field.kind(g.API) {
+	case "timestamp":
+		f.use("time")
+	case "list-integer", "list-long":
+		f.use(importFlex)
+	}
+}
+
+func (g *generator) writeCreate(f *File) {
+	g.useSDK(f)
+
+	f.printf("func resource%sCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {\n", g.Name)
+	g.writeConn(f)
+
+	if g.tags {
+		f.useNamed("tftags", importTags)
+		f.printf("defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig\n")
+		f.printf("tags := defaultTagsConfig.MergeTags(tftags.New(d.Get(\"tags\").(map[string]interface{})))\n\n")
+	}
+
+	f.printf("in := &%s.%sInput{}\n\n", g.sdkPackage(), g.Create)
+
+	for _, field := range g.fields {
+		if _, ok := g.createInput.Members[field.Member]; !ok {
+			continue
+		}
+
+		g.writeExpand(f, "in."+field.Member, g.Create+"Input", field, false)
+	}
+
+	if g.tags {
+		f.printf("if len(tags) > 0 {\nin.Tags = Tags(tags.IgnoreAWS())\n}\n\n")
+	}
+
+	if g.createIDPath != "" {
+		f.printf("out, err := %s\n\n", g.operationCall(g.Create))
+	} else {
+		f.printf("_, err := %s\n\n", g.operationCall(g.Create))
+	}
+
+	f.printf("if err != nil {\nreturn diag.Errorf(\"creating %s %s: %%s\", err)\n}\n\n", g.AWSServiceName, g.Name)
+
+	if g.createIDPath != "" {
+		f.printf("d.SetId(%s)\n\n", g.stringValue(g.createIDPath))
+	} else {
+		f.printf("d.SetId(d.Get(%q).(string))\n\n", toSnakeCase(g.idMember))
+	}
+
+	if g.statusMember != "" {
+		f.printf("if _, err := wait%sCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {\n", g.Name)
+		f.printf("return diag.Errorf(\"waiting for %s %s (%%s) create: %%s\", d.Id(), err)\n}\n\n", g.AWSServiceName, g.Name)
+	}
+
+	f.printf("return resource%sRead(ctx, d, meta)\n}\n\n", g.Name)
+}
+
+func (g *generator) writeRead(f *File) {
+	f.use("log")
+	f.use(importTFResource)
+
+	f.printf("func resource%sRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {\n", g.Name)
+	g.writeConn(f)
+
+	if g.tags {
+		f.printf("defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig\n")
+		f.printf("ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig\n\n")
+	}
+
+	f.printf("out, err := Find%sByID(ctx, conn, d.Id())\n\n", g.Name)
+	f.printf("if !d.IsNewResource() && tfresource.NotFound(err) {\n")
+	f.printf("log.Printf(\"[WARN] %s %s (%%s) not found, removing from state\", d.Id())\n", g.AWSServiceName, g.Name)
+	f.printf("d.SetId(\"\")\nreturn nil\n}\n\n")
+	f.printf("if err != nil {\nreturn diag.Errorf(\"reading %s %s (%%s): %%s\", d.Id(), err)\n}\n\n", g.AWSServiceName, g.Name)
+
+	for _, field := range g.fields {
+		if _, ok := g.readObject.Members[field.Member]; !ok {
+			continue
+		}
+
+		g.useFlatten(f, field)
+		v := "out." + field.Member
+
+		switch kind := field.kind(g.API); kind {
+		case "string", "boolean", "integer", "long", "float", "double":
+			f.printf("d.Set(%q, %s)\n", field.Attribute, v)
+		case "enum":
+			if g.SDKVersion == 2 {
+				f.printf("d.Set(%q, %s)\n", field.Attribute, g.flattenValue(field, v))
+			} else {
+				f.printf("d.Set(%q, %s)\n", field.Attribute, v)
+			}
+		case "timestamp", "structure":
+			f.printf("\nif %s != nil {\n", v)
+			g.writeSet(f, field, g.flattenValue(field, v))
+			f.printf("} else {\nd.Set(%q, nil)\n}\n\n", field.Attribute)
+		default:
+			f.printf("\n")
+			g.writeSet(f, field, g.flattenValue(field, v))
+			f.printf("\n")
+		}
+	}
+
+	if g.tags {
+		f.printf("\n")
+
+		if g.tagsInRead {
+			f.printf("tags := KeyValueTags(out.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)\n\n")
+		} else {
+			if g.SDKVersion == 2 {
+				f.printf("tags, err := ListTags(ctx, conn, %s)\n\n", g.taggingIdentifier())
+			} else {
+				f.printf("tags, err := ListTagsWithContext(ctx, conn, %s)\n\n", g.taggingIdentifier())
+			}
+
+			f.printf("if err != nil {\nreturn diag.Errorf(\"listing tags for %s %s (%%s): %%s\", d.Id(), err)\n}\n\n", g.AWSServiceName, g.Name)
+			f.printf("tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)\n\n")
+		}
+
+		f.printf("//lintignore:AWSR002\n")
+		f.printf("if err := d.Set(\"tags\", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {\nreturn diag.Errorf(\"setting tags: %%s\", err)\n}\n\n")
+		f.printf("if err := d.Set(\"tags_all\", tags.Map()); err != nil {\nreturn diag.Errorf(\"setting tags_all: %%s\", err)\n}\n\n")
+	}
+
+	f.printf("\nreturn nil\n}\n\n")
+}
+
+func (g *generator) writeSet(f *File, field *field, value string) {
+	f.printf("if err := d.Set(%q, %s); err != nil {\n", field.Attribute, value)
+	f.printf("return diag.Errorf(\"setting %s: %%s\", err)\n}\n", field.Attribute)
+}
+
+// taggingIdentifier returns the Go expression of the identifier used to list and update the resource's tags.
+func (g *generator) taggingIdentifier() string {
+	if g.arnAttribute != "" {
+		return fmt.Sprintf("d.Get(%q).(string)", g.arnAttribute)
+	}
+
+	return "d.Id()"
+}
+
+func (g *generator) writeUpdate(f *File) {
+	f.printf("func resource%sUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {\n", g.Name)
+	g.writeConn(f)
+
+	if g.Update != "" {
+		id := g.identifierMember(g.Update, g.updateInput)
+
+		if g.tags {
+			f.printf("if d.HasChangesExcept(\"tags\", \"tags_all\") {\n")
+		}
+
+		f.printf("in := &%s.%sInput{\n%s: aws.String(d.Id()),\n}\n\n", g.sdkPackage(), g.Update, id)
+
+		for _, field := range g.fields {
+			if _, ok := g.updateInput.Members[field.Member]; !ok || field.Member == id {
+				continue
+			}
+
+			if g.updateInput.IsRequired(field.Member) {
+				g.writeExpand(f, "in."+field.Member, g.Update+"Input", field, false)
+				continue
+			}
+
+			f.printf("if d.HasChange(%q) {\n", field.Attribute)
+			g.writeExpand(f, "in."+field.Member, g.Update+"Input", field, false)
+			f.printf("}\n\n")
+		}
+
+		f.printf("_, err := %s\n\n", g.operationCall(g.Update))
+		f.printf("if err != nil {\nreturn diag.Errorf(\"updating %s %s (%%s): %%s\", d.Id(), err)\n}\n\n", g.AWSServiceName, g.Name)
+
+		if g.statusMember != "" {
+			f.printf("if _, err := wait%sUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {\n", g.Name)
+			f.printf("return diag.Errorf(\"waiting for %s %s (%%s) update: %%s\", d.Id(), err)\n}\n", g.AWSServiceName, g.Name)
+		}
+
+		if g.tags {
+			f.printf("}\n")
+		}
+
+		f.printf("\n")
+	}
+
+	if g.tags {
+		f.printf("if d.HasChange(\"tags_all\") {\no, n := d.GetChange(\"tags_all\")\n\n")
+
+		if g.SDKVersion == 2 {
+			f.printf("if err := UpdateTags(ctx, conn, %s, o, n); err != nil {\n", g.taggingIdentifier())
+		} else {
+			f.printf("if err := UpdateTagsWithContext(ctx, conn, %s, o, n); err != nil {\n", g.taggingIdentifier())
+		}
+
+		f.printf("return diag.Errorf(\"updating %s %s (%%s) tags: %%s\", d.Id(), err)\n}\n}\n\n", g.AWSServiceName, g.Name)
+	}
+
+	f.printf("return resource%sRead(ctx, d, meta)\n}\n\n", g.Name)
+}
+
+func (g *generator) writeDelete(f *File) {
+	id := g.identifierMember(g.Delete, g.deleteInput)
+	decl, check := g.notFoundCheck(f)
+
+	f.printf("func resource%sDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {\n", g.Name)
+	g.writeConn(f)
+	f.printf("log.Printf(\"[INFO] Deleting %s %s: %%s\", d.Id())\n", g.AWSServiceName, g.Name)
+	f.printf("in := &%s.%sInput{\n%s: aws.String(d.Id()),\n}\n\n", g.sdkPackage(), g.Delete, id)
+	f.printf("_, err := %s\n\n", g.operationCall(g.Delete))
+	f.printf("%sif %s {\nreturn nil\n}\n\n", decl, check)
+	f.printf("if err != nil {\nreturn diag.Errorf(\"deleting %s %s (%%s): %%s\", d.Id(), err)\n}\n\n", g.AWSServiceName, g.Name)
+
+	if g.statusMember != "" {
+		f.printf("if _, err := wait%sDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {\n", g.Name)
+		f.printf("return diag.Errorf(\"waiting for %s %s (%%s) delete: %%s\", d.Id(), err)\n}\n\n", g.AWSServiceName, g.Name)
+	}
+
+	f.printf("return nil\n}\n\n")
+}
+
+// structureFields returns the fields of a structure shape, as mapped from the first field referencing it.
+func (g *generator) structureFields(name string) []*field {
+	var find func([]*field) []*field
+
+	find = func(fields []*field) []*field {
+		for _, f := range fields {
+			if len(f.Fields) > 0 && f.structureName() == name {
+				return f.Fields
+			}
+
+			if v := find(f.Fields); v != nil {
+				return v
+			}
+		}
+
+		return nil
+	}
+
+	return find(g.fields)
+}
+
+// writeFlex writes the expand and flatten functions for all referenced structures.
+func (g *generator) writeFlex(f *File) {
+	written := make(map[string]bool)
+
+	for {
+		var pending []string
+
+		for _, name := range sortedKeys(g.expanders) {
+			if !written["expand"+name] {
+				pending = append(pending, "expand"+name)
+			}
+		}
+
+		for _, name := range sortedKeys(g.flatteners) {
+			if !written["flatten"+name] {
+				pending = append(pending, "flatten"+name)
+			}
+		}
+
+		if len(pending) == 0 {
+			break
+		}
+
+		for _, v := range pending {
+			written[v] = true
+
+			if name := strings.TrimPrefix(v, "expand"); name != v {
+				g.writeExpandFunc(f, name)
+			} else {
+				g.writeFlattenFunc(f, strings.TrimPrefix(v, "flatten"))
+			}
+		}
+	}
+}
+
+func (g *generator) writeExpandFunc(f *File, name string) {
+	goType := fmt.Sprintf("%s.%s", g.typesPackage(), exportedName(name))
+	g.useTypes(f)
+
+	f.printf("func %s(tfMap map[string]interface{}) *%s {\n", g.funcName("expand", exportedName(name)), goType)
+	f.printf("if tfMap == nil {\nreturn nil\n}\n\n")
+	f.printf("apiObject := &%s{}\n\n", goType)
+
+	for _, field := range g.structureFields(name) {
+		g.writeExpand(f, "apiObject."+field.Member, exportedName(name), field, true)
+	}
+
+	f.printf("return apiObject\n}\n\n")
+
+	if !g.listExpand[name] {
+		return
+	}
+
+	elemType, elem := "*"+goType, "apiObject"
+
+	if g.SDKVersion == 2 {
+		elemType, elem = goType, "*apiObject"
+	}
+
+	f.printf("func %s(tfList []interface{}) []%s {\n", g.funcName("expand", plural(exportedName(name))), elemType)
+	f.printf("if len(tfList) == 0 {\nreturn nil\n}\n\n")
+	f.printf("var apiObjects []%s\n\n", elemType)
+	f.printf("for _, tfMapRaw := range tfList {\ntfMap, ok := tfMapRaw.(map[string]interface{})\n\nif !ok {\ncontinue\n}\n\n")
+	f.printf("apiObject := %s(tfMap)\n\nif apiObject == nil {\ncontinue\n}\n\n", g.funcName("expand", exportedName(name)))
+	f.printf("apiObjects = append(apiObjects, %s)\n}\n\nreturn apiObjects\n}\n\n", elem)
+}
+
+func (g *generator) writeFlattenFunc(f *File, name string) {
+	goType := fmt.Sprintf("%s.%s", g.typesPackage(), exportedName(name))
+	g.useTypes(f)
+
+	g.writeFlattenMapFunc(f, name, goType)
+
+	if !g.listFlatten[name] {
+		return
+	}
+	elemType, elem := "*"+goType, "apiObject"
+
+	if g.SDKVersion == 2 {
+		elemType, elem = goType, "&apiObject"
+	}
+
+	f.printf("func %s(apiObjects []%s) []interface{} {\n", g.funcName("flatten", plural(exportedName(name))), elemType)
+	f.printf("if len(apiObjects) == 0 {\nreturn nil\n}\n\n")
+	f.printf("var tfList []interface{}\n\n")
+	f.printf("for _, apiObject := range apiObjects {\n")
+
+	if g.SDKVersion == 1 {
+		f.printf("if apiObject == nil {\ncontinue\n}\n\n")
+	}
+
+	f.printf("tfList = append(tfList, %s(%s))\n}\n\nreturn tfList\n}\n\n", g.funcName("flatten", exportedName(name)), elem)
+}
+
+func (g *generator) writeFlattenMapFunc(f *File, name, goType string) {
+	f.printf("func %s(apiObject *%s) map[string]interface{} {\n", g.funcName("flatten", exportedName(name)), goType)
+	f.printf("if apiObject == nil {\nreturn nil\n}\n\n")
+	f.printf("tfMap := map[string]interface{}{}\n\n")
+
+	for _, field := range g.structureFields(name) {
+		g.useFlatten(f, field)
+
+		if g.isValue(exportedName(name), field.Member) {
+			f.printf("tfMap[%q] = apiObject.%s\n\n", field.Attribute, field.Member)
+			continue
+		}
+
+		if field.kind(g.API) == "enum" && g.SDKVersion == 2 {
+			f.printf("if v := apiObject.%s; v != \"\" {\n", field.Member)
+		} else {
+			f.printf("if v := apiObject.%s; v != nil {\n", field.Member)
+		}
+
+		f.printf("tfMap[%q] = %s\n}\n\n", field.Attribute, g.flattenValue(field, "v"))
+	}
+
+	f.printf("return tfMap\n}\n\n")
+}
+
+func (g *generator) findFile() *File {
+	f := newFile()
+
+	f.use("context")
+	f.use(importResource)
+	f.use(importTFResource)
+	g.useSDK(f)
+
+	decl, check := g.notFoundCheck(f)
+	id := g.identifierMember(g.Read, g.readInput)
+
+	f.printf("func Find%sByID(ctx context.Context, conn %s, id string) (%s, error) {\n", g.Name, g.clientType(), g.readObjectType())
+	f.printf("in := &%s.%sInput{\n%s: aws.String(id),\n}\n\n", g.sdkPackage(), g.Read, id)
+	f.printf("out, err := %s\n\n", g.operationCall(g.Read))
+	f.printf("%sif %s {\nreturn nil, &resource.NotFoundError{\nLastError: err,\nLastRequest: in,\n}\n}\n\n", decl, check)
+	f.printf("if err != nil {\nreturn nil, err\n}\n\n")
+
+	if g.readPath == "out" {
+		f.printf("if out == nil {\nreturn nil, tfresource.NewEmptyResultError(in)\n}\n\n")
+	} else {
+		f.printf("if out == nil || %s == nil {\nreturn nil, tfresource.NewEmptyResultError(in)\n}\n\n", g.readPath)
+	}
+
+	f.printf("return %s, nil\n}\n", g.readPath)
+
+	return f
+}
+
+func (g *generator) statusFile() *File {
+	f := newFile()
+
+	f.use("context")
+	f.use(importResource)
+	f.use(importTFResource)
+	g.useClient(f)
+
+	var status string
+
+	if g.SDKVersion == 2 {
+		status = fmt.Sprintf("string(out.%s)", g.statusMember)
+	} else {
+		f.use(importAWSV1)
+		status = g.stringValue("out." + g.statusMember)
+	}
+
+	f.printf("func status%s(ctx context.Context, conn %s, id string) resource.StateRefreshFunc {\n", g.Name, g.clientType())
+	f.printf("return func() (interface{}, string, error) {\n")
+	f.printf("out, err := Find%sByID(ctx, conn, id)\n\n", g.Name)
+	f.printf("if tfresource.NotFound(err) {\nreturn nil, \"\", nil\n}\n\n")
+	f.printf("if err != nil {\nreturn nil, \"\", err\n}\n\n")
+	f.printf("return out, %s, nil\n}\n}\n", status)
+
+	return f
+}
+
+func (g *generator) waitFile() *File {
+	f := newFile()
+
+	f.use("context")
+	f.use("time")
+	f.use(importResource)
+	g.useClient(f)
+	g.useTypes(f)
+
+	deletePending := append(append([]string{}, g.deleting...), g.target...)
+
+	g.writeWait(f, "Created", g.pending, g.target)
+
+	if g.Update != "" {
+		g.writeWait(f, "Updated", g.pending, g.target)
+	}
+
+	g.writeWait(f, "Deleted", deletePending, nil)
+
+	return f
+}
+
+func (g *generator) writeWait(f *File, suffix string, pending, target []string) {
+	f.printf("\nfunc wait%s%s(ctx context.Context, conn %s, id string, timeout time.Duration) (%s, error) {\n", g.Name, suffix, g.clientType(), g.readObjectType())
+	f.printf("stateConf := &resource.StateChangeConf{\n")
+	f.printf("Pending: %s,\n", g.stringSlice(pending))
+	f.printf("Target: %s,\n", g.stringSlice(target))
+	f.printf("Refresh: status%s(ctx, conn, id),\n", g.Name)
+	f.printf("Timeout: timeout,\n}\n\n")
+	f.printf("outputRaw, err := stateConf.WaitForStateContext(ctx)\n\n")
+	f.printf("if output, ok := outputRaw.(%s); ok {\nreturn output, err\n}\n\n", g.readObjectType())
+	f.printf("return nil, err\n}\n")
+}
+
+func (g *generator) stringSlice(constants []string) string {
+	values := make([]string, len(constants))
+
+	for i, v := range constants {
+		if g.SDKVersion == 2 {
+			v = fmt.Sprintf("string(%s)", v)
+		}
+
+		values[i] = v
+	}
+
+	return fmt.Sprintf("[]string{%s}", strings.Join(values, ", "))
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func lowerFirst(name string) string {
+	if name == "" {
+		return name
+	}
+
+	return strings.ToLower(name[0:1]) + name[1:]
+}
+
+// plural returns the plural form of a structure name used in list expand and flatten function names.
+func plural(name string) string {
+	switch {
+	case strings.HasSuffix(name, "s"):
+		return name + "es"
+	case strings.HasSuffix(name, "y") && !strings.HasSuffix(name, "ey"):
+		return strings.TrimSuffix(name, "y") + "ies"
+	}
+
+	return name + "s"
+}
@@ -0,0 +1,227 @@
+package model
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// File is generated Go source code with its imports.
+type File struct {
+	imports map[string]string // Import path to name, if any
+	body    bytes.Buffer
+}
+
+func newFile() *File {
+	return &File{
+		imports: make(map[string]string),
+	}
+}
+
+func (f *File) use(path string) {
+	f.imports[path] = ""
+}
+
+func (f *File) useNamed(name, path string) {
+	f.imports[path] = name
+}
+
+func (f *File) printf(format string, a ...interface{}) {
+	fmt.Fprintf(&f.body, format, a...)
+}
+
+var (
+	blankLinesRegexp = regexp.MustCompile(`\n{3,}`)
+	openBraceRegexp  = regexp.MustCompile(`\{\n\n+`)
+	closeBraceRegexp = regexp.MustCompile(`\n\n+(\t*[})])`)
+)
+
+// code returns the generated declarations without redundant blank lines.
+// Generated code is unindented until formatted, so braces can be matched at the start of lines.
+func (f *File) code() []byte {
+	b := blankLinesRegexp.ReplaceAll(f.body.Bytes(), []byte("\n\n"))
+	b = openBraceRegexp.ReplaceAll(b, []byte("{\n"))
+	b = closeBraceRegexp.ReplaceAll(b, []byte("\n$1"))
+
+	return b
+}
+
+// importSpecs returns the file's import specs, standard library packages first.
+func (f *File) importSpecs() ([]string, []string) {
+	var std, other []string
+
+	for path, name := range f.imports {
+		spec := strconv.Quote(path)
+
+		if name != "" {
+			spec = name + " " + spec
+		}
+
+		if strings.Contains(strings.Split(path, "/")[0], ".") {
+			other = append(other, spec)
+		} else {
+			std = append(std, spec)
+		}
+	}
+
+	sort.Strings(std)
+	sort.Strings(other)
+
+	return std, other
+}
+
+// Source returns the formatted source code of a new file in the specified package.
+func (f *File) Source(pkg string) ([]byte, error) {
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+
+	std, other := f.importSpecs()
+
+	if len(std)+len(other) > 0 {
+		b.WriteString("import (\n")
+
+		for _, spec := range std {
+			fmt.Fprintf(&b, "\t%s\n", spec)
+		}
+
+		if len(std) > 0 && len(other) > 0 {
+			b.WriteString("\n")
+		}
+
+		for _, spec := range other {
+			fmt.Fprintf(&b, "\t%s\n", spec)
+		}
+
+		b.WriteString(")\n\n")
+	}
+
+	b.Write(f.code())
+
+	return formatSource(b.Bytes())
+}
+
+// AppendTo returns the formatted source code of an existing file with the generated declarations appended
+// and any missing imports added.
+func (f *File) AppendTo(src []byte) ([]byte, error) {
+	std, other := f.importSpecs()
+	b, err := addImports(src, append(std, other...))
+
+	if err != nil {
+		return nil, err
+	}
+
+	b = append(b, '\n')
+	b = append(b, f.code()...)
+
+	return formatSource(b)
+}
+
+// AddImports returns the formatted source code of an existing file with any missing imports added.
+// Import specs are import paths, quoted and optionally named, e.g. `_ "embed"`.
+func AddImports(src []byte, specs ...string) ([]byte, error) {
+	b, err := addImports(src, specs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return formatSource(b)
+}
+
+func addImports(src []byte, specs []string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ImportsOnly)
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing Go source: %w", err)
+	}
+
+	existing := make(map[string]bool)
+
+	for _, v := range file.Imports {
+		path, err := strconv.Unquote(v.Path.Value)
+
+		if err != nil {
+			return nil, err
+		}
+
+		existing[path] = true
+	}
+
+	var missing []string
+
+	for _, spec := range specs {
+		path := spec[strings.Index(spec, `"`):]
+
+		if path, _ := strconv.Unquote(path); !existing[path] {
+			missing = append(missing, spec)
+		}
+	}
+
+	var b bytes.Buffer
+
+	switch {
+	case len(missing) == 0:
+		b.Write(src)
+	case len(file.Imports) == 0:
+		// Add an import declaration after the package clause.
+		offset := fset.Position(file.Name.End()).Offset
+		b.Write(src[:offset])
+		fmt.Fprintf(&b, "\n\nimport (\n\t%s\n)\n", strings.Join(missing, "\n\t"))
+		b.Write(src[offset:])
+	default:
+		// Add the missing imports to the first import declaration.
+		decl := file.Decls[0]
+		offset := fset.Position(decl.End()).Offset - 1
+
+		if src[offset] != ')' {
+			offset = fset.Position(decl.Pos()).Offset + len("import")
+			b.Write(src[:offset])
+			fmt.Fprintf(&b, " (\n\t%s\n", strings.Join(missing, "\n\t"))
+			b.Write(src[offset:fset.Position(decl.End()).Offset])
+			b.WriteString("\n)")
+			b.Write(src[fset.Position(decl.End()).Offset:])
+			break
+		}
+
+		b.Write(src[:offset])
+		fmt.Fprintf(&b, "\t%s\n", strings.Join(missing, "\n\t"))
+		b.Write(src[offset:])
+	}
+
+	return b.Bytes(), nil
+}
+
+func formatSource(src []byte) ([]byte, error) {
+	formatted, err := format.Source(src)
+
+	if err != nil {
+		return src, fmt.Errorf("error formatting generated source: %w", err)
+	}
+
+	// Linter directives must not be separated from the comment marker, but other comments are left as they are.
+	directives := make(map[string]bool)
+
+	for _, line := range strings.Split(string(src), "\n") {
+		if strings.Contains(line, "//lintignore:") {
+			directives[strings.TrimSpace(line)] = true
+		}
+	}
+
+	lines := strings.Split(string(formatted), "\n")
+
+	for i, line := range lines {
+		if v := strings.Replace(line, "// lintignore:", "//lintignore:", 1); v != line && directives[strings.TrimSpace(v)] {
+			lines[i] = v
+		}
+	}
+
+	return []byte(strings.Join(lines, "\n")), nil
+}
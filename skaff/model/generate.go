@@ -0,0 +1,682 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// maxDepth is the maximum nesting depth of generated schema blocks.
+	maxDepth = 5
+)
+
+// Resource describes a Terraform resource generated from the operations of an AWS API model.
+type Resource struct {
+	API *API
+
+	AWSServiceName string // Human-friendly service name used in messages, e.g. "MQ"
+	ClientTypeName string // AWS SDK for Go client type name, e.g. "MQ" or "Client"
+	GoPackage      string // AWS SDK for Go package name, e.g. "mq"
+	Name           string // Resource name, e.g. "Broker"
+	SDKVersion     int    // AWS SDK for Go major version, 1 or 2
+	Service        string // Provider service name used for the AWSClient connection, e.g. "MQ"
+	ServicePackage string // Provider service package name, e.g. "mq"
+
+	Create string // Create operation name, e.g. "CreateBroker"
+	Read   string // Read operation name, e.g. "DescribeBroker"
+	Update string // Optional update operation name, e.g. "UpdateBroker"
+	Delete string // Delete operation name, e.g. "DeleteBroker"
+
+	// GoTypes are the AWS SDK for Go v2 package's struct field types, used to distinguish value from pointer members.
+	// If nil, all scalar members are assumed to be pointers.
+	GoTypes GoTypes
+
+	// Declared are the functions already declared in the service package, which are not generated again.
+	Declared map[string]bool
+}
+
+// Code is the source code generated for a resource.
+// Find, Status and Wait contain only declarations and are added to the service package's
+// find.go, status.go and wait.go files.
+type Code struct {
+	Resource *File
+	Test     *File
+	Find     *File
+	Status   *File
+	Wait     *File
+
+	// Warnings lists API model members which could not be mapped to the Terraform schema.
+	Warnings []string
+}
+
+// field is a structure member mapped to a Terraform schema attribute.
+type field struct {
+	Member    string // Go struct field name, e.g. "BrokerName"
+	Attribute string // Terraform attribute name, e.g. "broker_name"
+	ShapeName string
+	Shape     Shape
+
+	Computed bool
+	ForceNew bool
+	Optional bool
+	Required bool
+
+	// Fields are the nested fields of structure and list of structure shapes.
+	Fields []*field
+}
+
+func (f *field) kind(api *API) string {
+	switch f.Shape.Type {
+	case "list":
+		elem := api.Shapes[f.Shape.Member.Shape]
+
+		if elem.Type == "structure" {
+			return "list-structure"
+		}
+
+		return "list-" + elem.Type
+	case "map":
+		return "map-" + api.Shapes[f.Shape.Value.Shape].Type
+	case "string":
+		if len(f.Shape.Enum) > 0 {
+			return "enum"
+		}
+	}
+
+	return f.Shape.Type
+}
+
+// generator holds the state of a single resource's code generation.
+type generator struct {
+	*Resource
+
+	createInput Shape
+	readInput   Shape
+	updateInput Shape
+	deleteInput Shape
+
+	// readObject is the shape describing the resource, and readPath the Go expression selecting it in the Read output.
+	readObject     Shape
+	readObjectName string
+	readPath       string
+
+	idMember     string
+	createIDPath string
+
+	notFound string
+
+	statusMember string
+	pending      []string
+	target       []string
+	deleting     []string
+
+	arnAttribute string
+	tags         bool
+	tagsInRead   bool
+
+	fields      []*field
+	expanders   map[string]bool // Structure shape names needing expand functions
+	flatteners  map[string]bool // Structure shape names needing flatten functions
+	listExpand  map[string]bool
+	listFlatten map[string]bool
+	enums       map[string]bool // Enum shape names needing v2 value functions
+	warnings    []string
+}
+
+// Generate generates the source code for the resource.
+func (r *Resource) Generate() (*Code, error) {
+	if r.SDKVersion != 1 && r.SDKVersion != 2 {
+		return nil, fmt.Errorf("unsupported AWS SDK for Go version: %d", r.SDKVersion)
+	}
+
+	g := &generator{
+		Resource:    r,
+		expanders:   make(map[string]bool),
+		flatteners:  make(map[string]bool),
+		listExpand:  make(map[string]bool),
+		listFlatten: make(map[string]bool),
+		enums:       make(map[string]bool),
+	}
+
+	if err := g.analyze(); err != nil {
+		return nil, err
+	}
+
+	code := &Code{
+		Resource: g.resourceFile(),
+		Test:     g.testFile(),
+		Find:     g.findFile(),
+	}
+
+	if g.statusMember != "" {
+		code.Status = g.statusFile()
+		code.Wait = g.waitFile()
+	}
+
+	code.Warnings = g.warnings
+
+	return code, nil
+}
+
+func (g *generator) operationShapes(name string, required bool) (Shape, Shape, []ShapeRef, error) {
+	if name == "" {
+		if required {
+			return Shape{}, Shape{}, nil, fmt.Errorf("%s operation is required", g.Name)
+		}
+
+		return Shape{}, Shape{}, nil, nil
+	}
+
+	op, err := g.API.Operation(name)
+
+	if err != nil {
+		return Shape{}, Shape{}, nil, err
+	}
+
+	input, err := g.API.Shape(op.Input)
+
+	if err != nil {
+		return Shape{}, Shape{}, nil, err
+	}
+
+	output, err := g.API.Shape(op.Output)
+
+	if err != nil {
+		return Shape{}, Shape{}, nil, err
+	}
+
+	return input, output, op.Errors, nil
+}
+
+func (g *generator) analyze() error {
+	var createOutput, readOutput Shape
+	var readErrors []ShapeRef
+	var err error
+
+	if g.createInput, createOutput, _, err = g.operationShapes(g.Create, true); err != nil {
+		return err
+	}
+
+	if g.readInput, readOutput, readErrors, err = g.operationShapes(g.Read, true); err != nil {
+		return err
+	}
+
+	if g.updateInput, _, _, err = g.operationShapes(g.Update, false); err != nil {
+		return err
+	}
+
+	if g.deleteInput, _, _, err = g.operationShapes(g.Delete, true); err != nil {
+		return err
+	}
+
+	// The resource identifier is the Read operation's (first) required input member.
+	required := append([]string{}, g.readInput.Required...)
+	sort.Strings(required)
+
+	if len(required) == 0 {
+		return fmt.Errorf("%s input has no required members to use as the resource identifier", g.Read)
+	}
+
+	g.idMember = required[0]
+
+	if len(required) > 1 {
+		g.warn("%s input has multiple required members (%s), only %s is used as the resource identifier", g.Read, strings.Join(required, ", "), g.idMember)
+	}
+
+	// The resource is either described by the Read output or by a structure member of the output named after the resource.
+	g.readObject = readOutput
+	g.readObjectName = g.API.Operations[g.Read].Output.shapeName()
+	g.readPath = "out"
+
+	if ref, ok := readOutput.Members[g.Name]; ok && g.API.Shapes[ref.Shape].Type == "structure" {
+		g.readObject = g.API.Shapes[ref.Shape]
+		g.readObjectName = ref.Shape
+		g.readPath = "out." + g.Name
+	} else if names := structureMembers(g.API, readOutput); len(readOutput.Members) == 1 && len(names) == 1 {
+		ref := readOutput.Members[names[0]]
+		g.readObject = g.API.Shapes[ref.Shape]
+		g.readObjectName = ref.Shape
+		g.readPath = "out." + names[0]
+	}
+
+	if path := findMemberPath(g.API, createOutput, g.idMember); path != "" {
+		g.createIDPath = "out." + path
+	} else if _, ok := g.createInput.Members[g.idMember]; !ok {
+		return fmt.Errorf("cannot determine the resource identifier (%s) from the %s output or input", g.idMember, g.Create)
+	}
+
+	g.notFound = notFoundError(readErrors)
+
+	if g.notFound == "" {
+		g.notFound = "ResourceNotFoundException"
+		g.warn("%s has no not found error, using %s", g.Read, g.notFound)
+	}
+
+	g.analyzeStatus()
+
+	for _, name := range []string{"Arn", g.Name + "Arn", g.Name + "ARN", "ARN"} {
+		if _, ok := g.readObject.Members[name]; ok {
+			g.arnAttribute = toSnakeCase(name)
+			break
+		}
+	}
+
+	if _, ok := g.createInput.Members["Tags"]; ok {
+		g.tags = true
+		_, g.tagsInRead = g.readObject.Members["Tags"]
+	}
+
+	g.fields = g.topLevelFields()
+
+	return nil
+}
+
+func (g *generator) warn(format string, a ...interface{}) {
+	g.warnings = append(g.warnings, fmt.Sprintf(format, a...))
+}
+
+func (ref *ShapeRef) shapeName() string {
+	if ref == nil {
+		return ""
+	}
+
+	return ref.Shape
+}
+
+// structureMembers returns the names of the shape's structure members in sorted order.
+func structureMembers(api *API, shape Shape) []string {
+	var names []string
+
+	for _, name := range shape.MemberNames() {
+		if api.Shapes[shape.Members[name].Shape].Type == "structure" {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// findMemberPath returns the Go selector path of the named member in the shape or one of its structure members.
+func findMemberPath(api *API, shape Shape, member string) string {
+	if _, ok := shape.Members[member]; ok {
+		return member
+	}
+
+	for _, name := range structureMembers(api, shape) {
+		if _, ok := api.Shapes[shape.Members[name].Shape].Members[member]; ok {
+			return name + "." + member
+		}
+	}
+
+	return ""
+}
+
+// notFoundError returns the name of the operation error indicating that a resource does not exist.
+func notFoundError(errors []ShapeRef) string {
+	names := make([]string, 0, len(errors))
+
+	for _, v := range errors {
+		names = append(names, v.Shape)
+	}
+
+	sort.Strings(names)
+
+	for _, pattern := range []string{"ResourceNotFound", "NotFound", "NoSuch"} {
+		for _, name := range names {
+			if strings.Contains(name, pattern) {
+				return name
+			}
+		}
+	}
+
+	return ""
+}
+
+var (
+	pendingStatusPatterns = []string{"CREATING", "PENDING", "PROGRESS", "UPDATING", "PROVISIONING", "STARTING", "MODIFYING", "INITIALIZING"}
+	targetStatusValues    = []string{"ACTIVE", "AVAILABLE", "COMPLETED", "CREATED", "ENABLED", "HEALTHY", "INSERVICE", "READY", "RUNNING", "STABLE", "SUCCEEDED", "UPDATED"}
+)
+
+// analyzeStatus finds the resource's status member and classifies its enum values as pending, target or deleting.
+func (g *generator) analyzeStatus() {
+	candidates := []string{"Status", "State", g.Name + "Status", g.Name + "State"}
+
+	for _, name := range g.readObject.MemberNames() {
+		if strings.HasSuffix(name, "Status") || strings.HasSuffix(name, "State") {
+			candidates = append(candidates, name)
+		}
+	}
+
+	for _, name := range candidates {
+		ref, ok := g.readObject.Members[name]
+
+		if !ok {
+			continue
+		}
+
+		// The status may be nested in a structure, e.g. Status.StatusCode.
+		path := name
+
+		if shape := g.API.Shapes[ref.Shape]; shape.Type == "structure" {
+			for _, member := range []string{"StatusCode", "State", "Status", "Code"} {
+				if v, ok := shape.Members[member]; ok && len(g.API.Shapes[v.Shape].Enum) > 0 {
+					path, ref = name+"."+member, v
+					break
+				}
+			}
+		}
+
+		if len(g.API.Shapes[ref.Shape].Enum) == 0 {
+			continue
+		}
+
+		var pending, target, deleting []string
+
+		for _, value := range g.API.Shapes[ref.Shape].Enum {
+			normalized := strings.ToUpper(regexp.MustCompile(`[^A-Za-z]`).ReplaceAllString(value, ""))
+
+			switch {
+			case strings.Contains(normalized, "DELET"):
+				deleting = append(deleting, value)
+			case strings.Contains(normalized, "FAIL"):
+			case containsString(targetStatusValues, normalized):
+				target = append(target, value)
+			case containsPattern(pendingStatusPatterns, normalized):
+				pending = append(pending, value)
+			}
+		}
+
+		if len(target) == 0 {
+			continue
+		}
+
+		g.statusMember = path
+		g.pending = g.enumConstants(ref.Shape, pending)
+		g.target = g.enumConstants(ref.Shape, target)
+		g.deleting = g.enumConstants(ref.Shape, deleting)
+
+		return
+	}
+
+	g.warn("%s has no status member, no waiters are generated", g.readObjectName)
+}
+
+func containsString(l []string, s string) bool {
+	for _, v := range l {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsPattern(l []string, s string) bool {
+	for _, v := range l {
+		if strings.Contains(s, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// enumConstants returns the Go constant names of the enum values.
+func (g *generator) enumConstants(shapeName string, values []string) []string {
+	var constants []string
+
+	for _, v := range values {
+		constants = append(constants, g.typesPackage()+"."+exportedName(shapeName)+EnumName(v))
+	}
+
+	return constants
+}
+
+var (
+	enumStrip     = regexp.MustCompile(`[^a-zA-Z0-9_:\./-]`)
+	enumDelims    = regexp.MustCompile(`[-_:\./]+`)
+	enumCamelCase = regexp.MustCompile(`([a-z])([A-Z])`)
+)
+
+// EnumName returns the Go constant name suffix of an enum value, e.g. "CreationInProgress" for "CREATION_IN_PROGRESS".
+func EnumName(value string) string {
+	value = enumStrip.ReplaceAllLiteralString(value, "")
+	value = enumCamelCase.ReplaceAllString(value, "$1-$2")
+	parts := enumDelims.Split(value, -1)
+
+	for i, v := range parts {
+		v = strings.ToLower(v)
+		parts[i] = ""
+
+		if len(v) > 0 {
+			parts[i] = strings.ToUpper(v[0:1])
+		}
+
+		if len(v) > 1 {
+			parts[i] += v[1:]
+		}
+	}
+
+	return strings.Join(parts, "")
+}
+
+func exportedName(name string) string {
+	if name == "" {
+		return name
+	}
+
+	return strings.ToUpper(name[0:1]) + name[1:]
+}
+
+// toSnakeCase returns the Terraform attribute name of a structure member, e.g. "broker_name" for "BrokerName".
+func toSnakeCase(name string) string {
+	name = regexp.MustCompile(`([a-z0-9])([A-Z])`).ReplaceAllString(name, `${1}_${2}`)
+	name = regexp.MustCompile(`([A-Z]+)([A-Z][a-z])`).ReplaceAllString(name, `${1}_${2}`)
+
+	return strings.ToLower(name)
+}
+
+// isIgnoredMember returns whether the structure member is not mapped to the Terraform schema.
+func isIgnoredMember(name string) bool {
+	switch name {
+	case "ClientToken", "ClientRequestToken", "IdempotencyToken", "DryRun", "NextToken", "MaxResults", "Tags", "TagList":
+		return true
+	}
+
+	return false
+}
+
+// topLevelFields returns the resource's top-level schema fields.
+// Arguments are the Create input members and attributes are the remaining members describing the resource.
+func (g *generator) topLevelFields() []*field {
+	var fields []*field
+	seen := make(map[string]bool)
+
+	for _, name := range g.createInput.MemberNames() {
+		if isIgnoredMember(name) || toSnakeCase(name) == "id" {
+			continue
+		}
+
+		ref := g.createInput.Members[name]
+		f := g.newField(name, ref, nil, 1, false)
+
+		if f == nil {
+			continue
+		}
+
+		f.Required = g.createInput.IsRequired(name)
+		f.Optional = !f.Required
+
+		if _, ok := g.readObject.Members[name]; ok && f.Optional {
+			f.Computed = true
+		}
+
+		if _, ok := g.updateInput.Members[name]; !ok {
+			f.ForceNew = true
+		}
+
+		fields = append(fields, f)
+		seen[name] = true
+	}
+
+	for _, name := range g.readObject.MemberNames() {
+		if seen[name] || isIgnoredMember(name) || toSnakeCase(name) == "id" {
+			continue
+		}
+
+		f := g.newField(name, g.readObject.Members[name], nil, 1, true)
+
+		if f == nil {
+			continue
+		}
+
+		f.Computed = true
+		fields = append(fields, f)
+	}
+
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Attribute < fields[j].Attribute
+	})
+
+	return fields
+}
+
+// newField maps a structure member to a schema field, returning nil for unsupported shapes.
+func (g *generator) newField(name string, ref ShapeRef, path []string, depth int, computed bool) *field {
+	shape, ok := g.API.Shapes[ref.Shape]
+
+	if !ok {
+		g.warn("%s: shape %s not found", name, ref.Shape)
+		return nil
+	}
+
+	f := &field{
+		Member:    name,
+		Attribute: toSnakeCase(name),
+		ShapeName: ref.Shape,
+		Shape:     shape,
+	}
+
+	var structureName string
+
+	switch f.kind(g.API) {
+	case "string", "enum", "boolean", "integer", "long", "float", "double", "timestamp":
+		return f
+	case "list-string", "map-string":
+		// AWS SDK for Go v2 enum slices and maps have element types other than string.
+		if elem := g.API.Shapes[g.elementShapeName(shape)]; g.SDKVersion == 1 || len(elem.Enum) == 0 {
+			return f
+		}
+	case "list-integer", "list-long":
+		if g.SDKVersion == 1 {
+			return f
+		}
+	case "structure":
+		structureName = ref.Shape
+	case "list-structure":
+		structureName = shape.Member.Shape
+	}
+
+	if structureName == "" {
+		g.warn("%s: unsupported %s shape (%s)", name, shape.Type, ref.Shape)
+		return nil
+	}
+
+	if depth >= maxDepth || containsString(path, structureName) {
+		g.warn("%s: recursive or deeply nested structure (%s)", name, structureName)
+		return nil
+	}
+
+	structure := g.API.Shapes[structureName]
+
+	for _, member := range structure.MemberNames() {
+		child := g.newField(member, structure.Members[member], append(path, structureName), depth+1, computed)
+
+		if child == nil {
+			continue
+		}
+
+		if computed {
+			child.Computed = true
+		} else {
+			child.Required = structure.IsRequired(member)
+			child.Optional = !child.Required
+		}
+
+		f.Fields = append(f.Fields, child)
+	}
+
+	if len(f.Fields) == 0 {
+		g.warn("%s: structure (%s) has no supported members", name, structureName)
+		return nil
+	}
+
+	return f
+}
+
+// elementShapeName returns the shape name of a list's members or a map's values.
+func (g *generator) elementShapeName(shape Shape) string {
+	if shape.Type == "list" {
+		return shape.Member.Shape
+	}
+
+	return shape.Value.Shape
+}
+
+// isValue returns whether a structure member is a non-pointer scalar Go struct field.
+// This is only the case for some AWS SDK for Go v2 members, e.g. "Enabled bool".
+func (g *generator) isValue(structName, member string) bool {
+	if g.SDKVersion != 2 {
+		return false
+	}
+
+	t, ok := g.GoTypes.FieldType(structName, member)
+
+	switch {
+	case !ok:
+		return false
+	case strings.HasPrefix(t, "*"), strings.HasPrefix(t, "[]"), strings.HasPrefix(t, "map["):
+		return false
+	}
+
+	switch t {
+	case "bool", "int32", "int64", "float32", "float64":
+		return true
+	}
+
+	return false
+}
+
+// structureName returns the structure shape name of a structure or list of structure field.
+func (f *field) structureName() string {
+	if f.Shape.Type == "list" {
+		return f.Shape.Member.Shape
+	}
+
+	return f.ShapeName
+}
+
+// sdkPackage returns the name of the AWS SDK for Go package containing the operation input and output types.
+func (g *generator) sdkPackage() string {
+	return g.GoPackage
+}
+
+// typesPackage returns the name of the AWS SDK for Go package containing the shape types.
+func (g *generator) typesPackage() string {
+	if g.SDKVersion == 2 {
+		return "types"
+	}
+
+	return g.GoPackage
+}
+
+// operationCall returns the Go method call expression for an operation.
+func (g *generator) operationCall(name string) string {
+	if g.SDKVersion == 2 {
+		return fmt.Sprintf("conn.%s(ctx, in)", name)
+	}
+
+	return fmt.Sprintf("conn.%sWithContext(ctx, in)", name)
+}
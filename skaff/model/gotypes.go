@@ -0,0 +1,99 @@
+package model
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// GoTypes are the struct field types declared in Go packages, by struct and field name.
+// The AWS SDK for Go v2 uses value types for some members, which API models do not describe.
+type GoTypes map[string]map[string]string
+
+// FieldType returns the type expression of a struct field, e.g. "*int32".
+func (t GoTypes) FieldType(structName, fieldName string) (string, bool) {
+	if t == nil {
+		return "", false
+	}
+
+	v, ok := t[structName][fieldName]
+
+	return v, ok
+}
+
+// LoadGoTypes reads the struct field types declared in the Go packages in the specified directories.
+func LoadGoTypes(dirs ...string) (GoTypes, error) {
+	t := make(GoTypes)
+
+	for _, dir := range dirs {
+		pkgs, err := parser.ParseDir(token.NewFileSet(), dir, nil, 0)
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing Go package (%s): %w", dir, err)
+		}
+
+		for _, pkg := range pkgs {
+			for _, file := range pkg.Files {
+				for _, decl := range file.Decls {
+					decl, ok := decl.(*ast.GenDecl)
+
+					if !ok || decl.Tok != token.TYPE {
+						continue
+					}
+
+					for _, spec := range decl.Specs {
+						spec := spec.(*ast.TypeSpec)
+						st, ok := spec.Type.(*ast.StructType)
+
+						if !ok {
+							continue
+						}
+
+						fields := make(map[string]string)
+
+						for _, field := range st.Fields.List {
+							for _, name := range field.Names {
+								fields[name.Name] = types.ExprString(field.Type)
+							}
+						}
+
+						t[spec.Name.Name] = fields
+					}
+				}
+			}
+		}
+	}
+
+	return t, nil
+}
+
+// DeclaredFuncs returns the names of the functions declared in the Go package in the specified directory,
+// excluding tests and the specified files, e.g. a file about to be regenerated.
+func DeclaredFuncs(dir string, exclude ...string) (map[string]bool, error) {
+	funcs := make(map[string]bool)
+
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && !containsString(exclude, fi.Name())
+	}, 0)
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing Go package (%s): %w", filepath.Base(dir), err)
+	}
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				if decl, ok := decl.(*ast.FuncDecl); ok && decl.Recv == nil {
+					funcs[decl.Name.Name] = true
+				}
+			}
+		}
+	}
+
+	return funcs, nil
+}
@@ -0,0 +1,229 @@
+// Package model reads AWS API models, as distributed with the AWS SDK for Go,
+// and generates Terraform resource source code from their shapes.
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	sdkV1Module              = "github.com/aws/aws-sdk-go"
+	sdkV2ServiceModulePrefix = "github.com/aws/aws-sdk-go-v2/service/"
+)
+
+// API is an AWS API model (api-2.json).
+type API struct {
+	Metadata   Metadata             `json:"metadata"`
+	Operations map[string]Operation `json:"operations"`
+	Shapes     map[string]Shape     `json:"shapes"`
+}
+
+// Metadata is an AWS API model's metadata.
+type Metadata struct {
+	EndpointPrefix      string `json:"endpointPrefix"`
+	Protocol            string `json:"protocol"`
+	ServiceAbbreviation string `json:"serviceAbbreviation"`
+	ServiceFullName     string `json:"serviceFullName"`
+	ServiceID           string `json:"serviceId"`
+	UID                 string `json:"uid"`
+}
+
+// Operation is an AWS API operation.
+type Operation struct {
+	Name   string     `json:"name"`
+	Input  *ShapeRef  `json:"input"`
+	Output *ShapeRef  `json:"output"`
+	Errors []ShapeRef `json:"errors"`
+}
+
+// ShapeRef is a reference to a shape, e.g. by a structure member.
+type ShapeRef struct {
+	Shape        string `json:"shape"`
+	LocationName string `json:"locationName"`
+}
+
+// Shape is an AWS API shape.
+type Shape struct {
+	Type      string              `json:"type"`
+	Members   map[string]ShapeRef `json:"members"`
+	Required  []string            `json:"required"`
+	Member    *ShapeRef           `json:"member"`
+	Key       *ShapeRef           `json:"key"`
+	Value     *ShapeRef           `json:"value"`
+	Enum      []string            `json:"enum"`
+	Exception bool                `json:"exception"`
+	Min       *float64            `json:"min"`
+	Max       *float64            `json:"max"`
+}
+
+// IsRequired returns whether the structure member is required.
+func (s Shape) IsRequired(member string) bool {
+	for _, v := range s.Required {
+		if v == member {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MemberNames returns the structure's member names in sorted order.
+func (s Shape) MemberNames() []string {
+	names := make([]string, 0, len(s.Members))
+
+	for name := range s.Members {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Load reads an AWS API model from the specified file.
+func Load(path string) (*API, error) {
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading API model (%s): %w", path, err)
+	}
+
+	api := &API{}
+
+	if err := json.Unmarshal(b, api); err != nil {
+		return nil, fmt.Errorf("error decoding API model (%s): %w", path, err)
+	}
+
+	for name, op := range api.Operations {
+		op.Name = name
+		api.Operations[name] = op
+	}
+
+	// Structure members are Go struct fields in the AWS SDK for Go, e.g. "workspaceId" is WorkspaceId.
+	for name, shape := range api.Shapes {
+		members := make(map[string]ShapeRef, len(shape.Members))
+
+		for member, ref := range shape.Members {
+			members[exportedName(member)] = ref
+		}
+
+		required := make([]string, len(shape.Required))
+
+		for i, member := range shape.Required {
+			required[i] = exportedName(member)
+		}
+
+		shape.Members = members
+		shape.Required = required
+		api.Shapes[name] = shape
+	}
+
+	return api, nil
+}
+
+// Operation returns the named operation.
+func (api *API) Operation(name string) (Operation, error) {
+	op, ok := api.Operations[name]
+
+	if !ok {
+		return Operation{}, fmt.Errorf("operation (%s) not found in API model (%s)", name, api.Metadata.ServiceID)
+	}
+
+	return op, nil
+}
+
+// Shape returns the referenced shape.
+func (api *API) Shape(ref *ShapeRef) (Shape, error) {
+	if ref == nil {
+		return Shape{Type: "structure"}, nil
+	}
+
+	shape, ok := api.Shapes[ref.Shape]
+
+	if !ok {
+		return Shape{}, fmt.Errorf("shape (%s) not found in API model (%s)", ref.Shape, api.Metadata.ServiceID)
+	}
+
+	return shape, nil
+}
+
+// SDKDir returns the directory of the AWS SDK for Go module required by the Go module in the current directory.
+// The AWS API models are distributed with the module.
+func SDKDir() (string, error) {
+	return moduleDir(sdkV1Module)
+}
+
+// SDKV2PackageDir returns the directory of the AWS SDK for Go v2 service module required by the Go module
+// in the current directory, e.g. for the "kendra" package.
+func SDKV2PackageDir(goPackage string) (string, error) {
+	return moduleDir(sdkV2ServiceModulePrefix + goPackage)
+}
+
+func moduleDir(path string) (string, error) {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", path).Output()
+
+	if err != nil {
+		return "", fmt.Errorf("error locating Go module (%s): %w", path, err)
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
+
+var serviceIDRegexp = regexp.MustCompile(`(?m)^\s*ServiceID\s*=\s*"([^"]+)"`)
+
+// Find returns the path of the latest API model for the AWS SDK for Go v1 package.
+// Models are matched by the service ID declared in the package, e.g. "amp" for the prometheusservice package.
+func Find(sdkDir, goV1Package string) (string, error) {
+	src, err := os.ReadFile(filepath.Join(sdkDir, "service", goV1Package, "service.go"))
+
+	if err != nil {
+		return "", fmt.Errorf("error reading AWS SDK for Go package (%s): %w", goV1Package, err)
+	}
+
+	m := serviceIDRegexp.FindSubmatch(src)
+
+	if m == nil {
+		return "", fmt.Errorf("no service ID found in AWS SDK for Go package (%s)", goV1Package)
+	}
+
+	return findModel(sdkDir, string(m[1]))
+}
+
+// findModel returns the path of the latest API model with the service ID.
+func findModel(sdkDir, serviceID string) (string, error) {
+	paths, err := filepath.Glob(filepath.Join(sdkDir, "models", "apis", "*", "*", "api-2.json"))
+
+	if err != nil {
+		return "", err
+	}
+
+	// API versions are dates, so the last matching path is the latest version.
+	sort.Strings(paths)
+
+	var found string
+
+	for _, path := range paths {
+		api, err := Load(path)
+
+		if err != nil {
+			return "", err
+		}
+
+		if api.Metadata.ServiceID == serviceID {
+			found = path
+		}
+	}
+
+	if found == "" {
+		return "", fmt.Errorf("no API model found for service ID (%s)", serviceID)
+	}
+
+	return found, nil
+}
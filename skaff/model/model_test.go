@@ -0,0 +1,391 @@
+package model
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testAPIModel = `{
+  "metadata": {
+    "endpointPrefix": "example",
+    "protocol": "rest-json",
+    "serviceId": "Example",
+    "uid": "example-2022-01-01"
+  },
+  "operations": {
+    "CreateThing": {
+      "input": {"shape": "CreateThingRequest"},
+      "output": {"shape": "CreateThingResponse"}
+    },
+    "DescribeThing": {
+      "input": {"shape": "DescribeThingRequest"},
+      "output": {"shape": "DescribeThingResponse"},
+      "errors": [{"shape": "AccessDeniedException"}, {"shape": "ResourceNotFoundException"}]
+    },
+    "UpdateThing": {
+      "input": {"shape": "UpdateThingRequest"}
+    },
+    "DeleteThing": {
+      "input": {"shape": "DeleteThingRequest"}
+    }
+  },
+  "shapes": {
+    "AccessDeniedException": {"type": "structure", "members": {}, "exception": true},
+    "Boolean": {"type": "boolean"},
+    "CreateThingRequest": {
+      "type": "structure",
+      "required": ["name"],
+      "members": {
+        "clientToken": {"shape": "String"},
+        "description": {"shape": "String"},
+        "name": {"shape": "String"},
+        "settings": {"shape": "Settings"},
+        "tags": {"shape": "TagMap"}
+      }
+    },
+    "CreateThingResponse": {
+      "type": "structure",
+      "members": {
+        "thingId": {"shape": "String"}
+      }
+    },
+    "DeleteThingRequest": {
+      "type": "structure",
+      "required": ["thingId"],
+      "members": {"thingId": {"shape": "String"}}
+    },
+    "DescribeThingRequest": {
+      "type": "structure",
+      "required": ["thingId"],
+      "members": {"thingId": {"shape": "String"}}
+    },
+    "DescribeThingResponse": {
+      "type": "structure",
+      "members": {"thing": {"shape": "ThingDescription"}}
+    },
+    "Integer": {"type": "integer"},
+    "ResourceNotFoundException": {"type": "structure", "members": {}, "exception": true},
+    "Settings": {
+      "type": "structure",
+      "required": ["mode"],
+      "members": {
+        "enabled": {"shape": "Boolean"},
+        "mode": {"shape": "SettingsMode"},
+        "values": {"shape": "StringList"}
+      }
+    },
+    "SettingsMode": {"type": "string", "enum": ["AUTOMATIC", "MANUAL"]},
+    "String": {"type": "string"},
+    "StringList": {"type": "list", "member": {"shape": "String"}},
+    "TagMap": {"type": "map", "key": {"shape": "String"}, "value": {"shape": "String"}},
+    "ThingDescription": {
+      "type": "structure",
+      "members": {
+        "arn": {"shape": "String"},
+        "createdAt": {"shape": "Timestamp"},
+        "description": {"shape": "String"},
+        "name": {"shape": "String"},
+        "settings": {"shape": "Settings"},
+        "status": {"shape": "ThingStatus"},
+        "tags": {"shape": "TagMap"},
+        "thingId": {"shape": "String"}
+      }
+    },
+    "ThingStatus": {"type": "string", "enum": ["CREATING", "ACTIVE", "UPDATING", "DELETING", "CREATION_FAILED"]},
+    "Timestamp": {"type": "timestamp"},
+    "UpdateThingRequest": {
+      "type": "structure",
+      "required": ["thingId"],
+      "members": {
+        "description": {"shape": "String"},
+        "settings": {"shape": "Settings"},
+        "thingId": {"shape": "String"}
+      }
+    }
+  }
+}`
+
+func testAPI(t *testing.T) *API {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "api-2.json")
+
+	if err := os.WriteFile(path, []byte(testAPIModel), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	api, err := Load(path)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return api
+}
+
+func TestEnumName(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected string
+	}{
+		{
+			TestName: "upper",
+			Input:    "ACTIVE",
+			Expected: "Active",
+		},
+		{
+			TestName: "underscores",
+			Input:    "CREATION_IN_PROGRESS",
+			Expected: "CreationInProgress",
+		},
+		{
+			TestName: "camel case",
+			Input:    "InService",
+			Expected: "InService",
+		},
+		{
+			TestName: "dashes and dots",
+			Input:    "m5.large-v2",
+			Expected: "M5LargeV2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := EnumName(testCase.Input)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	api := testAPI(t)
+
+	shape := api.Shapes["CreateThingRequest"]
+
+	if _, ok := shape.Members["ClientToken"]; !ok {
+		t.Errorf("expected member names to be exported, got %v", shape.MemberNames())
+	}
+
+	if !shape.IsRequired("Name") {
+		t.Errorf("expected Name to be required, got %v", shape.Required)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	testCases := []struct {
+		TestName   string
+		SDKVersion int
+		GoTypes    GoTypes
+		Declared   map[string]bool
+		Expected   map[string][]string
+	}{
+		{
+			TestName:   "v1",
+			SDKVersion: 1,
+			Declared:   map[string]bool{"flattenSettings": true},
+			Expected: map[string][]string{
+				"resource": {
+					`"settings": {`,
+					"MaxItems: 1,",
+					"ValidateFunc: validation.StringInSlice(example.SettingsMode_Values(), false),",
+					"in.Tags = Tags(tags.IgnoreAWS())",
+					"d.SetId(aws.StringValue(out.ThingId))",
+					"if d.HasChange(\"description\") {",
+					"func expandSettings(tfMap map[string]interface{}) *example.Settings {",
+					"func flattenThingSettings(apiObject *example.Settings) map[string]interface{} {",
+					`d.Set("settings", []interface{}{flattenThingSettings(out.Settings)})`,
+					"tfawserr.ErrCodeEquals(err, example.ErrCodeResourceNotFoundException)",
+				},
+				"find": {
+					"func FindThingByID(ctx context.Context, conn *example.Example, id string) (*example.ThingDescription, error) {",
+					"return out.Thing, nil",
+				},
+				"status": {
+					"return out, aws.StringValue(out.Status), nil",
+				},
+				"wait": {
+					"Pending: []string{example.ThingStatusCreating, example.ThingStatusUpdating},",
+					"Target:  []string{example.ThingStatusActive},",
+					"Pending: []string{example.ThingStatusDeleting, example.ThingStatusActive},",
+				},
+				"test": {
+					"func TestAccExampleThing_basic(t *testing.T) {",
+					"var thing example.ThingDescription",
+					"name = %[1]q",
+				},
+			},
+		},
+		{
+			TestName:   "v2",
+			SDKVersion: 2,
+			GoTypes: GoTypes{
+				"Settings": {"Enabled": "bool", "Mode": "SettingsMode", "Values": "[]string"},
+			},
+			Expected: map[string][]string{
+				"resource": {
+					"apiObject.Enabled = v",
+					`tfMap["enabled"] = apiObject.Enabled`,
+					"ValidateFunc: validation.StringInSlice(settingsModeValues(types.SettingsMode(\"\").Values()...), false),",
+					"func settingsModeValues(input ...types.SettingsMode) []string {",
+					"apiObject.Mode = types.SettingsMode(v)",
+					"d.SetId(aws.ToString(out.ThingId))",
+					"func expandSettings(tfMap map[string]interface{}) *types.Settings {",
+					"errors.As(err, &nfe)",
+				},
+				"find": {
+					"func FindThingByID(ctx context.Context, conn *example.Client, id string) (*types.ThingDescription, error) {",
+					"out, err := conn.DescribeThing(ctx, in)",
+				},
+				"status": {
+					"return out, string(out.Status), nil",
+				},
+				"wait": {
+					"Target:  []string{string(types.ThingStatusActive)},",
+				},
+				"test": {
+					"acctest.ErrorCheck(t, names.Example)",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			clientTypeName := "Example"
+
+			if testCase.SDKVersion == 2 {
+				clientTypeName = "Client"
+			}
+
+			r := &Resource{
+				API:            testAPI(t),
+				AWSServiceName: "Example",
+				ClientTypeName: clientTypeName,
+				GoPackage:      "example",
+				Name:           "Thing",
+				SDKVersion:     testCase.SDKVersion,
+				Service:        "Example",
+				ServicePackage: "example",
+				Create:         "CreateThing",
+				Read:           "DescribeThing",
+				Update:         "UpdateThing",
+				Delete:         "DeleteThing",
+				GoTypes:        testCase.GoTypes,
+				Declared:       testCase.Declared,
+			}
+
+			code, err := r.Generate()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for name, file := range map[string]*File{"resource": code.Resource, "find": code.Find, "status": code.Status, "wait": code.Wait, "test": code.Test} {
+				if file == nil {
+					t.Fatalf("expected %s file", name)
+				}
+
+				src, err := file.Source("example")
+
+				if err != nil {
+					t.Fatalf("%s: %s\n%s", name, err, src)
+				}
+
+				for _, expected := range testCase.Expected[name] {
+					if !strings.Contains(string(src), expected) {
+						t.Errorf("%s: expected %q in:\n%s", name, expected, src)
+					}
+				}
+			}
+
+			if len(code.Warnings) > 0 {
+				t.Errorf("unexpected warnings: %v", code.Warnings)
+			}
+		})
+	}
+}
+
+func TestLoadGoTypes(t *testing.T) {
+	dir := t.TempDir()
+	src := "package example\n\ntype Settings struct {\n\tEnabled bool\n\tPort *int32\n\tMode, Other string\n}\n\nfunc f() {}\n"
+
+	if err := os.WriteFile(filepath.Join(dir, "types.go"), []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	goTypes, err := LoadGoTypes(dir)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for field, expected := range map[string]string{"Enabled": "bool", "Port": "*int32", "Mode": "string", "Other": "string"} {
+		if got, _ := goTypes.FieldType("Settings", field); got != expected {
+			t.Errorf("%s: got %q, expected %q", field, got, expected)
+		}
+	}
+
+	funcs, err := DeclaredFuncs(dir)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !funcs["f"] {
+		t.Errorf("expected function f to be declared, got %v", funcs)
+	}
+}
+
+func TestFileAppendTo(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected string
+	}{
+		{
+			TestName: "no imports",
+			Input:    "package example\n",
+			Expected: "package example\n\nimport (\n\t\"context\"\n)\n\nfunc f(ctx context.Context) {}\n",
+		},
+		{
+			TestName: "import declaration",
+			Input:    "package example\n\nimport (\n\t\"fmt\"\n)\n\nvar s = fmt.Sprint()\n",
+			Expected: "package example\n\nimport (\n\t\"context\"\n\t\"fmt\"\n)\n\nvar s = fmt.Sprint()\n\nfunc f(ctx context.Context) {}\n",
+		},
+		{
+			TestName: "single import",
+			Input:    "package example\n\nimport \"fmt\"\n\nvar s = fmt.Sprint()\n",
+			Expected: "package example\n\nimport (\n\t\"context\"\n\t\"fmt\"\n)\n\nvar s = fmt.Sprint()\n\nfunc f(ctx context.Context) {}\n",
+		},
+		{
+			TestName: "existing import",
+			Input:    "package example\n\nimport (\n\t\"context\"\n)\n\nvar ctx = context.TODO()\n",
+			Expected: "package example\n\nimport (\n\t\"context\"\n)\n\nvar ctx = context.TODO()\n\nfunc f(ctx context.Context) {}\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			f := newFile()
+			f.use("context")
+			f.printf("func f(ctx context.Context) {}\n")
+
+			got, err := f.AppendTo([]byte(testCase.Input))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if string(got) != testCase.Expected {
+				t.Errorf("got:\n%s\nexpected:\n%s", got, testCase.Expected)
+			}
+		})
+	}
+}
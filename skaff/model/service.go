@@ -0,0 +1,86 @@
+package model
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Service is an AWS service as described by its API model and the AWS SDK for Go.
+type Service struct {
+	API *API
+
+	CLICommand         string // AWS CLI v2 command, e.g. "resource-groups"
+	GoV1ClientTypeName string // AWS SDK for Go v1 client type name, e.g. "ResourceGroups"
+	GoV1Package        string // AWS SDK for Go v1 package name, e.g. "resourcegroups"
+	GoV2Package        string // AWS SDK for Go v2 package name, e.g. "resourcegroups"
+}
+
+var (
+	clientTypeNameRegexp = regexp.MustCompile(`(?m)^func New\(p client\.ConfigProvider, cfgs \.\.\.\*aws\.Config\) \*(\w+) \{`)
+	nonAlphanumRegexp    = regexp.MustCompile(`[^a-z0-9]`)
+)
+
+// goV2Package returns the AWS SDK for Go v2 package name for a service ID, e.g. "route53domains" for "Route 53 Domains".
+func goV2Package(serviceID string) string {
+	return nonAlphanumRegexp.ReplaceAllString(strings.ToLower(serviceID), "")
+}
+
+// FindService returns the AWS service for an AWS SDK for Go v1 or v2 package name.
+func FindService(sdkDir, goPackage string) (*Service, error) {
+	paths, err := filepath.Glob(filepath.Join(sdkDir, "service", "*", "service.go"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		src, err := os.ReadFile(path)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading AWS SDK for Go package: %w", err)
+		}
+
+		m := serviceIDRegexp.FindSubmatch(src)
+
+		if m == nil {
+			continue
+		}
+
+		serviceID := string(m[1])
+		s := &Service{
+			GoV1Package: filepath.Base(filepath.Dir(path)),
+			GoV2Package: goV2Package(serviceID),
+		}
+
+		if s.GoV1Package != goPackage && s.GoV2Package != goPackage {
+			continue
+		}
+
+		if m := clientTypeNameRegexp.FindSubmatch(src); m != nil {
+			s.GoV1ClientTypeName = string(m[1])
+		}
+
+		modelPath, err := findModel(sdkDir, serviceID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if s.API, err = Load(modelPath); err != nil {
+			return nil, err
+		}
+
+		// Model directories are named for the service's AWS CLI command, e.g. models/apis/resource-groups/2017-11-27.
+		s.CLICommand = filepath.Base(filepath.Dir(filepath.Dir(modelPath)))
+
+		return s, nil
+	}
+
+	return nil, fmt.Errorf("no AWS SDK for Go package found named %s", goPackage)
+}
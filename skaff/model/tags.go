@@ -0,0 +1,155 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	listTagsOp = "ListTagsForResource"
+	tagOp      = "TagResource"
+	untagOp    = "UntagResource"
+)
+
+// TagsGenerateFlags returns the internal/generate/tags generator flags for the service's
+// TagResource, UntagResource and ListTagsForResource operations.
+// No flags are returned if the service has no TagResource operation.
+func (api *API) TagsGenerateFlags(sdkVersion int) ([]string, error) {
+	op, ok := api.Operations[tagOp]
+
+	if !ok {
+		return nil, nil
+	}
+
+	tagIn, err := api.Shape(op.Input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tagsElem, tagsShape := api.tagsMember(tagIn)
+
+	if tagsElem == "" {
+		return nil, fmt.Errorf("no tags member found in %s input", tagOp)
+	}
+
+	tagIDElem := requiredMember(tagIn, tagsElem)
+
+	if tagIDElem == "" {
+		return nil, fmt.Errorf("no resource identifier member found in %s input", tagOp)
+	}
+
+	var flags []string
+
+	if sdkVersion == 2 {
+		flags = append(flags, "-AwsSdkVersion=2")
+	}
+
+	if op, ok := api.Operations[listTagsOp]; ok {
+		in, err := api.Shape(op.Input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		out, err := api.Shape(op.Output)
+
+		if err != nil {
+			return nil, err
+		}
+
+		flags = append(flags, "-ListTags")
+
+		if v := requiredMember(in, ""); v != "" && v != "ResourceArn" {
+			flags = append(flags, "-ListTagsInIDElem="+v)
+		}
+
+		if v, _ := api.tagsMember(out); v != "" && v != "Tags" {
+			flags = append(flags, "-ListTagsOutTagsElem="+v)
+		}
+	}
+
+	if tagsShape.Type == "map" {
+		flags = append(flags, "-ServiceTagsMap")
+	} else {
+		flags = append(flags, "-ServiceTagsSlice")
+
+		tagType := tagsShape.Member.Shape
+		elem := api.Shapes[tagType]
+
+		if tagType != "Tag" {
+			flags = append(flags, "-TagType="+exportedName(tagType))
+		}
+
+		for _, name := range elem.MemberNames() {
+			switch {
+			case name == "Key" || name == "Value":
+			case strings.HasSuffix(name, "Key"):
+				flags = append(flags, "-TagTypeKeyElem="+name)
+			case strings.HasSuffix(name, "Value"):
+				flags = append(flags, "-TagTypeValElem="+name)
+			}
+		}
+	}
+
+	if tagIDElem != "ResourceArn" {
+		flags = append(flags, "-TagInIDElem="+tagIDElem)
+	}
+
+	if tagsElem != "Tags" {
+		flags = append(flags, "-TagInTagsElem="+tagsElem)
+	}
+
+	if op, ok := api.Operations[untagOp]; ok {
+		in, err := api.Shape(op.Input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range in.MemberNames() {
+			if name == tagIDElem || api.Shapes[in.Members[name].Shape].Type != "list" {
+				continue
+			}
+
+			if name != "TagKeys" {
+				flags = append(flags, "-UntagInTagsElem="+name)
+			}
+
+			break
+		}
+
+		flags = append(flags, "-UpdateTags")
+	}
+
+	return flags, nil
+}
+
+// tagsMember returns the name and shape of a structure's tags member: a map, or a list of key-value structures.
+func (api *API) tagsMember(shape Shape) (string, Shape) {
+	for _, name := range shape.MemberNames() {
+		v := api.Shapes[shape.Members[name].Shape]
+
+		switch v.Type {
+		case "map":
+			return name, v
+		case "list":
+			if elem := api.Shapes[v.Member.Shape]; elem.Type == "structure" && len(elem.Members) == 2 {
+				return name, v
+			}
+		}
+	}
+
+	return "", Shape{}
+}
+
+// requiredMember returns the first required member of a structure other than the excluded member.
+func requiredMember(shape Shape, exclude string) string {
+	for _, name := range shape.MemberNames() {
+		if name != exclude && shape.IsRequired(name) {
+			return name
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,69 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func testTagsAPI(idElem, tagsShape string) *API {
+	return &API{
+		Operations: map[string]Operation{
+			"ListTagsForResource": {Input: &ShapeRef{Shape: "ListTagsForResourceRequest"}, Output: &ShapeRef{Shape: "ListTagsForResourceResponse"}},
+			"TagResource":         {Input: &ShapeRef{Shape: "TagResourceRequest"}},
+			"UntagResource":       {Input: &ShapeRef{Shape: "UntagResourceRequest"}},
+		},
+		Shapes: map[string]Shape{
+			"ListTagsForResourceRequest":  {Type: "structure", Required: []string{idElem}, Members: map[string]ShapeRef{idElem: {Shape: "String"}}},
+			"ListTagsForResourceResponse": {Type: "structure", Members: map[string]ShapeRef{"Tags": {Shape: tagsShape}}},
+			"String":                      {Type: "string"},
+			"Tag":                         {Type: "structure", Members: map[string]ShapeRef{"Key": {Shape: "String"}, "Value": {Shape: "String"}}},
+			"TagKeyList":                  {Type: "list", Member: &ShapeRef{Shape: "String"}},
+			"TagList":                     {Type: "list", Member: &ShapeRef{Shape: "Tag"}},
+			"TagMap":                      {Type: "map", Key: &ShapeRef{Shape: "String"}, Value: &ShapeRef{Shape: "String"}},
+			"TagResourceRequest":          {Type: "structure", Required: []string{idElem, "Tags"}, Members: map[string]ShapeRef{idElem: {Shape: "String"}, "Tags": {Shape: tagsShape}}},
+			"UntagResourceRequest":        {Type: "structure", Required: []string{idElem, "TagKeys"}, Members: map[string]ShapeRef{idElem: {Shape: "String"}, "TagKeys": {Shape: "TagKeyList"}}},
+		},
+	}
+}
+
+func TestTagsGenerateFlags(t *testing.T) {
+	testCases := []struct {
+		TestName   string
+		API        *API
+		SDKVersion int
+		Expected   []string
+	}{
+		{
+			TestName:   "map",
+			API:        testTagsAPI("ResourceArn", "TagMap"),
+			SDKVersion: 1,
+			Expected:   []string{"-ListTags", "-ServiceTagsMap", "-UpdateTags"},
+		},
+		{
+			TestName:   "slice",
+			API:        testTagsAPI("ResourceARN", "TagList"),
+			SDKVersion: 2,
+			Expected:   []string{"-AwsSdkVersion=2", "-ListTags", "-ListTagsInIDElem=ResourceARN", "-ServiceTagsSlice", "-TagInIDElem=ResourceARN", "-UpdateTags"},
+		},
+		{
+			TestName:   "no tagging",
+			API:        &API{},
+			SDKVersion: 1,
+			Expected:   nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := testCase.API.TagsGenerateFlags(testCase.SDKVersion)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
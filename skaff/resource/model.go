@@ -0,0 +1,216 @@
+package resource
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/model"
+)
+
+// Operations are the AWS API operations used to generate a resource from the service's API model.
+type Operations struct {
+	Create string
+	Read   string
+	Update string
+	Delete string
+
+	// ModelPath is the path of the API model (api-2.json). If empty, the model distributed with the AWS SDK for Go is used.
+	ModelPath string
+}
+
+// CreateFromModel generates a resource, its finder, status and waiter functions, acceptance test and
+// website documentation from the service's AWS API model.
+func CreateFromModel(resName, snakeName string, ops Operations, force bool) error {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	if resName == "" {
+		return fmt.Errorf("error checking: no name given")
+	}
+
+	if resName == strings.ToLower(resName) {
+		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+	}
+
+	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
+		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+	}
+
+	s, err := names.ProviderNameUpper(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting service connection name: %w", err)
+	}
+
+	sn, err := names.FullHumanFriendly(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting AWS service name: %w", err)
+	}
+
+	version, err := names.AWSGoSDKVersion(servicePackage)
+	if err != nil {
+		return err
+	}
+
+	goPackage, err := names.AWSGoPackage(servicePackage, version)
+	if err != nil {
+		return err
+	}
+
+	clientTypeName, err := names.AWSGoClientTypeName(servicePackage, version)
+	if err != nil {
+		return err
+	}
+
+	modelPath := ops.ModelPath
+
+	if modelPath == "" {
+		goV1Package, err := names.AWSGoV1Package(servicePackage)
+		if err != nil {
+			return err
+		}
+
+		dir, err := model.SDKDir()
+		if err != nil {
+			return err
+		}
+
+		if modelPath, err = model.Find(dir, goV1Package); err != nil {
+			return err
+		}
+	}
+
+	api, err := model.Load(modelPath)
+	if err != nil {
+		return err
+	}
+
+	snake := toSnakeCase(resName, snakeName)
+
+	declared, err := model.DeclaredFuncs(wd, fmt.Sprintf("%s.go", snake))
+	if err != nil {
+		return err
+	}
+
+	var goTypes model.GoTypes
+
+	if version == 2 {
+		dir, err := model.SDKV2PackageDir(goPackage)
+		if err != nil {
+			return err
+		}
+
+		if goTypes, err = model.LoadGoTypes(dir, filepath.Join(dir, "types")); err != nil {
+			return err
+		}
+	}
+
+	r := &model.Resource{
+		API:            api,
+		AWSServiceName: sn,
+		ClientTypeName: clientTypeName,
+		GoPackage:      goPackage,
+		Name:           resName,
+		SDKVersion:     version,
+		Service:        s,
+		ServicePackage: servicePackage,
+		Create:         ops.Create,
+		Read:           ops.Read,
+		Update:         ops.Update,
+		Delete:         ops.Delete,
+		GoTypes:        goTypes,
+		Declared:       declared,
+	}
+
+	code, err := r.Generate()
+	if err != nil {
+		return fmt.Errorf("error generating resource from API model (%s): %w", modelPath, err)
+	}
+
+	if err := writeSource(fmt.Sprintf("%s.go", snake), servicePackage, code.Resource, force); err != nil {
+		return fmt.Errorf("writing resource: %w", err)
+	}
+
+	if err := writeSource(fmt.Sprintf("%s_test.go", snake), servicePackage+"_test", code.Test, force); err != nil {
+		return fmt.Errorf("writing resource test: %w", err)
+	}
+
+	for filename, f := range map[string]*model.File{"find.go": code.Find, "status.go": code.Status, "wait.go": code.Wait} {
+		if f == nil {
+			continue
+		}
+
+		if err := appendSource(filename, servicePackage, f); err != nil {
+			return err
+		}
+	}
+
+	templateData := TemplateData{
+		Resource:        resName,
+		ResourceLower:   strings.ToLower(resName),
+		IncludeComments: true,
+		ServicePackage:  servicePackage,
+		Service:         s,
+		ServiceLower:    strings.ToLower(s),
+		AWSServiceName:  sn,
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snake)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	for _, v := range code.Warnings {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", v)
+	}
+
+	return nil
+}
+
+func writeSource(filename, pkg string, f *model.File, force bool) error {
+	if _, err := os.Stat(filename); !os.IsNotExist(err) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	contents, err := f.Source(pkg)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filename, contents, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	return nil
+}
+
+// appendSource adds the generated declarations to an existing file, e.g. find.go, or creates the file.
+func appendSource(filename, pkg string, f *model.File) error {
+	src, err := os.ReadFile(filename)
+
+	if os.IsNotExist(err) {
+		return writeSource(filename, pkg, f, false)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading file (%s): %s", filename, err)
+	}
+
+	contents, err := f.AppendTo(src)
+	if err != nil {
+		return fmt.Errorf("error adding to file (%s): %w", filename, err)
+	}
+
+	if err := os.WriteFile(filename, contents, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	return nil
+}
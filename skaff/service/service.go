@@ -0,0 +1,474 @@
+package service
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/model"
+)
+
+//go:embed generate.tmpl
+var generateTmpl string
+
+//go:embed config.tmpl
+var configTmpl string
+
+const (
+	configFile    = "internal/conns/config.go"
+	namesDataFile = "names/names_data.csv"
+	providerFile  = "internal/provider/provider.go"
+	servicesDir   = "internal/service"
+)
+
+// Generated files that generators append to are removed before the generators are run, as `make gen` does.
+var generatedFiles = []string{
+	"internal/conns/*_gen.go",
+	"internal/sweep/sweep_test.go",
+	"names/consts_gen.go",
+	"infrastructure/repository/labels-service.tf",
+	"website/docs/guides/custom-service-endpoints.html.md",
+	".semgrep-caps-aws-ec2.yml",
+	".semgrep-configs.yml",
+	".semgrep-service-name*.yml",
+}
+
+// Generators are the packages whose go:generate directives are run after names_data.csv is changed.
+// The names constants are generated first as other generated code refers to them.
+var generators = []string{
+	"./names",
+	"./internal/conns",
+	"./internal/generate/customends",
+	"./internal/generate/servicelabels",
+	"./internal/generate/servicesemgrep",
+	"./internal/generate/sweepimp",
+}
+
+// Options are used for services not yet in names_data.csv.
+type Options struct {
+	SDKVersion        int    // AWS SDK for Go major version, 1 or 2; 0 to use the version in names_data.csv or 1
+	HumanFriendly     string // Defaults to the name in the service's AWS API model
+	Brand             string // Defaults to the brand in the service's AWS API model
+	ProviderNameUpper string // Defaults to the AWS SDK for Go v1 client type name
+}
+
+type TemplateData struct {
+	GoPackage         string
+	ProviderNameUpper string
+	ProviderPackage   string
+	TagsFlags         string
+}
+
+// Create adds a service to names_data.csv, if not already there, runs the generators that depend on it,
+// and creates the service package with generated tagging functions and registers it in the provider.
+// It must be run in the terraform-provider-aws directory.
+func Create(goPackage string, opts Options) error {
+	if goPackage == "" {
+		return fmt.Errorf("error checking: no name given")
+	}
+
+	if goPackage != strings.ToLower(goPackage) {
+		return fmt.Errorf("error checking: name should be an AWS SDK for Go package name (e.g., resourcegroups)")
+	}
+
+	if opts.SDKVersion != 0 && opts.SDKVersion != 1 && opts.SDKVersion != 2 {
+		return fmt.Errorf("error checking: unsupported AWS SDK for Go version: %d", opts.SDKVersion)
+	}
+
+	if _, err := os.Stat(namesDataFile); err != nil {
+		return fmt.Errorf("error checking: skaff service must be run in the terraform-provider-aws directory: %w", err)
+	}
+
+	sdkDir, err := model.SDKDir()
+	if err != nil {
+		return err
+	}
+
+	svc, err := model.FindService(sdkDir, goPackage)
+	if err != nil {
+		return err
+	}
+
+	row, err := updateNamesData(svc, opts)
+	if err != nil {
+		return err
+	}
+
+	providerPackage := providerPackageName(row)
+	sdkVersion := 1
+	td := TemplateData{
+		GoPackage:         row[names.ColGoV1Package],
+		ProviderNameUpper: row[names.ColProviderNameUpper],
+		ProviderPackage:   providerPackage,
+	}
+
+	if row[names.ColSDKVersion] == "2" {
+		sdkVersion = 2
+		td.GoPackage = row[names.ColGoV2Package]
+
+		if err := run(".", "go", "get", "github.com/aws/aws-sdk-go-v2/service/"+td.GoPackage); err != nil {
+			return err
+		}
+
+		if err := addClientConfig(td); err != nil {
+			return err
+		}
+	}
+
+	for _, pattern := range generatedFiles {
+		paths, err := filepath.Glob(pattern)
+		if err != nil {
+			return err
+		}
+
+		for _, path := range paths {
+			if err := os.Remove(path); err != nil {
+				return fmt.Errorf("error removing generated file (%s): %w", path, err)
+			}
+		}
+	}
+
+	if err := run(".", "go", append([]string{"generate"}, generators...)...); err != nil {
+		return err
+	}
+
+	flags, err := svc.API.TagsGenerateFlags(sdkVersion)
+	if err != nil {
+		return fmt.Errorf("error getting tags generator flags: %w", err)
+	}
+
+	td.TagsFlags = strings.Join(flags, " ")
+	dir := filepath.Join(servicesDir, providerPackage)
+
+	if err := os.Mkdir(dir, 0755); err != nil {
+		return fmt.Errorf("error creating service package directory: %w", err)
+	}
+
+	if err := writeTemplate("generate", filepath.Join(dir, "generate.go"), generateTmpl, td); err != nil {
+		return fmt.Errorf("writing generate.go: %w", err)
+	}
+
+	if len(flags) == 0 {
+		fmt.Fprintf(os.Stderr, "warning: no %s tagging operations found, tags_gen.go not generated\n", svc.API.Metadata.ServiceID)
+	} else if err := run(dir, "go", "generate"); err != nil {
+		return err
+	}
+
+	if err := addProviderImport(providerPackage); err != nil {
+		return err
+	}
+
+	return run(".", "go", "build", "./internal/conns", "./"+dir)
+}
+
+// updateNamesData returns the service's row in names_data.csv, adding or updating it if required.
+func updateNamesData(svc *model.Service, opts Options) ([]string, error) {
+	src, err := os.ReadFile(namesDataFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", namesDataFile, err)
+	}
+
+	data, err := csv.NewReader(bytes.NewReader(src)).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", namesDataFile, err)
+	}
+
+	var existing []string
+
+	for i, l := range data {
+		if i < 1 { // no header
+			continue
+		}
+
+		if l[names.ColGoV1Package] == svc.GoV1Package || (l[names.ColGoV2Package] != "" && l[names.ColGoV2Package] == svc.GoV2Package) {
+			existing = l
+			break
+		}
+	}
+
+	row := existing
+
+	if row == nil {
+		row = newRow(svc, opts)
+	} else {
+		row = append([]string{}, existing...)
+
+		if row[names.ColExclude] != "" {
+			return nil, fmt.Errorf("service %s is excluded in %s: %s", row[names.ColHumanFriendly], namesDataFile, row[names.ColNote])
+		}
+
+		// The AWS SDK for Go v2 client configuration is not generated.
+		if opts.SDKVersion == 2 && row[names.ColSDKVersion] != "2" {
+			row[names.ColSDKVersion] = "2"
+			row[names.ColSkipClientGenerate] = "x"
+		}
+	}
+
+	if err := names.CheckServiceRow(row); err != nil {
+		return nil, fmt.Errorf("error checking %s: %w", namesDataFile, err)
+	}
+
+	if _, err := os.Stat(filepath.Join(servicesDir, providerPackageName(row))); !os.IsNotExist(err) {
+		return nil, fmt.Errorf("error checking: service package (%s) already exists", providerPackageName(row))
+	}
+
+	line, err := csvLine(row)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing != nil {
+		old, err := csvLine(existing)
+		if err != nil {
+			return nil, err
+		}
+
+		if old == line {
+			return row, nil
+		}
+
+		if !bytes.Contains(src, []byte(old)) {
+			return nil, fmt.Errorf("error updating %s: row for service %s not found", namesDataFile, row[names.ColHumanFriendly])
+		}
+
+		src = bytes.Replace(src, []byte(old), []byte(line), 1)
+	} else {
+		src = insertLine(src, line)
+	}
+
+	if err := os.WriteFile(namesDataFile, src, 0644); err != nil {
+		return nil, fmt.Errorf("error writing %s: %w", namesDataFile, err)
+	}
+
+	fmt.Printf("%s: %s", namesDataFile, line)
+
+	return row, nil
+}
+
+func providerPackageName(l []string) string {
+	if l[names.ColProviderPackageActual] != "" {
+		return l[names.ColProviderPackageActual]
+	}
+
+	return l[names.ColProviderPackageCorrect]
+}
+
+// newRow returns a names_data.csv row for a service from its AWS API model and AWS SDK for Go packages.
+func newRow(svc *model.Service, opts Options) []string {
+	l := make([]string, names.ColNote+1)
+
+	l[names.ColAWSCLIV2Command] = svc.CLICommand
+	l[names.ColAWSCLIV2CommandNoDashes] = strings.Replace(svc.CLICommand, "-", "", -1)
+	l[names.ColGoV1Package] = svc.GoV1Package
+	l[names.ColGoV2Package] = svc.GoV2Package
+	l[names.ColGoV1ClientTypeName] = svc.GoV1ClientTypeName
+
+	// ProviderPackageCorrect is the shorter of AWSCLIV2CommandNoDashes and GoV2Package.
+	p := svc.GoV2Package
+
+	if len(l[names.ColAWSCLIV2CommandNoDashes]) < len(p) {
+		p = l[names.ColAWSCLIV2CommandNoDashes]
+	}
+
+	l[names.ColProviderPackageCorrect] = p
+	l[names.ColResourcePrefixCorrect] = fmt.Sprintf("aws_%s_", p)
+	l[names.ColDocPrefix] = fmt.Sprintf("%s_", p)
+
+	l[names.ColProviderNameUpper] = svc.GoV1ClientTypeName
+
+	if opts.ProviderNameUpper != "" {
+		l[names.ColProviderNameUpper] = opts.ProviderNameUpper
+	}
+
+	l[names.ColSDKVersion] = "1"
+
+	if opts.SDKVersion == 2 {
+		l[names.ColSDKVersion] = "2"
+		l[names.ColSkipClientGenerate] = "x"
+	}
+
+	l[names.ColHumanFriendly], l[names.ColBrand] = humanFriendly(svc.API.Metadata, l[names.ColProviderNameUpper])
+
+	if opts.HumanFriendly != "" {
+		l[names.ColHumanFriendly] = opts.HumanFriendly
+	}
+
+	if opts.Brand != "" {
+		l[names.ColBrand] = opts.Brand
+	}
+
+	return l
+}
+
+// humanFriendly returns the service's name, without brand, and brand from the AWS API model,
+// e.g. "SQS" and "Amazon" for "Amazon SQS".
+func humanFriendly(md model.Metadata, fallback string) (string, string) {
+	for _, v := range []string{md.ServiceAbbreviation, md.ServiceFullName} {
+		if strings.ToLower(v) == v || !strings.Contains(v, " ") {
+			continue
+		}
+
+		for _, brand := range []string{"Amazon", "AWS"} {
+			if strings.HasPrefix(v, brand+" ") {
+				return strings.TrimPrefix(v, brand+" "), brand
+			}
+		}
+
+		return v, ""
+	}
+
+	return fallback, ""
+}
+
+func csvLine(l []string) (string, error) {
+	var b bytes.Buffer
+
+	w := csv.NewWriter(&b)
+
+	if err := w.Write(l); err != nil {
+		return "", err
+	}
+
+	w.Flush()
+
+	return b.String(), w.Error()
+}
+
+// insertLine inserts a line in names_data.csv in order of the first column, AWSCLIV2Command.
+func insertLine(src []byte, line string) []byte {
+	lines := strings.SplitAfter(string(src), "\n")
+	key := strings.Split(line, ",")[0]
+
+	for i, v := range lines {
+		if i < 1 || v == "" { // no header
+			continue
+		}
+
+		if strings.Split(v, ",")[0] > key {
+			return []byte(strings.Join(lines[:i], "") + line + strings.Join(lines[i:], ""))
+		}
+	}
+
+	if !strings.HasSuffix(string(src), "\n") {
+		return []byte(string(src) + "\n" + line)
+	}
+
+	return []byte(string(src) + line)
+}
+
+var configClientRegexp = regexp.MustCompile(`(?m)^\tclient\.(\w+)Conn = \w+\.NewFromConfig\(`)
+
+// addClientConfig adds the AWS SDK for Go v2 client configuration to the conns package, in order of connection name.
+func addClientConfig(td TemplateData) error {
+	src, err := os.ReadFile(configFile)
+	if err != nil {
+		return fmt.Errorf("error reading %s: %w", configFile, err)
+	}
+
+	if bytes.Contains(src, []byte(fmt.Sprintf("client.%sConn = ", td.ProviderNameUpper))) {
+		return nil
+	}
+
+	var b bytes.Buffer
+
+	tplate, err := template.New("config").Parse(configTmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	if err := tplate.Execute(&b, td); err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	offset := bytes.Index(src, []byte("\t// sts\n"))
+
+	for _, m := range configClientRegexp.FindAllSubmatchIndex(src, -1) {
+		if string(src[m[2]:m[3]]) > td.ProviderNameUpper {
+			offset = m[0]
+			break
+		}
+	}
+
+	if offset < 0 {
+		return fmt.Errorf("error adding client configuration to %s: no insertion point found", configFile)
+	}
+
+	src = append(src[:offset:offset], append(b.Bytes(), src[offset:]...)...)
+
+	if src, err = model.AddImports(src, fmt.Sprintf("%q", "github.com/aws/aws-sdk-go-v2/service/"+td.GoPackage)); err != nil {
+		return fmt.Errorf("error adding client configuration to %s: %w", configFile, err)
+	}
+
+	if err := os.WriteFile(configFile, src, 0644); err != nil {
+		return fmt.Errorf("error writing %s: %w", configFile, err)
+	}
+
+	return nil
+}
+
+// addProviderImport registers the service package in the provider.
+// The package is imported only for its side effects until it has resources or data sources.
+func addProviderImport(providerPackage string) error {
+	src, err := os.ReadFile(providerFile)
+	if err != nil {
+		return fmt.Errorf("error reading %s: %w", providerFile, err)
+	}
+
+	spec := fmt.Sprintf("_ %q", "github.com/hashicorp/terraform-provider-aws/"+servicesDir+"/"+providerPackage)
+
+	if src, err = model.AddImports(src, spec); err != nil {
+		return fmt.Errorf("error adding import to %s: %w", providerFile, err)
+	}
+
+	if err := os.WriteFile(providerFile, src, 0644); err != nil {
+		return fmt.Errorf("error writing %s: %w", providerFile, err)
+	}
+
+	return nil
+}
+
+func writeTemplate(templateName, filename, tmpl string, td TemplateData) error {
+	if _, err := os.Stat(filename); !os.IsNotExist(err) {
+		return fmt.Errorf("file (%s) already exists", filename)
+	}
+
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tplate.Execute(&buffer, td)
+	if err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	if err := os.WriteFile(filename, buffer.Bytes(), 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	return nil
+}
+
+func run(dir, name string, args ...string) error {
+	fmt.Printf("%s: %s %s\n", dir, name, strings.Join(args, " "))
+
+	cmd := exec.Command(name, args...)
+	cmd.Dir = dir
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("error running %s %s: %w", name, strings.Join(args, " "), err)
+	}
+
+	return nil
+}